@@ -0,0 +1,70 @@
+package adjust
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+func ValidMode(mode string) bool {
+	switch mode {
+	case constant.AdjustNone, constant.AdjustSplits, constant.AdjustTotal:
+		return true
+	}
+	return false
+}
+
+// Back-adjust date-sorted bars for the corporate actions, so the latest
+// bars keep their raw prices and older ones are scaled to match them.
+// Splits scale prices down and volume up; with the total mode, each
+// dividend also scales prices by (1 - amount / close before the ex-date).
+// The raw bars are left untouched.
+func Bars(bars []dto.DailyOHLCVRes, actions []dto.CorporateActionRes, mode string) []dto.DailyOHLCVRes {
+	if mode == constant.AdjustNone || mode == "" || len(actions) == 0 {
+		return bars
+	}
+
+	sorted := make([]dto.CorporateActionRes, len(actions))
+	copy(sorted, actions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	adjusted := make([]dto.DailyOHLCVRes, len(bars))
+	priceFactor, volumeFactor := decimal.NewFromInt(1), decimal.NewFromInt(1)
+	next := len(sorted) - 1
+	for i := len(bars) - 1; i >= 0; i-- {
+		bar := bars[i]
+
+		// Actions taking effect after this bar apply to it
+		for ; next >= 0 && sorted[next].Date.After(bar.Day); next-- {
+			action := sorted[next]
+			switch action.Type {
+			case constant.ActionSplit:
+				if action.Value.IsPositive() {
+					priceFactor = priceFactor.Div(action.Value)
+					volumeFactor = volumeFactor.Mul(action.Value)
+				}
+			case constant.ActionDividend:
+				close := bar.OHLC["close"]
+				if mode == constant.AdjustTotal && close.IsPositive() {
+					priceFactor = priceFactor.Mul(
+						decimal.NewFromInt(1).Sub(action.Value.Div(close)))
+				}
+			}
+		}
+
+		ohlc := make(map[string]decimal.Decimal, len(bar.OHLC))
+		for key, price := range bar.OHLC {
+			ohlc[key] = price.Mul(priceFactor).Round(constant.AdjustedPricePlaces)
+		}
+		adjusted[i] = dto.DailyOHLCVRes{
+			Day:    bar.Day,
+			OHLC:   ohlc,
+			Volume: int(decimal.NewFromInt(int64(bar.Volume)).Mul(volumeFactor).Round(0).IntPart()),
+		}
+	}
+	return adjusted
+}
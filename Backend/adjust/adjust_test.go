@@ -0,0 +1,76 @@
+package adjust
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+func bar(day string, close int64, volume int) dto.DailyOHLCVRes {
+	price := decimal.NewFromInt(close)
+	return dto.DailyOHLCVRes{
+		Day:    util.Date(day),
+		OHLC:   map[string]decimal.Decimal{"open": price, "high": price, "low": price, "close": price},
+		Volume: volume,
+	}
+}
+
+func TestUnitAdjustBars(t *testing.T) {
+	bars := []dto.DailyOHLCVRes{
+		bar("2025-06-02", 400, 100),
+		bar("2025-06-03", 100, 400),
+		bar("2025-06-04", 50, 400),
+		bar("2025-06-05", 49, 400),
+	}
+	actions := []dto.CorporateActionRes{
+		{Date: util.Date("2025-06-05"), Type: constant.ActionDividend, Value: decimal.NewFromInt(1)},
+		{Date: util.Date("2025-06-03"), Type: constant.ActionSplit, Value: decimal.NewFromInt(4)},
+	}
+
+	testCases := []struct {
+		name           string
+		mode           string
+		expectedCloses []string
+		expectedVolume []int
+	}{
+		{
+			name:           "raw",
+			mode:           constant.AdjustNone,
+			expectedCloses: []string{"400", "100", "50", "49"},
+			expectedVolume: []int{100, 400, 400, 400},
+		},
+		{
+			name:           "splits only",
+			mode:           constant.AdjustSplits,
+			expectedCloses: []string{"100", "100", "50", "49"},
+			expectedVolume: []int{400, 400, 400, 400},
+		},
+		{
+			name:           "splits and dividends",
+			mode:           constant.AdjustTotal,
+			expectedCloses: []string{"98", "98", "49", "49"},
+			expectedVolume: []int{400, 400, 400, 400},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			adjusted := Bars(bars, actions, tt.mode)
+
+			//then
+			for i, day := range adjusted {
+				assert.Equal(t, day.OHLC["close"].String(), tt.expectedCloses[i])
+				assert.Equal(t, day.Volume, tt.expectedVolume[i])
+			}
+
+			// raw bars stay as they were
+			assert.Equal(t, bars[0].OHLC["close"].String(), "400")
+			assert.Equal(t, bars[0].Volume, 100)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package alert
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+func ValidRule(rule *dto.AlertRuleReq) error {
+	switch rule.Type {
+	case constant.AlertCloseAbove, constant.AlertCloseBelow:
+		if !rule.Level.IsPositive() {
+			return constant.ErrInvalidAlertRule
+		}
+	case constant.AlertPercentChange:
+		if rule.Days < 1 || rule.Threshold.IsZero() {
+			return constant.ErrInvalidAlertRule
+		}
+	case constant.AlertVolumeSpike:
+		if rule.Days < 1 || !rule.Threshold.IsPositive() {
+			return constant.ErrInvalidAlertRule
+		}
+	case constant.AlertRSIAbove, constant.AlertRSIBelow:
+		if !rule.Threshold.IsPositive() || !rule.Threshold.LessThan(hundred) || rule.Period < 0 {
+			return constant.ErrInvalidAlertRule
+		}
+	default:
+		return constant.ErrInvalidAlertType
+	}
+	if !validWebhookURL(rule.WebhookURL) {
+		return constant.ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+// Webhooks only go out over http(s) to public hosts. A hostname can't be
+// judged before it's resolved, so deliveries check the address again.
+func validWebhookURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return true
+	}
+	return util.PublicAddr(addr)
+}
+
+// Whether the rule fires on the latest of the date-sorted bars, and the
+// value it looked at there. Rules fire when their condition turns true,
+// so a close staying above a level alerts once, not every day.
+func Evaluate(rule *dto.AlertRuleRes, bars []dto.DailyOHLCVRes) (decimal.Decimal, bool) {
+	if len(bars) == 0 {
+		return decimal.Zero, false
+	}
+	values := series(rule, bars)
+	last := len(bars) - 1
+	now := values[last]
+	if now == nil {
+		return decimal.Zero, false
+	}
+	if !holds(rule, *now) {
+		return *now, false
+	}
+	if last > 0 && values[last-1] != nil && holds(rule, *values[last-1]) {
+		return *now, false
+	}
+	return *now, true
+}
+
+// The value a rule watches at each bar; nil while it can't be computed
+func series(rule *dto.AlertRuleRes, bars []dto.DailyOHLCVRes) []*decimal.Decimal {
+	values := make([]*decimal.Decimal, len(bars))
+	switch rule.Type {
+	case constant.AlertCloseAbove, constant.AlertCloseBelow:
+		for i, bar := range bars {
+			close := bar.OHLC["close"]
+			values[i] = &close
+		}
+
+	case constant.AlertPercentChange:
+		for i := rule.Days; i < len(bars); i++ {
+			base := bars[i-rule.Days].OHLC["close"]
+			if base.IsZero() {
+				continue
+			}
+			change := bars[i].OHLC["close"].Div(base).Sub(decimal.NewFromInt(1)).Mul(hundred)
+			values[i] = &change
+		}
+
+	case constant.AlertVolumeSpike:
+		for i := rule.Days; i < len(bars); i++ {
+			sum := 0
+			for _, bar := range bars[i-rule.Days : i] {
+				sum += bar.Volume
+			}
+			if sum == 0 {
+				continue
+			}
+			ratio := decimal.NewFromInt(int64(bars[i].Volume * rule.Days)).
+				Div(decimal.NewFromInt(int64(sum)))
+			values[i] = &ratio
+		}
+
+	case constant.AlertRSIAbove, constant.AlertRSIBelow:
+		period := rule.Period
+		if period == 0 {
+			period = constant.DefaultIndicatorPeriods[constant.IndicatorRSI]
+		}
+		closes := make([]decimal.Decimal, len(bars))
+		for i, bar := range bars {
+			closes[i] = bar.OHLC["close"]
+		}
+		values = analytics.RSI(closes, period)
+	}
+	return values
+}
+
+func holds(rule *dto.AlertRuleRes, value decimal.Decimal) bool {
+	switch rule.Type {
+	case constant.AlertCloseAbove:
+		return value.GreaterThan(rule.Level)
+	case constant.AlertCloseBelow:
+		return value.LessThan(rule.Level)
+	case constant.AlertPercentChange:
+		if rule.Threshold.IsNegative() {
+			return value.LessThanOrEqual(rule.Threshold)
+		}
+		return value.GreaterThanOrEqual(rule.Threshold)
+	case constant.AlertVolumeSpike:
+		return value.GreaterThanOrEqual(rule.Threshold)
+	case constant.AlertRSIAbove:
+		return value.GreaterThan(rule.Threshold)
+	case constant.AlertRSIBelow:
+		return value.LessThan(rule.Threshold)
+	}
+	return false
+}
+
+// Human readable description of a firing
+func Message(rule *dto.AlertRuleRes, value decimal.Decimal) string {
+	switch rule.Type {
+	case constant.AlertCloseAbove:
+		return fmt.Sprintf("%s closed at %s, above %s", rule.Symbol, value, rule.Level)
+	case constant.AlertCloseBelow:
+		return fmt.Sprintf("%s closed at %s, below %s", rule.Symbol, value, rule.Level)
+	case constant.AlertPercentChange:
+		return fmt.Sprintf("%s moved %s%% over %d bars, threshold %s%%",
+			rule.Symbol, value.Round(2), rule.Days, rule.Threshold)
+	case constant.AlertVolumeSpike:
+		return fmt.Sprintf("%s volume is %sx its %d-bar average, threshold %sx",
+			rule.Symbol, value.Round(2), rule.Days, rule.Threshold)
+	case constant.AlertRSIAbove:
+		return fmt.Sprintf("%s RSI is %s, above %s", rule.Symbol, value.Round(2), rule.Threshold)
+	case constant.AlertRSIBelow:
+		return fmt.Sprintf("%s RSI is %s, below %s", rule.Symbol, value.Round(2), rule.Threshold)
+	}
+	return ""
+}
+
+// Hex HMAC-SHA256 of "<timestamp>.<body>"
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Check a received signature, as a webhook receiver would
+func Verify(secret string, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,188 @@
+package alert
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+func bars(closes []int64, volumes []int) []dto.DailyOHLCVRes {
+	start := util.Date("2025-06-02")
+	out := make([]dto.DailyOHLCVRes, len(closes))
+	for i, close := range closes {
+		out[i] = dto.DailyOHLCVRes{
+			Day:    start.AddDate(0, 0, i),
+			OHLC:   map[string]decimal.Decimal{"close": decimal.NewFromInt(close)},
+			Volume: 100,
+		}
+		if volumes != nil {
+			out[i].Volume = volumes[i]
+		}
+	}
+	return out
+}
+
+func TestUnitAlertEvaluate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		rule          dto.AlertRuleRes
+		bars          []dto.DailyOHLCVRes
+		expectedValue string
+		expectedFired bool
+	}{
+		{
+			name:          "close crosses above",
+			rule:          dto.AlertRuleRes{Type: constant.AlertCloseAbove, Level: decimal.NewFromInt(100)},
+			bars:          bars([]int64{98, 99, 101}, nil),
+			expectedValue: "101",
+			expectedFired: true,
+		},
+		{
+			name:          "close already above",
+			rule:          dto.AlertRuleRes{Type: constant.AlertCloseAbove, Level: decimal.NewFromInt(100)},
+			bars:          bars([]int64{98, 101, 102}, nil),
+			expectedValue: "102",
+		},
+		{
+			name:          "close crosses below",
+			rule:          dto.AlertRuleRes{Type: constant.AlertCloseBelow, Level: decimal.NewFromInt(100)},
+			bars:          bars([]int64{101, 99}, nil),
+			expectedValue: "99",
+			expectedFired: true,
+		},
+		{
+			name: "fall over days",
+			rule: dto.AlertRuleRes{
+				Type:      constant.AlertPercentChange,
+				Days:      2,
+				Threshold: decimal.NewFromInt(-10),
+			},
+			bars:          bars([]int64{100, 100, 95, 88}, nil),
+			expectedValue: "-12",
+			expectedFired: true,
+		},
+		{
+			name: "rise below threshold",
+			rule: dto.AlertRuleRes{
+				Type:      constant.AlertPercentChange,
+				Days:      1,
+				Threshold: decimal.NewFromInt(5),
+			},
+			bars:          bars([]int64{100, 104}, nil),
+			expectedValue: "4",
+		},
+		{
+			name: "volume spike",
+			rule: dto.AlertRuleRes{
+				Type:      constant.AlertVolumeSpike,
+				Days:      2,
+				Threshold: decimal.NewFromInt(3),
+			},
+			bars:          bars([]int64{1, 1, 1, 1}, []int{100, 100, 100, 400}),
+			expectedValue: "4",
+			expectedFired: true,
+		},
+		{
+			name:          "rsi crosses above",
+			rule:          dto.AlertRuleRes{Type: constant.AlertRSIAbove, Period: 2, Threshold: decimal.NewFromInt(70)},
+			bars:          bars([]int64{10, 11, 10, 12}, nil),
+			expectedValue: "83.3333",
+			expectedFired: true,
+		},
+		{
+			name:          "not enough bars",
+			rule:          dto.AlertRuleRes{Type: constant.AlertRSIBelow, Threshold: decimal.NewFromInt(30)},
+			bars:          bars([]int64{10, 9}, nil),
+			expectedValue: "0",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			value, fired := Evaluate(&tt.rule, tt.bars)
+
+			//then
+			assert.Equal(t, value.Round(4).String(), tt.expectedValue)
+			assert.Equal(t, fired, tt.expectedFired)
+		})
+	}
+}
+
+func TestUnitAlertSign(t *testing.T) {
+	body := []byte(`{"symbol":"AAPL"}`)
+	signature := Sign("secret", "1718000000", body)
+
+	assert.Equal(t, Verify("secret", "1718000000", body, signature), true)
+	assert.Equal(t, Verify("secret", "1718000001", body, signature), false)
+	assert.Equal(t, Verify("other", "1718000000", body, signature), false)
+}
+
+func TestUnitAlertValidRule(t *testing.T) {
+	testCases := []struct {
+		name        string
+		webhookURL  string
+		expectedErr error
+	}{
+		{
+			name:       "public https host",
+			webhookURL: "https://hooks.example.com/alerts",
+		},
+		{
+			name:       "public address",
+			webhookURL: "http://93.184.216.34:8080/hook",
+		},
+		{
+			name:        "scheme other than http",
+			webhookURL:  "ftp://hooks.example.com/alerts",
+			expectedErr: constant.ErrInvalidWebhookURL,
+		},
+		{
+			name:        "localhost",
+			webhookURL:  "http://localhost:8080/hook",
+			expectedErr: constant.ErrInvalidWebhookURL,
+		},
+		{
+			name:        "loopback address",
+			webhookURL:  "http://127.0.0.1/hook",
+			expectedErr: constant.ErrInvalidWebhookURL,
+		},
+		{
+			name:        "private address",
+			webhookURL:  "https://10.0.0.5/hook",
+			expectedErr: constant.ErrInvalidWebhookURL,
+		},
+		{
+			name:        "link-local metadata address",
+			webhookURL:  "http://169.254.169.254/latest/meta-data",
+			expectedErr: constant.ErrInvalidWebhookURL,
+		},
+		{
+			name:        "IPv6 loopback",
+			webhookURL:  "http://[::1]/hook",
+			expectedErr: constant.ErrInvalidWebhookURL,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			rule := &dto.AlertRuleReq{
+				Symbol:     "AAPL",
+				Type:       constant.AlertCloseAbove,
+				Level:      decimal.NewFromInt(200),
+				WebhookURL: tt.webhookURL,
+			}
+
+			//when
+			err := ValidRule(rule)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+		})
+	}
+}
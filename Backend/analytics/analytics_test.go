@@ -0,0 +1,176 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+func decimals(values ...float64) []decimal.Decimal {
+	out := make([]decimal.Decimal, len(values))
+	for i, value := range values {
+		out[i] = decimal.NewFromFloat(value)
+	}
+	return out
+}
+
+// Series as strings rounded to 4 places, "-" while warming up
+func texts(series []*decimal.Decimal) []string {
+	out := make([]string, len(series))
+	for i, value := range series {
+		if value == nil {
+			out[i] = "-"
+			continue
+		}
+		out[i] = value.Round(4).String()
+	}
+	return out
+}
+
+func TestUnitAnalyticsIndicators(t *testing.T) {
+	testCases := []struct {
+		name     string
+		compute  func() [][]*decimal.Decimal
+		expected [][]string
+	}{
+		{
+			name: "sma",
+			compute: func() [][]*decimal.Decimal {
+				return [][]*decimal.Decimal{SMA(decimals(1, 2, 3, 4, 5), 3)}
+			},
+			expected: [][]string{{"-", "-", "2", "3", "4"}},
+		},
+		{
+			name: "ema seeded with sma",
+			compute: func() [][]*decimal.Decimal {
+				return [][]*decimal.Decimal{EMA(decimals(1, 2, 3, 4, 5), 3)}
+			},
+			expected: [][]string{{"-", "-", "2", "3", "4"}},
+		},
+		{
+			name: "rsi with wilder smoothing",
+			compute: func() [][]*decimal.Decimal {
+				return [][]*decimal.Decimal{RSI(decimals(1, 2, 3, 2), 2)}
+			},
+			expected: [][]string{{"-", "-", "100", "50"}},
+		},
+		{
+			name: "rsi shorter than its period",
+			compute: func() [][]*decimal.Decimal {
+				return [][]*decimal.Decimal{RSI(decimals(1, 2), 2)}
+			},
+			expected: [][]string{{"-", "-"}},
+		},
+		{
+			name: "macd warms up line then signal",
+			compute: func() [][]*decimal.Decimal {
+				line, signal, histogram := MACD(decimals(1, 2, 3, 4, 5, 6), 2, 3, 2)
+				return [][]*decimal.Decimal{line, signal, histogram}
+			},
+			expected: [][]string{
+				{"-", "-", "0.5", "0.5", "0.5", "0.5"},
+				{"-", "-", "-", "0.5", "0.5", "0.5"},
+				{"-", "-", "-", "0", "0", "0"},
+			},
+		},
+		{
+			name: "bollinger bands",
+			compute: func() [][]*decimal.Decimal {
+				middle, upper, lower := BollingerBands(decimals(1, 3, 3), 2, decimal.NewFromInt(2))
+				return [][]*decimal.Decimal{middle, upper, lower}
+			},
+			expected: [][]string{
+				{"-", "2", "3"},
+				{"-", "4", "3"},
+				{"-", "0", "3"},
+			},
+		},
+		{
+			name: "atr from true ranges with wilder smoothing",
+			compute: func() [][]*decimal.Decimal {
+				// True ranges 2 (high - low), 4 (from the close up to the high)
+				// and 5 (from the close down to the low)
+				highs, lows := decimals(11, 12, 15, 11), decimals(9, 10, 13, 9)
+				return [][]*decimal.Decimal{ATR(highs, lows, decimals(10, 11, 14, 10), 2)}
+			},
+			expected: [][]string{{"-", "-", "3", "4"}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			series := tt.compute()
+
+			//then
+			for i := range series {
+				assert.Equal(t, texts(series[i]), tt.expected[i])
+			}
+		})
+	}
+}
+
+func TestUnitAnalyticsSqrt(t *testing.T) {
+	assert.Equal(t, Sqrt(decimal.NewFromInt(2)).Round(8).String(), "1.41421356")
+	assert.Equal(t, Sqrt(decimal.NewFromInt(9)).Round(8).String(), "3")
+	assert.Equal(t, Sqrt(decimal.NewFromInt(-1)).String(), "0")
+}
+
+func TestUnitAnalyticsMedian(t *testing.T) {
+	assert.Equal(t, Median(decimals(3, -1, 2)).String(), "2")
+	assert.Equal(t, Median(decimals(0.4, 0.1, 0.3, 0.2)).String(), "0.25")
+	assert.Equal(t, Median(nil).String(), "0")
+}
+
+func TestUnitAnalyticsReturns(t *testing.T) {
+	//given
+	closes := decimals(100, 110, 99, 121)
+
+	//when
+	simple := SimpleReturns(closes)
+	logs := LogReturns(closes)
+	drawdown, peak, trough := MaxDrawdown(closes)
+
+	//then
+	assert.Equal(t, texts([]*decimal.Decimal{&simple[0], &simple[1], &simple[2]}),
+		[]string{"0.1", "-0.1", "0.2222"})
+	assert.Equal(t, logs[0].Round(4).String(), "0.0953")
+	assert.Equal(t, CumulativeReturn(closes).String(), "0.21")
+	assert.Equal(t, drawdown.String(), "-0.1")
+	assert.Equal(t, peak, 1)
+	assert.Equal(t, trough, 2)
+	assert.Equal(t, AnnualizedVolatility(simple, 252).Round(4).String(), "2.5823")
+	assert.Equal(t, texts([]*decimal.Decimal{Sharpe(simple, zero, 252)}), []string{"7.2288"})
+	assert.Equal(t, texts([]*decimal.Decimal{Sortino(simple, zero, 252)}), []string{"20.367"})
+	assert.Equal(t, Sharpe(decimals(0.1, 0.1), zero, 252), (*decimal.Decimal)(nil))
+	assert.Equal(t, Sortino(decimals(0.1, 0.2), zero, 252), (*decimal.Decimal)(nil))
+}
+
+func TestUnitAnalyticsCorrelation(t *testing.T) {
+	x := decimals(1, 2, 3, 4)
+	y := decimals(2, 4, 6, 8)
+	z := decimals(4, 3, 2, 1)
+
+	assert.Equal(t, Covariance(x, y).Round(4).String(), "3.3333")
+	assert.Equal(t, texts([]*decimal.Decimal{Correlation(x, y), Correlation(x, z)}),
+		[]string{"1", "-1"})
+	assert.Equal(t, Correlation(x, decimals(5, 5, 5, 5)), (*decimal.Decimal)(nil))
+	assert.Equal(t, texts(RollingCorrelation(x, decimals(1, 2, 1, 2), 3)),
+		[]string{"-", "-", "0", "0"})
+}
+
+func TestUnitAnalyticsBeta(t *testing.T) {
+	benchmark := decimals(0.01, -0.02, 0.03, 0)
+	asset := decimals(0.021, -0.039, 0.061, 0.001)
+
+	assert.Equal(t, texts([]*decimal.Decimal{
+		Beta(asset, benchmark),
+		Alpha(asset, benchmark, decimal.Zero, 252),
+		RSquared(asset, benchmark),
+		InformationRatio(asset, benchmark, 252),
+	}), []string{"2", "0.252", "1", "4.5755"})
+	assert.Equal(t, TrackingError(asset, benchmark, 252).Round(4).String(), "0.3305")
+	assert.Equal(t, Beta(asset, decimals(0.01, 0.01, 0.01, 0.01)), (*decimal.Decimal)(nil))
+	assert.Equal(t, texts(RollingBeta(asset, benchmark, 3)), []string{"-", "-", "2", "2"})
+}
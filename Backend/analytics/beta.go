@@ -0,0 +1,77 @@
+package analytics
+
+import "github.com/shopspring/decimal"
+
+// Slope of the asset's returns on the benchmark's; nil when the
+// benchmark doesn't vary
+func Beta(asset, benchmark []decimal.Decimal) *decimal.Decimal {
+	n := min(len(asset), len(benchmark))
+	variance := Covariance(benchmark[:n], benchmark[:n])
+	if variance.IsZero() {
+		return nil
+	}
+	return ptr(Covariance(asset[:n], benchmark[:n]).Div(variance))
+}
+
+// Jensen's alpha, annualized: the asset's mean excess return beyond
+// what beta times the benchmark's explains; nil without a beta
+func Alpha(asset, benchmark []decimal.Decimal, riskFree decimal.Decimal, periodsPerYear int) *decimal.Decimal {
+	beta := Beta(asset, benchmark)
+	if beta == nil {
+		return nil
+	}
+	n := min(len(asset), len(benchmark))
+	periods := decimal.NewFromInt(int64(periodsPerYear))
+	excess := meanExcess(asset[:n], riskFree, periodsPerYear).
+		Sub(beta.Mul(meanExcess(benchmark[:n], riskFree, periodsPerYear)))
+	return ptr(excess.Mul(periods))
+}
+
+// Share of the asset's variance the benchmark explains; nil when either
+// doesn't vary
+func RSquared(asset, benchmark []decimal.Decimal) *decimal.Decimal {
+	correlation := Correlation(asset, benchmark)
+	if correlation == nil {
+		return nil
+	}
+	return ptr(correlation.Mul(*correlation))
+}
+
+func activeReturns(asset, benchmark []decimal.Decimal) []decimal.Decimal {
+	n := min(len(asset), len(benchmark))
+	active := make([]decimal.Decimal, n)
+	for i := range n {
+		active[i] = asset[i].Sub(benchmark[i])
+	}
+	return active
+}
+
+// Annualized volatility of the returns beyond the benchmark's
+func TrackingError(asset, benchmark []decimal.Decimal, periodsPerYear int) decimal.Decimal {
+	return AnnualizedVolatility(activeReturns(asset, benchmark), periodsPerYear)
+}
+
+// Annualized mean return beyond the benchmark's per unit of tracking
+// error; nil when the asset tracks the benchmark exactly
+func InformationRatio(asset, benchmark []decimal.Decimal, periodsPerYear int) *decimal.Decimal {
+	trackingError := TrackingError(asset, benchmark, periodsPerYear)
+	if trackingError.IsZero() {
+		return nil
+	}
+	active := Mean(activeReturns(asset, benchmark)).Mul(decimal.NewFromInt(int64(periodsPerYear)))
+	return ptr(active.Div(trackingError))
+}
+
+// Beta over the trailing window at each point, nil until the window
+// is full
+func RollingBeta(asset, benchmark []decimal.Decimal, window int) []*decimal.Decimal {
+	n := min(len(asset), len(benchmark))
+	out := make([]*decimal.Decimal, n)
+	if window < 2 {
+		return out
+	}
+	for i := window - 1; i < n; i++ {
+		out[i] = Beta(asset[i-window+1:i+1], benchmark[i-window+1:i+1])
+	}
+	return out
+}
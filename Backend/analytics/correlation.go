@@ -0,0 +1,42 @@
+package analytics
+
+import "github.com/shopspring/decimal"
+
+// Sample covariance of two equally long series
+func Covariance(x, y []decimal.Decimal) decimal.Decimal {
+	n := min(len(x), len(y))
+	if n < 2 {
+		return zero
+	}
+	meanX, meanY := Mean(x[:n]), Mean(y[:n])
+	sum := zero
+	for i := range n {
+		sum = sum.Add(x[i].Sub(meanX).Mul(y[i].Sub(meanY)))
+	}
+	return sum.Div(decimal.NewFromInt(int64(n - 1)))
+}
+
+// Pearson correlation of two equally long series; nil when either
+// doesn't vary
+func Correlation(x, y []decimal.Decimal) *decimal.Decimal {
+	n := min(len(x), len(y))
+	deviations := SampleStdDev(x[:n]).Mul(SampleStdDev(y[:n]))
+	if deviations.IsZero() {
+		return nil
+	}
+	return ptr(Covariance(x[:n], y[:n]).Div(deviations))
+}
+
+// Correlation over the trailing window at each point, nil until the
+// window is full
+func RollingCorrelation(x, y []decimal.Decimal, window int) []*decimal.Decimal {
+	n := min(len(x), len(y))
+	out := make([]*decimal.Decimal, n)
+	if window < 2 {
+		return out
+	}
+	for i := window - 1; i < n; i++ {
+		out[i] = Correlation(x[i-window+1:i+1], y[i-window+1:i+1])
+	}
+	return out
+}
@@ -0,0 +1,173 @@
+package analytics
+
+import "github.com/shopspring/decimal"
+
+// Indicator series are aligned with their input: entry i belongs to
+// input i, and is nil while the indicator is still warming up
+
+// Simple moving average over period values
+func SMA(values []decimal.Decimal, period int) []*decimal.Decimal {
+	out := make([]*decimal.Decimal, len(values))
+	if period < 1 {
+		return out
+	}
+	size := decimal.NewFromInt(int64(period))
+	sum := zero
+	for i, value := range values {
+		sum = sum.Add(value)
+		if i >= period {
+			sum = sum.Sub(values[i-period])
+		}
+		if i >= period-1 {
+			out[i] = ptr(sum.Div(size))
+		}
+	}
+	return out
+}
+
+// Exponential moving average, seeded with the simple average
+// of its first period values
+func EMA(values []decimal.Decimal, period int) []*decimal.Decimal {
+	in := make([]*decimal.Decimal, len(values))
+	for i := range values {
+		in[i] = &values[i]
+	}
+	return emaOf(in, period)
+}
+
+// EMA over a series that may itself start with a warm-up
+func emaOf(values []*decimal.Decimal, period int) []*decimal.Decimal {
+	out := make([]*decimal.Decimal, len(values))
+	if period < 1 {
+		return out
+	}
+	alpha := two.Div(decimal.NewFromInt(int64(period + 1)))
+
+	start := 0
+	for start < len(values) && values[start] == nil {
+		start++
+	}
+	seed := start + period - 1
+	if seed >= len(values) {
+		return out
+	}
+
+	sum := zero
+	for i := start; i <= seed; i++ {
+		sum = sum.Add(*values[i])
+	}
+	prev := sum.Div(decimal.NewFromInt(int64(period)))
+	out[seed] = ptr(prev)
+	for i := seed + 1; i < len(values); i++ {
+		prev = values[i].Sub(prev).Mul(alpha).Add(prev)
+		out[i] = ptr(prev)
+	}
+	return out
+}
+
+// Relative strength index with Wilder's smoothing;
+// the first value needs period changes, so period + 1 closes
+func RSI(closes []decimal.Decimal, period int) []*decimal.Decimal {
+	out := make([]*decimal.Decimal, len(closes))
+	if period < 1 || len(closes) <= period {
+		return out
+	}
+	size := decimal.NewFromInt(int64(period))
+	hundred := decimal.NewFromInt(100)
+
+	rsi := func(gain, loss decimal.Decimal) *decimal.Decimal {
+		if loss.IsZero() {
+			return ptr(hundred)
+		}
+		return ptr(hundred.Sub(hundred.Div(one.Add(gain.Div(loss)))))
+	}
+
+	gain, loss := zero, zero
+	for i := 1; i <= period; i++ {
+		change := closes[i].Sub(closes[i-1])
+		if change.IsPositive() {
+			gain = gain.Add(change)
+		} else {
+			loss = loss.Sub(change)
+		}
+	}
+	gain, loss = gain.Div(size), loss.Div(size)
+	out[period] = rsi(gain, loss)
+
+	for i := period + 1; i < len(closes); i++ {
+		change := closes[i].Sub(closes[i-1])
+		up, down := zero, zero
+		if change.IsPositive() {
+			up = change
+		} else {
+			down = change.Neg()
+		}
+		gain = gain.Mul(size.Sub(one)).Add(up).Div(size)
+		loss = loss.Mul(size.Sub(one)).Add(down).Div(size)
+		out[i] = rsi(gain, loss)
+	}
+	return out
+}
+
+// MACD line (fast EMA - slow EMA), its signal EMA and their difference
+func MACD(closes []decimal.Decimal, fast, slow, signal int) (line, signalLine, histogram []*decimal.Decimal) {
+	fastEMA, slowEMA := EMA(closes, fast), EMA(closes, slow)
+	line = make([]*decimal.Decimal, len(closes))
+	for i := range closes {
+		if fastEMA[i] != nil && slowEMA[i] != nil {
+			line[i] = ptr(fastEMA[i].Sub(*slowEMA[i]))
+		}
+	}
+
+	signalLine = emaOf(line, signal)
+	histogram = make([]*decimal.Decimal, len(closes))
+	for i := range closes {
+		if line[i] != nil && signalLine[i] != nil {
+			histogram[i] = ptr(line[i].Sub(*signalLine[i]))
+		}
+	}
+	return line, signalLine, histogram
+}
+
+// Bollinger bands: simple average plus and minus width standard deviations
+func BollingerBands(closes []decimal.Decimal, period int, width decimal.Decimal) (middle, upper, lower []*decimal.Decimal) {
+	middle = SMA(closes, period)
+	upper = make([]*decimal.Decimal, len(closes))
+	lower = make([]*decimal.Decimal, len(closes))
+	for i := range closes {
+		if middle[i] == nil {
+			continue
+		}
+		band := StdDev(closes[i-period+1 : i+1]).Mul(width)
+		upper[i] = ptr(middle[i].Add(band))
+		lower[i] = ptr(middle[i].Sub(band))
+	}
+	return middle, upper, lower
+}
+
+// Average true range with Wilder's smoothing; true ranges reach back
+// to the close before, so the first value needs period + 1 bars
+func ATR(highs, lows, closes []decimal.Decimal, period int) []*decimal.Decimal {
+	out := make([]*decimal.Decimal, len(closes))
+	if period < 1 || len(closes) <= period {
+		return out
+	}
+	size := decimal.NewFromInt(int64(period))
+	trueRange := func(i int) decimal.Decimal {
+		return decimal.Max(highs[i].Sub(lows[i]),
+			highs[i].Sub(closes[i-1]).Abs(),
+			lows[i].Sub(closes[i-1]).Abs())
+	}
+
+	sum := zero
+	for i := 1; i <= period; i++ {
+		sum = sum.Add(trueRange(i))
+	}
+	atr := sum.Div(size)
+	out[period] = ptr(atr)
+	for i := period + 1; i < len(closes); i++ {
+		atr = atr.Mul(size.Sub(one)).Add(trueRange(i)).Div(size)
+		out[i] = ptr(atr)
+	}
+	return out
+}
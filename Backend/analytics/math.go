@@ -0,0 +1,84 @@
+package analytics
+
+import (
+	"math"
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	zero = decimal.Zero
+	one  = decimal.NewFromInt(1)
+	two  = decimal.NewFromInt(2)
+)
+
+// Square root to decimal precision, refined from the float estimate
+func Sqrt(d decimal.Decimal) decimal.Decimal {
+	if !d.IsPositive() {
+		return zero
+	}
+	x := decimal.NewFromFloat(math.Sqrt(d.InexactFloat64()))
+	if !x.IsPositive() {
+		return zero
+	}
+	for range 3 {
+		x = x.Add(d.Div(x)).Div(two)
+	}
+	return x
+}
+
+func Mean(values []decimal.Decimal) decimal.Decimal {
+	if len(values) == 0 {
+		return zero
+	}
+	return decimal.Sum(zero, values...).Div(decimal.NewFromInt(int64(len(values))))
+}
+
+// Middle value, or the mean of the middle two
+func Median(values []decimal.Decimal) decimal.Decimal {
+	if len(values) == 0 {
+		return zero
+	}
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b decimal.Decimal) int {
+		return a.Cmp(b)
+	})
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).Div(two)
+}
+
+// Population standard deviation
+func StdDev(values []decimal.Decimal) decimal.Decimal {
+	if len(values) == 0 {
+		return zero
+	}
+	mean := Mean(values)
+	squares := make([]decimal.Decimal, len(values))
+	for i, value := range values {
+		diff := value.Sub(mean)
+		squares[i] = diff.Mul(diff)
+	}
+	return Sqrt(Mean(squares))
+}
+
+// Sample standard deviation (n - 1 degrees of freedom)
+func SampleStdDev(values []decimal.Decimal) decimal.Decimal {
+	if len(values) < 2 {
+		return zero
+	}
+	mean := Mean(values)
+	sum := zero
+	for _, value := range values {
+		diff := value.Sub(mean)
+		sum = sum.Add(diff.Mul(diff))
+	}
+	return Sqrt(sum.Div(decimal.NewFromInt(int64(len(values) - 1))))
+}
+
+func ptr(d decimal.Decimal) *decimal.Decimal {
+	return &d
+}
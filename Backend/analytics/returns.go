@@ -0,0 +1,111 @@
+package analytics
+
+import "github.com/shopspring/decimal"
+
+// Places kept by natural logarithms
+const lnPlaces int32 = 16
+
+// Close-to-close returns; the result is one shorter than closes
+func SimpleReturns(closes []decimal.Decimal) []decimal.Decimal {
+	if len(closes) < 2 {
+		return []decimal.Decimal{}
+	}
+	returns := make([]decimal.Decimal, len(closes)-1)
+	for i := 1; i < len(closes); i++ {
+		if closes[i-1].IsZero() {
+			returns[i-1] = zero
+			continue
+		}
+		returns[i-1] = closes[i].Div(closes[i-1]).Sub(one)
+	}
+	return returns
+}
+
+// Continuously compounded returns, ln(close / previous close)
+func LogReturns(closes []decimal.Decimal) []decimal.Decimal {
+	if len(closes) < 2 {
+		return []decimal.Decimal{}
+	}
+	returns := make([]decimal.Decimal, len(closes)-1)
+	for i := 1; i < len(closes); i++ {
+		if !closes[i].IsPositive() || !closes[i-1].IsPositive() {
+			returns[i-1] = zero
+			continue
+		}
+		ln, err := closes[i].Div(closes[i-1]).Ln(lnPlaces)
+		if err != nil {
+			ln = zero
+		}
+		returns[i-1] = ln
+	}
+	return returns
+}
+
+// Growth from the first close to the last
+func CumulativeReturn(closes []decimal.Decimal) decimal.Decimal {
+	if len(closes) < 2 || closes[0].IsZero() {
+		return zero
+	}
+	return closes[len(closes)-1].Div(closes[0]).Sub(one)
+}
+
+// Sample standard deviation of daily returns scaled to a year
+func AnnualizedVolatility(returns []decimal.Decimal, periodsPerYear int) decimal.Decimal {
+	return SampleStdDev(returns).Mul(Sqrt(decimal.NewFromInt(int64(periodsPerYear))))
+}
+
+// Annualized mean excess return over its volatility; nil when returns
+// don't vary
+func Sharpe(returns []decimal.Decimal, riskFree decimal.Decimal, periodsPerYear int) *decimal.Decimal {
+	deviation := SampleStdDev(returns)
+	if deviation.IsZero() {
+		return nil
+	}
+	return ptr(meanExcess(returns, riskFree, periodsPerYear).Div(deviation).
+		Mul(Sqrt(decimal.NewFromInt(int64(periodsPerYear)))))
+}
+
+// Like Sharpe, but only returns below the risk-free rate count as risk;
+// nil when there are none
+func Sortino(returns []decimal.Decimal, riskFree decimal.Decimal, periodsPerYear int) *decimal.Decimal {
+	if len(returns) == 0 {
+		return nil
+	}
+	periodRate := riskFree.Div(decimal.NewFromInt(int64(periodsPerYear)))
+	sum := zero
+	for _, r := range returns {
+		if excess := r.Sub(periodRate); excess.IsNegative() {
+			sum = sum.Add(excess.Mul(excess))
+		}
+	}
+	deviation := Sqrt(sum.Div(decimal.NewFromInt(int64(len(returns)))))
+	if deviation.IsZero() {
+		return nil
+	}
+	return ptr(meanExcess(returns, riskFree, periodsPerYear).Div(deviation).
+		Mul(Sqrt(decimal.NewFromInt(int64(periodsPerYear)))))
+}
+
+func meanExcess(returns []decimal.Decimal, riskFree decimal.Decimal, periodsPerYear int) decimal.Decimal {
+	return Mean(returns).Sub(riskFree.Div(decimal.NewFromInt(int64(periodsPerYear))))
+}
+
+// Largest fall from a running peak close, as a non-positive fraction,
+// with the indexes of that peak and the trough after it
+func MaxDrawdown(closes []decimal.Decimal) (decimal.Decimal, int, int) {
+	drawdown, peak, trough := zero, 0, 0
+	high := 0
+	for i, close := range closes {
+		if close.GreaterThan(closes[high]) {
+			high = i
+		}
+		if closes[high].IsZero() {
+			continue
+		}
+		fall := close.Div(closes[high]).Sub(one)
+		if fall.LessThan(drawdown) {
+			drawdown, peak, trough = fall, high, i
+		}
+	}
+	return drawdown, peak, trough
+}
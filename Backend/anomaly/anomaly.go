@@ -0,0 +1,122 @@
+package anomaly
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+
+	"github.com/shopspring/decimal"
+)
+
+var madScale = decimal.RequireFromString(constant.MADScale)
+
+// Check the scoring parameters, filling in the defaults of what's left out
+func Normalize(params *dto.AnomalyParams) error {
+	if params.Method == "" {
+		params.Method = constant.AnomalyZScore
+	}
+	threshold, ok := constant.DefaultAnomalyThresholds[params.Method]
+	if !ok {
+		return constant.ErrInvalidAnomaly
+	}
+	if params.Threshold == nil {
+		d := decimal.RequireFromString(threshold)
+		params.Threshold = &d
+	}
+	if params.Window == 0 {
+		params.Window = constant.DefaultAnomalyWindow
+	}
+	if !params.Threshold.IsPositive() || params.Window < 3 {
+		return constant.ErrInvalidAnomaly
+	}
+	return nil
+}
+
+// Score of each value against the window values before it, aligned with
+// values; nil until a full window precedes it, where values given as nil
+// are, and where the window doesn't vary
+func Scores(values []*decimal.Decimal, window int, method string) []*decimal.Decimal {
+	out := make([]*decimal.Decimal, len(values))
+	for i := window; i < len(values); i++ {
+		if values[i] == nil {
+			continue
+		}
+		past := make([]decimal.Decimal, 0, window)
+		for _, value := range values[i-window : i] {
+			if value != nil {
+				past = append(past, *value)
+			}
+		}
+		if len(past) < window {
+			continue
+		}
+		out[i] = score(*values[i], past, method)
+	}
+	return out
+}
+
+func score(value decimal.Decimal, past []decimal.Decimal, method string) *decimal.Decimal {
+	if method == constant.AnomalyMAD {
+		median := analytics.Median(past)
+		deviations := make([]decimal.Decimal, len(past))
+		for i, p := range past {
+			deviations[i] = p.Sub(median).Abs()
+		}
+		mad := analytics.Median(deviations)
+		if mad.IsZero() {
+			return nil
+		}
+		s := madScale.Mul(value.Sub(median)).Div(mad)
+		return &s
+	}
+	std := analytics.SampleStdDev(past)
+	if std.IsZero() {
+		return nil
+	}
+	s := value.Sub(analytics.Mean(past)).Div(std)
+	return &s
+}
+
+// Return and volume flags of each bar, aligned with bars and nil where
+// neither strays past the threshold. Returns are from the close before,
+// so the first bar only serves as a close.
+func Detect(bars []dto.DailyOHLCVRes, params dto.AnomalyParams) [][]dto.AnomalyFlagRes {
+	returns := make([]*decimal.Decimal, len(bars))
+	volumes := make([]*decimal.Decimal, len(bars))
+	for i, bar := range bars {
+		volume := decimal.NewFromInt(int64(bar.Volume))
+		volumes[i] = &volume
+		if i == 0 || bars[i-1].OHLC["close"].IsZero() {
+			continue
+		}
+		r := bar.OHLC["close"].Div(bars[i-1].OHLC["close"]).Sub(decimal.NewFromInt(1))
+		returns[i] = &r
+	}
+
+	out := make([][]dto.AnomalyFlagRes, len(bars))
+	metrics := []struct {
+		name   string
+		values []*decimal.Decimal
+	}{
+		{constant.AnomalyReturn, returns},
+		{constant.AnomalyVolume, volumes},
+	}
+	for _, metric := range metrics {
+		for i, s := range Scores(metric.values, params.Window, params.Method) {
+			if s == nil || s.Abs().LessThan(*params.Threshold) {
+				continue
+			}
+			direction := constant.AnomalyHigh
+			if s.IsNegative() {
+				direction = constant.AnomalyLow
+			}
+			out[i] = append(out[i], dto.AnomalyFlagRes{
+				Metric:    metric.name,
+				Value:     metric.values[i].Round(constant.AnalyticsPlaces),
+				Score:     s.Round(constant.AnalyticsPlaces),
+				Direction: direction,
+			})
+		}
+	}
+	return out
+}
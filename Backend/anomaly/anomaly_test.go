@@ -0,0 +1,146 @@
+package anomaly
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"fmt"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+func values(in ...float64) []*decimal.Decimal {
+	out := make([]*decimal.Decimal, len(in))
+	for i, value := range in {
+		d := decimal.NewFromFloat(value)
+		out[i] = &d
+	}
+	return out
+}
+
+// Scores as strings rounded to 4 places, "-" where there is none
+func texts(scores []*decimal.Decimal) []string {
+	out := make([]string, len(scores))
+	for i, score := range scores {
+		if score == nil {
+			out[i] = "-"
+			continue
+		}
+		out[i] = score.Round(4).String()
+	}
+	return out
+}
+
+func TestUnitAnomalyNormalize(t *testing.T) {
+	negative := decimal.NewFromInt(-1)
+
+	testCases := []struct {
+		name              string
+		params            dto.AnomalyParams
+		expectedMethod    string
+		expectedThreshold string
+		expectedWindow    int
+		expectedErr       error
+	}{
+		{
+			name:              "defaults",
+			expectedMethod:    constant.AnomalyZScore,
+			expectedThreshold: "3",
+			expectedWindow:    constant.DefaultAnomalyWindow,
+		},
+		{
+			name:              "mad has its own default threshold",
+			params:            dto.AnomalyParams{Method: constant.AnomalyMAD, Window: 10},
+			expectedMethod:    constant.AnomalyMAD,
+			expectedThreshold: "3.5",
+			expectedWindow:    10,
+		},
+		{
+			name:        "unknown method",
+			params:      dto.AnomalyParams{Method: "iqr"},
+			expectedErr: constant.ErrInvalidAnomaly,
+		},
+		{
+			name:        "negative threshold",
+			params:      dto.AnomalyParams{Threshold: &negative},
+			expectedErr: constant.ErrInvalidAnomaly,
+		},
+		{
+			name:        "window too short",
+			params:      dto.AnomalyParams{Window: 2},
+			expectedErr: constant.ErrInvalidAnomaly,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			err := Normalize(&tt.params)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, tt.params.Method, tt.expectedMethod)
+				assert.Equal(t, tt.params.Threshold.String(), tt.expectedThreshold)
+				assert.Equal(t, tt.params.Window, tt.expectedWindow)
+			}
+		})
+	}
+}
+
+func TestUnitAnomalyScores(t *testing.T) {
+	assert.Equal(t, texts(Scores(values(1, 2, 3, 4, 10), 4, constant.AnomalyZScore)),
+		[]string{"-", "-", "-", "-", "5.8095"})
+	assert.Equal(t, texts(Scores(values(1, 2, 3, 4, 10), 4, constant.AnomalyMAD)),
+		[]string{"-", "-", "-", "-", "5.0588"})
+	// A flat window gives no scale to measure against
+	assert.Equal(t, texts(Scores(values(5, 5, 5, 5, 9), 4, constant.AnomalyZScore)),
+		[]string{"-", "-", "-", "-", "-"})
+	// Missing values leave the windows holding them short
+	assert.Equal(t, texts(Scores(append([]*decimal.Decimal{nil}, values(1, 2, 3, 4, 10)...), 4, constant.AnomalyZScore)),
+		[]string{"-", "-", "-", "-", "-", "5.8095"})
+}
+
+func TestUnitAnomalyDetect(t *testing.T) {
+	threshold := decimal.NewFromInt(3)
+	params := dto.AnomalyParams{Method: constant.AnomalyZScore, Threshold: &threshold, Window: 4}
+
+	testCases := []struct {
+		name     string
+		bars     []dto.DailyOHLCVRes
+		expected []string
+	}{
+		{
+			name: "price and volume spike",
+			bars: util.WithVolumes(util.Bars("2025-06-02", 100, 101, 100, 101, 100, 101, 120),
+				100, 110, 90, 100, 110, 90, 500),
+			expected: []string{"6 return high 16.3683", "6 volume high 42.0398"},
+		},
+		{
+			name: "volume drying up",
+			bars: util.WithVolumes(util.Bars("2025-06-02", 100, 101, 100, 101, 100, 101, 100),
+				100, 110, 90, 100, 110, 90, 10),
+			expected: []string{"6 volume low -9.1391"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			output := Detect(tt.bars, params)
+
+			//then
+			assert.Equal(t, len(output), len(tt.bars))
+			found := make([]string, 0)
+			for i, flags := range output {
+				for _, flag := range flags {
+					found = append(found, fmt.Sprintf("%d %s %s %s",
+						i, flag.Metric, flag.Direction, flag.Score))
+				}
+			}
+			assert.Equal(t, found, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package backtest
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+
+	"github.com/shopspring/decimal"
+)
+
+var one = decimal.NewFromInt(1)
+
+// Signals decided at a bar's close, filled at the next bar's open
+const (
+	hold = iota
+	enter
+	exit
+)
+
+// Check a request, filling in the defaults of what it leaves out, so the
+// stored result records the parameters actually used
+func Normalize(req *dto.BacktestReq) error {
+	switch req.Strategy {
+	case constant.StrategySMACrossover:
+		if req.Fast == 0 {
+			req.Fast = constant.DefaultFastPeriod
+		}
+		if req.Slow == 0 {
+			req.Slow = constant.DefaultSlowPeriod
+		}
+		if req.Fast < 1 || req.Slow <= req.Fast {
+			return constant.ErrInvalidBacktest
+		}
+		req.Days, req.ExitDays = 0, 0
+	case constant.StrategyBreakout:
+		if req.Days == 0 {
+			req.Days = constant.DefaultBreakoutDays
+		}
+		if req.ExitDays == 0 {
+			req.ExitDays = constant.DefaultExitDays
+		}
+		if req.Days < 1 || req.ExitDays < 1 {
+			return constant.ErrInvalidBacktest
+		}
+		req.Fast, req.Slow = 0, 0
+	case constant.StrategyBuyAndHold:
+		req.Fast, req.Slow, req.Days, req.ExitDays = 0, 0, 0, 0
+	default:
+		return constant.ErrInvalidStrategy
+	}
+
+	if req.InitialCapital.IsZero() {
+		req.InitialCapital = decimal.RequireFromString(constant.DefaultInitialCapital)
+	}
+	if !req.InitialCapital.IsPositive() ||
+		req.Commission.IsNegative() || !req.Commission.LessThan(one) ||
+		req.Slippage.IsNegative() || !req.Slippage.LessThan(one) {
+		return constant.ErrInvalidBacktest
+	}
+	return nil
+}
+
+// The signal at the close of each bar; bars before start only warm up
+// the indicators
+func signals(req *dto.BacktestReq, bars []dto.DailyOHLCVRes, start int) []int {
+	out := make([]int, len(bars))
+	switch req.Strategy {
+	case constant.StrategyBuyAndHold:
+		out[start] = enter
+
+	case constant.StrategySMACrossover:
+		closes := make([]decimal.Decimal, len(bars))
+		for i, bar := range bars {
+			closes[i] = bar.OHLC["close"]
+		}
+		fast := analytics.SMA(closes, req.Fast)
+		slow := analytics.SMA(closes, req.Slow)
+		for i := max(start, 1); i < len(bars); i++ {
+			if fast[i-1] == nil || slow[i-1] == nil || fast[i] == nil || slow[i] == nil {
+				continue
+			}
+			before := fast[i-1].Cmp(*slow[i-1])
+			now := fast[i].Cmp(*slow[i])
+			if before <= 0 && now > 0 {
+				out[i] = enter
+			} else if before >= 0 && now < 0 {
+				out[i] = exit
+			}
+		}
+
+	case constant.StrategyBreakout:
+		for i := start; i < len(bars); i++ {
+			close := bars[i].OHLC["close"]
+			if i >= req.Days {
+				high := bars[i-req.Days].OHLC["high"]
+				for _, bar := range bars[i-req.Days : i] {
+					high = decimal.Max(high, bar.OHLC["high"])
+				}
+				if close.GreaterThan(high) {
+					out[i] = enter
+					continue
+				}
+			}
+			if i >= req.ExitDays {
+				low := bars[i-req.ExitDays].OHLC["low"]
+				for _, bar := range bars[i-req.ExitDays : i] {
+					low = decimal.Min(low, bar.OHLC["low"])
+				}
+				if close.LessThan(low) {
+					out[i] = exit
+				}
+			}
+		}
+	}
+	return out
+}
+
+// What a strategy did over the bars from start
+type run struct {
+	trades     []dto.BacktestTradeRes
+	equity     []dto.EquityPointRes
+	commission decimal.Decimal
+	held       int
+}
+
+// Trade whole shares with all the cash, long only. Entries pay slippage
+// above the open and exits receive it below; commission comes on top.
+func simulate(req *dto.BacktestReq, bars []dto.DailyOHLCVRes, start int, signals []int) *run {
+	r := &run{
+		trades:     make([]dto.BacktestTradeRes, 0),
+		equity:     make([]dto.EquityPointRes, 0, len(bars)-start),
+		commission: decimal.Zero,
+	}
+	cash, quantity, cost := req.InitialCapital, decimal.Zero, decimal.Zero
+	var trade *dto.BacktestTradeRes
+
+	for i := start; i < len(bars); i++ {
+		bar := bars[i]
+		if i > start {
+			switch {
+			case signals[i-1] == enter && trade == nil:
+				price := bar.OHLC["open"].Mul(one.Add(req.Slippage))
+				shares := cash.Div(price.Mul(one.Add(req.Commission))).Floor()
+				if !shares.IsPositive() {
+					break
+				}
+				fee := shares.Mul(price).Mul(req.Commission)
+				cost = shares.Mul(price).Add(fee)
+				cash = cash.Sub(cost)
+				quantity = shares
+				r.commission = r.commission.Add(fee)
+				trade = &dto.BacktestTradeRes{
+					EntryDate:  bar.Day,
+					EntryPrice: price,
+					Quantity:   shares,
+					Commission: fee,
+				}
+			case signals[i-1] == exit && trade != nil:
+				price := bar.OHLC["open"].Mul(one.Sub(req.Slippage))
+				proceeds := quantity.Mul(price)
+				fee := proceeds.Mul(req.Commission)
+				cash = cash.Add(proceeds).Sub(fee)
+				r.commission = r.commission.Add(fee)
+
+				day := bar.Day
+				trade.ExitDate = &day
+				trade.ExitPrice = &price
+				trade.Commission = trade.Commission.Add(fee)
+				trade.PnL = proceeds.Sub(fee).Sub(cost)
+				trade.Return = trade.PnL.Div(cost)
+				r.trades = append(r.trades, *trade)
+				trade, quantity = nil, decimal.Zero
+			}
+		}
+
+		position := quantity.Mul(bar.OHLC["close"])
+		if trade != nil {
+			r.held++
+		}
+		r.equity = append(r.equity, dto.EquityPointRes{
+			Date:     bar.Day,
+			Cash:     cash,
+			Position: position,
+			Equity:   cash.Add(position),
+		})
+	}
+
+	// Still open at the end; marked at the last close
+	if trade != nil {
+		trade.PnL = r.equity[len(r.equity)-1].Position.Sub(cost)
+		trade.Return = trade.PnL.Div(cost)
+		trade.Open = true
+		r.trades = append(r.trades, *trade)
+	}
+	return r
+}
+
+// Run a normalized request over date-sorted bars, trading from the bar
+// at start on; the result has no id or creation time yet
+func Run(req *dto.BacktestReq, bars []dto.DailyOHLCVRes, start int, riskFree decimal.Decimal) *dto.BacktestRes {
+	r := simulate(req, bars, start, signals(req, bars, start))
+	baseline := r
+	if req.Strategy != constant.StrategyBuyAndHold {
+		baseline = simulate(req, bars, start, signals(
+			&dto.BacktestReq{Strategy: constant.StrategyBuyAndHold}, bars, start))
+	}
+
+	equities := make([]decimal.Decimal, len(r.equity))
+	for i, point := range r.equity {
+		equities[i] = point.Equity
+	}
+	final := equities[len(equities)-1]
+	growth := final.Div(req.InitialCapital)
+	periods := constant.PeriodsPerYear[constant.IntervalDay]
+	annualized := decimal.Zero
+	if len(equities) > 1 && growth.IsPositive() {
+		scaled, err := growth.PowWithPrecision(
+			decimal.NewFromInt(int64(periods)).Div(decimal.NewFromInt(int64(len(equities)-1))), 16)
+		if err == nil {
+			annualized = scaled.Sub(one)
+		}
+	}
+	drawdown, peak, trough := analytics.MaxDrawdown(equities)
+
+	closed, wins := 0, 0
+	for _, trade := range r.trades {
+		if trade.Open {
+			continue
+		}
+		closed++
+		if trade.PnL.IsPositive() {
+			wins++
+		}
+	}
+	var winRate *decimal.Decimal
+	if closed > 0 {
+		rate := decimal.NewFromInt(int64(wins)).Div(decimal.NewFromInt(int64(closed))).
+			Round(constant.AnalyticsPlaces)
+		winRate = &rate
+	}
+	var sharpe *decimal.Decimal
+	if ratio := analytics.Sharpe(analytics.SimpleReturns(equities), riskFree, periods); ratio != nil {
+		rounded := ratio.Round(constant.AnalyticsPlaces)
+		sharpe = &rounded
+	}
+	baselineFinal := baseline.equity[len(baseline.equity)-1].Equity
+
+	res := &dto.BacktestRes{
+		Symbol:         req.Symbol,
+		Strategy:       req.Strategy,
+		Adjust:         req.Adjust,
+		From:           bars[start].Day,
+		To:             bars[len(bars)-1].Day,
+		InitialCapital: req.InitialCapital,
+		Commission:     req.Commission,
+		Slippage:       req.Slippage,
+		Fast:           req.Fast,
+		Slow:           req.Slow,
+		Days:           req.Days,
+		ExitDays:       req.ExitDays,
+		Summary: dto.BacktestSummaryRes{
+			FinalEquity:      final.Round(constant.MoneyPlaces),
+			TotalReturn:      growth.Sub(one).Round(constant.AnalyticsPlaces),
+			AnnualizedReturn: annualized.Round(constant.AnalyticsPlaces),
+			MaxDrawdown: dto.DrawdownRes{
+				Value:  drawdown.Round(constant.AnalyticsPlaces),
+				Peak:   r.equity[peak].Date,
+				Trough: r.equity[trough].Date,
+			},
+			Sharpe:  sharpe,
+			Trades:  len(r.trades),
+			WinRate: winRate,
+			Exposure: decimal.NewFromInt(int64(r.held)).
+				Div(decimal.NewFromInt(int64(len(r.equity)))).Round(constant.AnalyticsPlaces),
+			Commission: r.commission.Round(constant.MoneyPlaces),
+			BuyAndHoldReturn: baselineFinal.Div(req.InitialCapital).Sub(one).
+				Round(constant.AnalyticsPlaces),
+		},
+		Trades: r.trades,
+		Equity: r.equity,
+	}
+	for i := range res.Trades {
+		trade := &res.Trades[i]
+		trade.EntryPrice = trade.EntryPrice.Round(constant.AnalyticsPlaces)
+		if trade.ExitPrice != nil {
+			price := trade.ExitPrice.Round(constant.AnalyticsPlaces)
+			trade.ExitPrice = &price
+		}
+		trade.Commission = trade.Commission.Round(constant.MoneyPlaces)
+		trade.PnL = trade.PnL.Round(constant.MoneyPlaces)
+		trade.Return = trade.Return.Round(constant.AnalyticsPlaces)
+	}
+	for i := range res.Equity {
+		point := &res.Equity[i]
+		point.Cash = point.Cash.Round(constant.MoneyPlaces)
+		point.Position = point.Position.Round(constant.MoneyPlaces)
+		point.Equity = point.Equity.Round(constant.MoneyPlaces)
+	}
+	return res
+}
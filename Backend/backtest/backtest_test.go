@@ -0,0 +1,238 @@
+package backtest
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+// Bars on consecutive days from 2025-06-02, each as open, high, low, close
+func bars(prices ...[4]float64) []dto.DailyOHLCVRes {
+	start := util.Date("2025-06-02")
+	out := make([]dto.DailyOHLCVRes, len(prices))
+	for i, p := range prices {
+		out[i] = dto.DailyOHLCVRes{
+			Day: start.AddDate(0, 0, i),
+			OHLC: map[string]decimal.Decimal{
+				"open":  decimal.NewFromFloat(p[0]),
+				"high":  decimal.NewFromFloat(p[1]),
+				"low":   decimal.NewFromFloat(p[2]),
+				"close": decimal.NewFromFloat(p[3]),
+			},
+			Volume: 100,
+		}
+	}
+	return out
+}
+
+func TestUnitBacktestNormalize(t *testing.T) {
+	testCases := []struct {
+		name         string
+		req          dto.BacktestReq
+		expectedErr  error
+		expectedFast int
+		expectedSlow int
+	}{
+		{
+			name:         "crossover defaults",
+			req:          dto.BacktestReq{Strategy: constant.StrategySMACrossover},
+			expectedFast: constant.DefaultFastPeriod,
+			expectedSlow: constant.DefaultSlowPeriod,
+		},
+		{
+			name:        "fast not below slow",
+			req:         dto.BacktestReq{Strategy: constant.StrategySMACrossover, Fast: 50, Slow: 20},
+			expectedErr: constant.ErrInvalidBacktest,
+		},
+		{
+			name:        "commission of the whole trade",
+			req:         dto.BacktestReq{Strategy: constant.StrategyBuyAndHold, Commission: decimal.NewFromInt(1)},
+			expectedErr: constant.ErrInvalidBacktest,
+		},
+		{
+			name:        "negative breakout days",
+			req:         dto.BacktestReq{Strategy: constant.StrategyBreakout, Days: -1},
+			expectedErr: constant.ErrInvalidBacktest,
+		},
+		{
+			name:        "unknown strategy",
+			req:         dto.BacktestReq{Strategy: "martingale"},
+			expectedErr: constant.ErrInvalidStrategy,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			err := Normalize(&tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, tt.req.Fast, tt.expectedFast)
+				assert.Equal(t, tt.req.Slow, tt.expectedSlow)
+				assert.Equal(t, tt.req.InitialCapital.String(), constant.DefaultInitialCapital)
+			}
+		})
+	}
+}
+
+func TestUnitBacktestRun(t *testing.T) {
+	testCases := []struct {
+		name               string
+		req                dto.BacktestReq
+		bars               []dto.DailyOHLCVRes
+		expectedTrades     []dto.BacktestTradeRes
+		expectedFinal      string
+		expectedReturn     string
+		expectedDrawdown   string
+		expectedWinRate    string
+		expectedExposure   string
+		expectedCommission string
+		expectedBaseline   string
+	}{
+		{
+			name: "buy and hold fills at the next open and stays open",
+			req: dto.BacktestReq{Strategy: constant.StrategyBuyAndHold,
+				InitialCapital: decimal.NewFromInt(1000)},
+			bars: bars(
+				[4]float64{10, 10, 10, 10},
+				[4]float64{10, 11, 10, 11},
+				[4]float64{11, 12, 11, 12},
+				[4]float64{12, 15, 12, 15},
+			),
+			expectedTrades: []dto.BacktestTradeRes{{
+				EntryDate:  util.Date("2025-06-03"),
+				EntryPrice: decimal.NewFromInt(10),
+				Quantity:   decimal.NewFromInt(100),
+				PnL:        decimal.NewFromInt(500),
+				Return:     decimal.RequireFromString("0.5"),
+				Open:       true,
+			}},
+			expectedFinal:      "1500",
+			expectedReturn:     "0.5",
+			expectedDrawdown:   "0",
+			expectedWinRate:    "",
+			expectedExposure:   "0.75",
+			expectedCommission: "0",
+			expectedBaseline:   "0.5",
+		},
+		{
+			name: "sma crossover with commission and slippage",
+			req: dto.BacktestReq{Strategy: constant.StrategySMACrossover, Fast: 1, Slow: 2,
+				InitialCapital: decimal.NewFromInt(1000),
+				Commission:     decimal.RequireFromString("0.001"),
+				Slippage:       decimal.RequireFromString("0.01")},
+			bars: bars(
+				[4]float64{10, 10, 10, 10},
+				[4]float64{9, 9, 9, 9},
+				[4]float64{8, 8, 8, 8},
+				[4]float64{9, 10, 9, 10},
+				[4]float64{11, 12, 11, 12},
+				[4]float64{12, 12, 11, 11},
+				[4]float64{12, 12, 11, 11},
+			),
+			expectedTrades: []dto.BacktestTradeRes{{
+				EntryDate:  util.Date("2025-06-06"),
+				EntryPrice: decimal.RequireFromString("11.11"),
+				Quantity:   decimal.NewFromInt(89),
+				ExitDate:   ptrDate(util.Date("2025-06-08")),
+				ExitPrice:  ptrDecimal(decimal.RequireFromString("11.88")),
+				Commission: decimal.RequireFromString("2.05"),
+				PnL:        decimal.RequireFromString("66.48"),
+				Return:     decimal.RequireFromString("0.0672"),
+			}},
+			expectedFinal:      "1066.48",
+			expectedReturn:     "0.0665",
+			expectedDrawdown:   "-0.0825",
+			expectedWinRate:    "1",
+			expectedExposure:   "0.2857",
+			expectedCommission: "2.05",
+			expectedBaseline:   "0.2072",
+		},
+		{
+			name: "breakout enters above the high and exits below the low",
+			req: dto.BacktestReq{Strategy: constant.StrategyBreakout, Days: 2, ExitDays: 1,
+				InitialCapital: decimal.NewFromInt(1200)},
+			bars: bars(
+				[4]float64{10, 11, 9, 10},
+				[4]float64{10, 11, 9, 10},
+				[4]float64{10, 13, 10, 12},
+				[4]float64{12, 13, 11, 12},
+				[4]float64{12, 12, 9, 9.5},
+				[4]float64{9, 10, 8, 9},
+			),
+			expectedTrades: []dto.BacktestTradeRes{{
+				EntryDate:  util.Date("2025-06-05"),
+				EntryPrice: decimal.NewFromInt(12),
+				Quantity:   decimal.NewFromInt(100),
+				ExitDate:   ptrDate(util.Date("2025-06-07")),
+				ExitPrice:  ptrDecimal(decimal.NewFromInt(9)),
+				PnL:        decimal.NewFromInt(-300),
+				Return:     decimal.RequireFromString("-0.25"),
+			}},
+			expectedFinal:      "900",
+			expectedReturn:     "-0.25",
+			expectedDrawdown:   "-0.25",
+			expectedWinRate:    "0",
+			expectedExposure:   "0.3333",
+			expectedCommission: "0",
+			expectedBaseline:   "-0.1",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			err := Normalize(&tt.req)
+			assert.Equal(t, err, nil)
+
+			//when
+			output := Run(&tt.req, tt.bars, 0, decimal.Zero)
+
+			//then
+			assert.Equal(t, len(output.Trades), len(tt.expectedTrades))
+			for i, trade := range output.Trades {
+				expected := tt.expectedTrades[i]
+				assert.Equal(t, trade.EntryDate, expected.EntryDate)
+				assert.Equal(t, trade.EntryPrice.String(), expected.EntryPrice.String())
+				assert.Equal(t, trade.Quantity.String(), expected.Quantity.String())
+				assert.Equal(t, trade.ExitDate, expected.ExitDate)
+				assert.Equal(t, trade.ExitPrice == nil, expected.ExitPrice == nil)
+				if trade.ExitPrice != nil {
+					assert.Equal(t, trade.ExitPrice.String(), expected.ExitPrice.String())
+				}
+				assert.Equal(t, trade.Commission.String(), expected.Commission.String())
+				assert.Equal(t, trade.PnL.String(), expected.PnL.String())
+				assert.Equal(t, trade.Return.String(), expected.Return.String())
+				assert.Equal(t, trade.Open, expected.Open)
+			}
+			assert.Equal(t, len(output.Equity), len(tt.bars))
+			summary := output.Summary
+			assert.Equal(t, summary.FinalEquity.String(), tt.expectedFinal)
+			assert.Equal(t, output.Equity[len(output.Equity)-1].Equity.String(), tt.expectedFinal)
+			assert.Equal(t, summary.TotalReturn.String(), tt.expectedReturn)
+			assert.Equal(t, summary.MaxDrawdown.Value.String(), tt.expectedDrawdown)
+			winRate := ""
+			if summary.WinRate != nil {
+				winRate = summary.WinRate.String()
+			}
+			assert.Equal(t, winRate, tt.expectedWinRate)
+			assert.Equal(t, summary.Exposure.String(), tt.expectedExposure)
+			assert.Equal(t, summary.Commission.String(), tt.expectedCommission)
+			assert.Equal(t, summary.BuyAndHoldReturn.String(), tt.expectedBaseline)
+		})
+	}
+}
+
+func ptrDate(d dto.DateOnly) *dto.DateOnly {
+	return &d
+}
+
+func ptrDecimal(d decimal.Decimal) *decimal.Decimal {
+	return &d
+}
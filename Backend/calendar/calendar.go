@@ -0,0 +1,233 @@
+package calendar
+
+import (
+	"Backend/dto"
+	"strings"
+	"sync"
+	"time"
+
+	// Exchange timezones must load wherever the service is deployed
+	_ "time/tzdata"
+)
+
+type CalendarItf interface {
+	Name() string
+	// Where the exchange's trading dates are reckoned
+	Location() *time.Location
+	// First day of the exchange's trading week, e.g. Sunday for Tadawul
+	WeekStart() time.Weekday
+	IsWeekend(dto.DateOnly) bool
+	IsTradingDay(dto.DateOnly) bool
+	IsEarlyClose(dto.DateOnly) bool
+	// Name of the holiday closing the market on this date, if any
+	Holiday(dto.DateOnly) (string, bool)
+	// Closest session strictly before / after the date
+	PrevSession(dto.DateOnly) dto.DateOnly
+	NextSession(dto.DateOnly) dto.DateOnly
+	// Sessions within [from, to], oldest first
+	SessionsBetween(from, to dto.DateOnly) []dto.DateOnly
+}
+
+// Holiday and early-close rules for one year, keyed by ISO date
+type YearRules func(year int) (holidays, earlyCloses map[string]string)
+
+// Calendar driven by weekend days plus yearly holiday rules
+type Exchange struct {
+	name      string
+	location  *time.Location
+	weekend   map[time.Weekday]bool
+	weekStart time.Weekday
+	rules     YearRules
+
+	mu    sync.Mutex
+	years map[int]yearCache
+}
+
+type yearCache struct {
+	holidays, earlyCloses map[string]string
+}
+
+func NewExchange(name, timezone string, weekend []time.Weekday, rules YearRules) *Exchange {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	weekendSet := make(map[time.Weekday]bool)
+	for _, day := range weekend {
+		weekendSet[day] = true
+	}
+
+	// The trading week starts on the day right after the weekend
+	weekStart := time.Monday
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if !weekendSet[day] && weekendSet[(day+6)%7] {
+			weekStart = day
+			break
+		}
+	}
+
+	return &Exchange{
+		name:      name,
+		location:  location,
+		weekend:   weekendSet,
+		weekStart: weekStart,
+		rules:     rules,
+		years:     make(map[int]yearCache),
+	}
+}
+
+func (ex *Exchange) Name() string {
+	return ex.name
+}
+
+func (ex *Exchange) Location() *time.Location {
+	return ex.location
+}
+
+func (ex *Exchange) WeekStart() time.Weekday {
+	return ex.weekStart
+}
+
+func (ex *Exchange) IsWeekend(d dto.DateOnly) bool {
+	return ex.weekend[d.Weekday()]
+}
+
+func (ex *Exchange) year(year int) yearCache {
+	ex.mu.Lock()
+	defer ex.mu.Unlock()
+
+	cache, ok := ex.years[year]
+	if !ok {
+		holidays, earlyCloses := ex.rules(year)
+		cache = yearCache{holidays: holidays, earlyCloses: earlyCloses}
+		ex.years[year] = cache
+	}
+	return cache
+}
+
+func (ex *Exchange) Holiday(d dto.DateOnly) (string, bool) {
+	name, ok := ex.year(time.Time(d).Year()).holidays[d.String()]
+	return name, ok
+}
+
+func (ex *Exchange) IsTradingDay(d dto.DateOnly) bool {
+	if ex.IsWeekend(d) {
+		return false
+	}
+	_, holiday := ex.Holiday(d)
+	return !holiday
+}
+
+func (ex *Exchange) IsEarlyClose(d dto.DateOnly) bool {
+	_, ok := ex.year(time.Time(d).Year()).earlyCloses[d.String()]
+	return ok && ex.IsTradingDay(d)
+}
+
+func (ex *Exchange) PrevSession(d dto.DateOnly) dto.DateOnly {
+	for {
+		d = d.AddDate(0, 0, -1)
+		if ex.IsTradingDay(d) {
+			return d
+		}
+	}
+}
+
+func (ex *Exchange) NextSession(d dto.DateOnly) dto.DateOnly {
+	for {
+		d = d.AddDate(0, 0, 1)
+		if ex.IsTradingDay(d) {
+			return d
+		}
+	}
+}
+
+func (ex *Exchange) SessionsBetween(from, to dto.DateOnly) []dto.DateOnly {
+	sessions := make([]dto.DateOnly, 0)
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		if ex.IsTradingDay(d) {
+			sessions = append(sessions, d)
+		}
+	}
+	return sessions
+}
+
+// Registry of calendars by exchange name, e.g. "NYSE"
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]CalendarItf{}
+)
+
+func Register(cal CalendarItf) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[strings.ToUpper(cal.Name())] = cal
+}
+
+func Get(name string) (CalendarItf, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	cal, ok := registry[strings.ToUpper(name)]
+	return cal, ok
+}
+
+// Default calendar of the service
+func Default() CalendarItf {
+	cal, _ := Get("NYSE")
+	return cal
+}
+
+// Exchanges by ticker suffix, e.g. "TSCO.LON";
+// tickers without a known suffix are taken as US listings
+var suffixes = map[string]string{
+	"LON": "LSE",
+	"L":   "LSE",
+	"TRT": "TSX",
+	"TRV": "TSX",
+	"TO":  "TSX",
+	"TSE": "TSE",
+	"T":   "TSE",
+	"SAU": "TADAWUL",
+	"SR":  "TADAWUL",
+}
+
+func ForSymbol(symbol string) CalendarItf {
+	if i := strings.LastIndex(symbol, "."); i >= 0 {
+		if name, ok := suffixes[strings.ToUpper(symbol[i+1:])]; ok {
+			if cal, ok := Get(name); ok {
+				return cal
+			}
+		}
+	}
+	return Default()
+}
+
+// Currencies prices are quoted in, by exchange name; London quotes
+// are in pence
+var currencies = map[string]string{
+	"NYSE":    "USD",
+	"NASDAQ":  "USD",
+	"LSE":     "GBX",
+	"TSX":     "CAD",
+	"TSE":     "JPY",
+	"TADAWUL": "SAR",
+}
+
+// Currency the symbol's prices are quoted in, by where it's listed
+func Currency(symbol string) string {
+	if currency, ok := currencies[strings.ToUpper(ForSymbol(symbol).Name())]; ok {
+		return currency
+	}
+	return currencies["NYSE"]
+}
+
+func init() {
+	satSun := []time.Weekday{time.Saturday, time.Sunday}
+	Register(NewExchange("NYSE", "America/New_York", satSun, USRules))
+	Register(NewExchange("NASDAQ", "America/New_York", satSun, USRules))
+	Register(NewExchange("LSE", "Europe/London", satSun, UKRules))
+	Register(NewExchange("TSX", "America/Toronto", satSun, CanadaRules))
+	Register(NewExchange("TSE", "Asia/Tokyo", satSun, JapanRules))
+	Register(NewExchange("TADAWUL", "Asia/Riyadh",
+		[]time.Weekday{time.Friday, time.Saturday}, SaudiRules))
+}
@@ -0,0 +1,277 @@
+package calendar
+
+import (
+	"Backend/util"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert"
+)
+
+func TestUnitCalendarUSRules(t *testing.T) {
+	testCases := []struct {
+		name                string
+		year                int
+		expectedHolidays    []string
+		expectedEarlyCloses []string
+	}{
+		{
+			name: "2025",
+			year: 2025,
+			expectedHolidays: []string{
+				"2025-01-01", "2025-01-20", "2025-02-17", "2025-04-18",
+				"2025-05-26", "2025-06-19", "2025-07-04", "2025-09-01",
+				"2025-11-27", "2025-12-25",
+			},
+			expectedEarlyCloses: []string{"2025-07-03", "2025-11-28", "2025-12-24"},
+		},
+		{
+			name: "2021, christmas and new year on saturday",
+			year: 2021,
+			expectedHolidays: []string{
+				"2021-01-01", "2021-01-18", "2021-02-15", "2021-04-02",
+				"2021-05-31", "2021-07-05", "2021-09-06", "2021-11-25",
+				"2021-12-24",
+			},
+			expectedEarlyCloses: []string{"2021-11-26"},
+		},
+		{
+			name: "2023, new year on sunday",
+			year: 2023,
+			expectedHolidays: []string{
+				"2023-01-02", "2023-01-16", "2023-02-20", "2023-04-07",
+				"2023-05-29", "2023-06-19", "2023-07-04", "2023-09-04",
+				"2023-11-23", "2023-12-25",
+			},
+			expectedEarlyCloses: []string{"2023-07-03", "2023-11-24"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			holidays, earlyCloses := USRules(tt.year)
+
+			//then
+			assert.Equal(t, len(holidays), len(tt.expectedHolidays))
+			for _, day := range tt.expectedHolidays {
+				_, ok := holidays[day]
+				assert.Equal(t, ok, true)
+			}
+			assert.Equal(t, len(earlyCloses), len(tt.expectedEarlyCloses))
+			for _, day := range tt.expectedEarlyCloses {
+				_, ok := earlyCloses[day]
+				assert.Equal(t, ok, true)
+			}
+		})
+	}
+}
+
+func TestUnitCalendarSessions(t *testing.T) {
+	nyse, ok := Get("nyse")
+	assert.Equal(t, ok, true)
+
+	testCases := []struct {
+		name     string
+		check    func() any
+		expected any
+	}{
+		{
+			name:     "weekday is a trading day",
+			check:    func() any { return nyse.IsTradingDay(util.Date("2025-04-17")) },
+			expected: true,
+		},
+		{
+			name:     "good friday is not",
+			check:    func() any { return nyse.IsTradingDay(util.Date("2025-04-18")) },
+			expected: false,
+		},
+		{
+			name:     "weekend is not",
+			check:    func() any { return nyse.IsTradingDay(util.Date("2025-04-19")) },
+			expected: false,
+		},
+		{
+			name:     "early close",
+			check:    func() any { return nyse.IsEarlyClose(util.Date("2025-11-28")) },
+			expected: true,
+		},
+		{
+			name:     "previous session skips weekend and holiday",
+			check:    func() any { return nyse.PrevSession(util.Date("2025-04-21")).String() },
+			expected: "2025-04-17",
+		},
+		{
+			name:     "next session skips holiday",
+			check:    func() any { return nyse.NextSession(util.Date("2025-01-17")).String() },
+			expected: "2025-01-21",
+		},
+		{
+			name:     "sessions between",
+			check:    func() any { return len(nyse.SessionsBetween(util.Date("2025-04-14"), util.Date("2025-04-20"))) },
+			expected: 4,
+		},
+		{
+			name: "holiday name",
+			check: func() any {
+				name, _ := nyse.Holiday(util.Date("2025-06-19"))
+				return name
+			},
+			expected: "Juneteenth",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.check(), tt.expected)
+		})
+	}
+}
+
+func TestUnitCalendarExchangeRules(t *testing.T) {
+	testCases := []struct {
+		name                string
+		rules               YearRules
+		year                int
+		expectedHolidays    []string
+		expectedEarlyCloses []string
+	}{
+		{
+			name:  "uk 2025",
+			rules: UKRules,
+			year:  2025,
+			expectedHolidays: []string{
+				"2025-01-01", "2025-04-18", "2025-04-21", "2025-05-05",
+				"2025-05-26", "2025-08-25", "2025-12-25", "2025-12-26",
+			},
+			expectedEarlyCloses: []string{"2025-12-24", "2025-12-31"},
+		},
+		{
+			name:  "uk 2027, christmas on saturday",
+			rules: UKRules,
+			year:  2027,
+			expectedHolidays: []string{
+				"2027-01-01", "2027-03-26", "2027-03-29", "2027-05-03",
+				"2027-05-31", "2027-08-30", "2027-12-27", "2027-12-28",
+			},
+			expectedEarlyCloses: []string{"2027-12-24", "2027-12-31"},
+		},
+		{
+			name:  "canada 2025",
+			rules: CanadaRules,
+			year:  2025,
+			expectedHolidays: []string{
+				"2025-01-01", "2025-02-17", "2025-04-18", "2025-05-19",
+				"2025-07-01", "2025-08-04", "2025-09-01", "2025-10-13",
+				"2025-12-25", "2025-12-26",
+			},
+			expectedEarlyCloses: []string{"2025-12-24"},
+		},
+		{
+			name:  "japan 2025, sunday holidays substituted",
+			rules: JapanRules,
+			year:  2025,
+			expectedHolidays: []string{
+				"2025-01-01", "2025-01-02", "2025-01-03", "2025-01-13",
+				"2025-02-11", "2025-02-23", "2025-02-24", "2025-03-20",
+				"2025-04-29", "2025-05-03", "2025-05-04", "2025-05-05",
+				"2025-05-06", "2025-07-21", "2025-08-11", "2025-09-15",
+				"2025-09-23", "2025-10-13", "2025-11-03", "2025-11-23",
+				"2025-11-24", "2025-12-31",
+			},
+			expectedEarlyCloses: []string{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			holidays, earlyCloses := tt.rules(tt.year)
+
+			//then
+			assert.Equal(t, len(holidays), len(tt.expectedHolidays))
+			for _, day := range tt.expectedHolidays {
+				_, ok := holidays[day]
+				assert.Equal(t, ok, true)
+			}
+			assert.Equal(t, len(earlyCloses), len(tt.expectedEarlyCloses))
+			for _, day := range tt.expectedEarlyCloses {
+				_, ok := earlyCloses[day]
+				assert.Equal(t, ok, true)
+			}
+		})
+	}
+}
+
+func TestUnitCalendarForSymbol(t *testing.T) {
+	testCases := []struct {
+		name              string
+		symbol            string
+		expectedName      string
+		expectedWeekStart time.Weekday
+		expectedLocation  string
+		expectedCurrency  string
+	}{
+		{
+			name:              "us listing",
+			symbol:            "IBM",
+			expectedName:      "NYSE",
+			expectedWeekStart: time.Monday,
+			expectedLocation:  "America/New_York",
+			expectedCurrency:  "USD",
+		},
+		{
+			name:              "london",
+			symbol:            "TSCO.LON",
+			expectedName:      "LSE",
+			expectedWeekStart: time.Monday,
+			expectedLocation:  "Europe/London",
+			expectedCurrency:  "GBX",
+		},
+		{
+			name:              "toronto",
+			symbol:            "shop.trt",
+			expectedName:      "TSX",
+			expectedWeekStart: time.Monday,
+			expectedLocation:  "America/Toronto",
+			expectedCurrency:  "CAD",
+		},
+		{
+			name:              "tokyo",
+			symbol:            "7203.TSE",
+			expectedName:      "TSE",
+			expectedWeekStart: time.Monday,
+			expectedLocation:  "Asia/Tokyo",
+			expectedCurrency:  "JPY",
+		},
+		{
+			name:              "sunday to thursday week",
+			symbol:            "2222.SR",
+			expectedName:      "TADAWUL",
+			expectedWeekStart: time.Sunday,
+			expectedLocation:  "Asia/Riyadh",
+			expectedCurrency:  "SAR",
+		},
+		{
+			name:              "unknown suffix",
+			symbol:            "BRK.B",
+			expectedName:      "NYSE",
+			expectedWeekStart: time.Monday,
+			expectedLocation:  "America/New_York",
+			expectedCurrency:  "USD",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			cal := ForSymbol(tt.symbol)
+
+			//then
+			assert.Equal(t, cal.Name(), tt.expectedName)
+			assert.Equal(t, cal.WeekStart(), tt.expectedWeekStart)
+			assert.Equal(t, cal.Location().String(), tt.expectedLocation)
+			assert.Equal(t, Currency(tt.symbol), tt.expectedCurrency)
+		})
+	}
+}
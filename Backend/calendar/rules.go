@@ -0,0 +1,238 @@
+package calendar
+
+import "time"
+
+// n-th given weekday of a month; n < 0 counts from the end of the month
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	if n > 0 {
+		t := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		for t.Weekday() != weekday {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t.AddDate(0, 0, 7*(n-1))
+	}
+	t := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+	for t.Weekday() != weekday {
+		t = t.AddDate(0, 0, -1)
+	}
+	return t.AddDate(0, 0, 7*(n+1))
+}
+
+// Western Easter Sunday (anonymous Gregorian algorithm)
+func easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// Saturday holidays move to Friday, Sunday holidays to Monday
+func observed(t time.Time) time.Time {
+	switch t.Weekday() {
+	case time.Saturday:
+		return t.AddDate(0, 0, -1)
+	case time.Sunday:
+		return t.AddDate(0, 0, 1)
+	}
+	return t
+}
+
+// NYSE and NASDAQ holidays and 1 p.m. early closes
+// (one-off closures such as national days of mourning are not covered)
+func USRules(year int) (map[string]string, map[string]string) {
+	holidays := make(map[string]string)
+	add := func(t time.Time, name string) {
+		if t.Year() == year {
+			holidays[t.Format("2006-01-02")] = name
+		}
+	}
+
+	// New Year's Day falling on Saturday is not observed on the Friday before
+	newYear := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if newYear.Weekday() != time.Saturday {
+		add(observed(newYear), "New Year's Day")
+	}
+	add(nthWeekday(year, time.January, time.Monday, 3), "Martin Luther King Jr. Day")
+	add(nthWeekday(year, time.February, time.Monday, 3), "Washington's Birthday")
+	add(easter(year).AddDate(0, 0, -2), "Good Friday")
+	add(nthWeekday(year, time.May, time.Monday, -1), "Memorial Day")
+	if year >= 2022 {
+		add(observed(time.Date(year, time.June, 19, 0, 0, 0, 0, time.UTC)), "Juneteenth")
+	}
+	independence := time.Date(year, time.July, 4, 0, 0, 0, 0, time.UTC)
+	add(observed(independence), "Independence Day")
+	add(nthWeekday(year, time.September, time.Monday, 1), "Labor Day")
+	thanksgiving := nthWeekday(year, time.November, time.Thursday, 4)
+	add(thanksgiving, "Thanksgiving Day")
+	christmas := time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC)
+	add(observed(christmas), "Christmas Day")
+
+	earlyCloses := make(map[string]string)
+	addEarly := func(t time.Time, name string) {
+		date := t.Format("2006-01-02")
+		weekday := t.Weekday()
+		if _, holiday := holidays[date]; !holiday &&
+			weekday != time.Saturday && weekday != time.Sunday {
+			earlyCloses[date] = name
+		}
+	}
+	addEarly(independence.AddDate(0, 0, -1), "Independence Day eve")
+	addEarly(thanksgiving.AddDate(0, 0, 1), "Day after Thanksgiving")
+	addEarly(time.Date(year, time.December, 24, 0, 0, 0, 0, time.UTC), "Christmas Eve")
+
+	return holidays, earlyCloses
+}
+
+// Weekend holidays move to the next weekday not already taken
+func substitute(holidays map[string]string, t time.Time, name string) {
+	for t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		t = t.AddDate(0, 0, 1)
+	}
+	for holidays[t.Format("2006-01-02")] != "" {
+		t = t.AddDate(0, 0, 1)
+	}
+	holidays[t.Format("2006-01-02")] = name
+}
+
+// Half days on the eves, skipping weekends and holidays
+func eveCloses(holidays map[string]string, eves ...time.Time) map[string]string {
+	earlyCloses := make(map[string]string)
+	for _, t := range eves {
+		date := t.Format("2006-01-02")
+		weekday := t.Weekday()
+		if _, holiday := holidays[date]; !holiday &&
+			weekday != time.Saturday && weekday != time.Sunday {
+			earlyCloses[date] = t.Format("January 2") + " half day"
+		}
+	}
+	return earlyCloses
+}
+
+// London Stock Exchange bank holidays and 12:30 p.m. early closes
+// (one-off moves such as the 2020 VE Day bank holiday are not covered)
+func UKRules(year int) (map[string]string, map[string]string) {
+	holidays := make(map[string]string)
+	substitute(holidays, time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), "New Year's Day")
+	holidays[easter(year).AddDate(0, 0, -2).Format("2006-01-02")] = "Good Friday"
+	holidays[easter(year).AddDate(0, 0, 1).Format("2006-01-02")] = "Easter Monday"
+	holidays[nthWeekday(year, time.May, time.Monday, 1).Format("2006-01-02")] = "Early May Bank Holiday"
+	holidays[nthWeekday(year, time.May, time.Monday, -1).Format("2006-01-02")] = "Spring Bank Holiday"
+	holidays[nthWeekday(year, time.August, time.Monday, -1).Format("2006-01-02")] = "Summer Bank Holiday"
+	substitute(holidays, time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC), "Christmas Day")
+	substitute(holidays, time.Date(year, time.December, 26, 0, 0, 0, 0, time.UTC), "Boxing Day")
+
+	earlyCloses := eveCloses(holidays,
+		time.Date(year, time.December, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC))
+	return holidays, earlyCloses
+}
+
+// Toronto Stock Exchange holidays and 1 p.m. Christmas Eve close
+func CanadaRules(year int) (map[string]string, map[string]string) {
+	holidays := make(map[string]string)
+	substitute(holidays, time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), "New Year's Day")
+	holidays[nthWeekday(year, time.February, time.Monday, 3).Format("2006-01-02")] = "Family Day"
+	holidays[easter(year).AddDate(0, 0, -2).Format("2006-01-02")] = "Good Friday"
+
+	// Victoria Day is the last Monday before May 25
+	victoria := time.Date(year, time.May, 24, 0, 0, 0, 0, time.UTC)
+	for victoria.Weekday() != time.Monday {
+		victoria = victoria.AddDate(0, 0, -1)
+	}
+	holidays[victoria.Format("2006-01-02")] = "Victoria Day"
+	substitute(holidays, time.Date(year, time.July, 1, 0, 0, 0, 0, time.UTC), "Canada Day")
+	holidays[nthWeekday(year, time.August, time.Monday, 1).Format("2006-01-02")] = "Civic Holiday"
+	holidays[nthWeekday(year, time.September, time.Monday, 1).Format("2006-01-02")] = "Labour Day"
+	holidays[nthWeekday(year, time.October, time.Monday, 2).Format("2006-01-02")] = "Thanksgiving Day"
+	substitute(holidays, time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC), "Christmas Day")
+	substitute(holidays, time.Date(year, time.December, 26, 0, 0, 0, 0, time.UTC), "Boxing Day")
+
+	earlyCloses := eveCloses(holidays,
+		time.Date(year, time.December, 24, 0, 0, 0, 0, time.UTC))
+	return holidays, earlyCloses
+}
+
+// Tokyo Stock Exchange closures: national holidays plus the year-end break
+// (one-off moves such as the 2020 and 2021 Olympic shifts are not covered)
+func JapanRules(year int) (map[string]string, map[string]string) {
+	holidays := make(map[string]string)
+	add := func(month time.Month, day int, name string) {
+		holidays[time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format("2006-01-02")] = name
+	}
+	addNth := func(month time.Month, n int, name string) {
+		holidays[nthWeekday(year, month, time.Monday, n).Format("2006-01-02")] = name
+	}
+
+	// Equinox days, valid for 1980 to 2099
+	leap := (year - 1980) / 4
+	vernal := int(20.8431+0.242194*float64(year-1980)) - leap
+	autumnal := int(23.2488+0.242194*float64(year-1980)) - leap
+
+	add(time.January, 1, "New Year's Day")
+	addNth(time.January, 2, "Coming of Age Day")
+	add(time.February, 11, "National Foundation Day")
+	if year >= 2020 {
+		add(time.February, 23, "Emperor's Birthday")
+	}
+	add(time.March, vernal, "Vernal Equinox Day")
+	add(time.April, 29, "Showa Day")
+	add(time.May, 3, "Constitution Memorial Day")
+	add(time.May, 4, "Greenery Day")
+	add(time.May, 5, "Children's Day")
+	addNth(time.July, 3, "Marine Day")
+	if year >= 2016 {
+		add(time.August, 11, "Mountain Day")
+	}
+	addNth(time.September, 3, "Respect for the Aged Day")
+	add(time.September, autumnal, "Autumnal Equinox Day")
+	addNth(time.October, 2, "Sports Day")
+	add(time.November, 3, "Culture Day")
+	add(time.November, 23, "Labour Thanksgiving Day")
+
+	// Sunday holidays are made up on the next free weekday,
+	// and a weekday between two holidays is itself a holiday
+	autumnalDate := time.Date(year, time.September, autumnal, 0, 0, 0, 0, time.UTC)
+	between := autumnalDate.AddDate(0, 0, -1)
+	if holidays[between.AddDate(0, 0, -1).Format("2006-01-02")] != "" {
+		holidays[between.Format("2006-01-02")] = "Citizens' Holiday"
+	}
+	sundays := make(map[time.Time]string)
+	for date, name := range holidays {
+		t, _ := time.Parse("2006-01-02", date)
+		if t.Weekday() == time.Sunday {
+			sundays[t] = name
+		}
+	}
+	for t, name := range sundays {
+		substitute(holidays, t, name+" (substitute)")
+	}
+
+	// Exchange year-end break
+	add(time.January, 2, "Year-end break")
+	add(time.January, 3, "Year-end break")
+	add(time.December, 31, "Year-end break")
+
+	return holidays, map[string]string{}
+}
+
+// Tadawul fixed-date national holidays; the Eid closures follow the
+// lunar calendar and are announced yearly, so they are not covered
+func SaudiRules(year int) (map[string]string, map[string]string) {
+	holidays := make(map[string]string)
+	if year >= 2022 {
+		holidays[time.Date(year, time.February, 22, 0, 0, 0, 0, time.UTC).Format("2006-01-02")] = "Founding Day"
+	}
+	holidays[time.Date(year, time.September, 23, 0, 0, 0, 0, time.UTC).Format("2006-01-02")] = "National Day"
+	return holidays, map[string]string{}
+}
@@ -0,0 +1,162 @@
+package chart
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+
+	"github.com/shopspring/decimal"
+)
+
+var two = decimal.NewFromInt(2)
+
+// Smallest fixed box as a fraction of the window's highest close; a box
+// far below the price would turn every move into thousands of bricks
+var minBoxFraction = decimal.RequireFromString("0.005")
+
+// Check the box sizing against the bars in the window, filling in the
+// defaults of what's left out; a fixed box leaves the ATR period at zero
+func Normalize(params *dto.BoxParams, bars []dto.DailyOHLCVRes) error {
+	if params.Box != nil && params.ATR != 0 {
+		return constant.ErrInvalidBox
+	}
+	if params.Box == nil && params.ATR == 0 {
+		params.ATR = constant.DefaultBoxATRPeriod
+	}
+	if params.Reversal == 0 {
+		params.Reversal = constant.DefaultReversal
+	}
+	if params.Box != nil && !params.Box.IsPositive() || params.ATR < 0 || params.Reversal < 1 {
+		return constant.ErrInvalidBox
+	}
+	if params.Box != nil && params.Box.LessThan(minBox(bars)) {
+		return constant.ErrInvalidBox
+	}
+	return nil
+}
+
+func minBox(bars []dto.DailyOHLCVRes) decimal.Decimal {
+	highest := decimal.Zero
+	for _, bar := range bars {
+		highest = decimal.Max(highest, bar.OHLC["close"])
+	}
+	return highest.Mul(minBoxFraction)
+}
+
+// Box size from the ATR at the last bar, rounded to chart prices;
+// nil when there are too few bars or it rounds to nothing
+func ATRBox(bars []dto.DailyOHLCVRes, period int) *decimal.Decimal {
+	highs := make([]decimal.Decimal, len(bars))
+	lows := make([]decimal.Decimal, len(bars))
+	closes := make([]decimal.Decimal, len(bars))
+	for i, bar := range bars {
+		highs[i], lows[i], closes[i] = bar.OHLC["high"], bar.OHLC["low"], bar.OHLC["close"]
+	}
+	atr := analytics.ATR(highs, lows, closes, period)
+	if len(atr) == 0 || atr[len(atr)-1] == nil {
+		return nil
+	}
+	box := atr[len(atr)-1].Round(constant.ChartPricePlaces)
+	if !box.IsPositive() {
+		return nil
+	}
+	return &box
+}
+
+// Heikin-Ashi candles aligned with bars. Each open carries on from the
+// candle before, so candles settle a few bars after the first.
+func HeikinAshi(bars []dto.DailyOHLCVRes) []dto.DailyOHLCVRes {
+	out := make([]dto.DailyOHLCVRes, len(bars))
+	var open, close decimal.Decimal
+	for i, bar := range bars {
+		if i == 0 {
+			open = bar.OHLC["open"].Add(bar.OHLC["close"]).Div(two)
+		} else {
+			open = open.Add(close).Div(two)
+		}
+		close = bar.OHLC["open"].Add(bar.OHLC["high"]).
+			Add(bar.OHLC["low"]).Add(bar.OHLC["close"]).Div(two.Add(two))
+		out[i] = dto.DailyOHLCVRes{
+			Day: bar.Day,
+			OHLC: map[string]decimal.Decimal{
+				"open":  open.Round(constant.ChartPricePlaces),
+				"high":  decimal.Max(bar.OHLC["high"], open, close).Round(constant.ChartPricePlaces),
+				"low":   decimal.Min(bar.OHLC["low"], open, close).Round(constant.ChartPricePlaces),
+				"close": close.Round(constant.ChartPricePlaces),
+			},
+			Volume: bar.Volume,
+		}
+	}
+	return out
+}
+
+// Renko bricks of the closes, starting from the first one. A brick is
+// laid each time the close moves a box past the last brick, so turning
+// takes two boxes.
+func Renko(bars []dto.DailyOHLCVRes, box decimal.Decimal) []dto.BrickRes {
+	bricks := make([]dto.BrickRes, 0)
+	if len(bars) == 0 {
+		return bricks
+	}
+	high := bars[0].OHLC["close"]
+	low := high
+	for _, bar := range bars[1:] {
+		close := bar.OHLC["close"]
+		for close.GreaterThanOrEqual(high.Add(box)) {
+			bricks = append(bricks, dto.BrickRes{Date: bar.Day, Open: high, Close: high.Add(box), Direction: constant.BrickUp})
+			low, high = high, high.Add(box)
+		}
+		for close.LessThanOrEqual(low.Sub(box)) {
+			bricks = append(bricks, dto.BrickRes{Date: bar.Day, Open: low, Close: low.Sub(box), Direction: constant.BrickDown})
+			high, low = low, low.Sub(box)
+		}
+	}
+	return bricks
+}
+
+// Point-and-figure columns of the closes, on price levels that are
+// multiples of box. The first column starts with the first level
+// crossed from the first close; a column turns once the close crosses
+// reversal levels against it.
+func PointFigure(bars []dto.DailyOHLCVRes, box decimal.Decimal, reversal int) []dto.ColumnRes {
+	columns := make([]dto.ColumnRes, 0)
+	if len(bars) == 0 {
+		return columns
+	}
+	below := func(price decimal.Decimal) decimal.Decimal {
+		return price.Div(box).Floor().Mul(box)
+	}
+	above := func(price decimal.Decimal) decimal.Decimal {
+		return price.Div(box).Ceil().Mul(box)
+	}
+	turn := box.Mul(decimal.NewFromInt(int64(reversal)))
+
+	first := bars[0].OHLC["close"]
+	for _, bar := range bars[1:] {
+		top, bottom := below(bar.OHLC["close"]), above(bar.OHLC["close"])
+		if len(columns) == 0 {
+			if start := below(first).Add(box); top.GreaterThanOrEqual(start) {
+				columns = append(columns, dto.ColumnRes{Direction: constant.ColumnX, Low: start, High: top, Start: bar.Day, End: bar.Day})
+			} else if start := above(first).Sub(box); bottom.LessThanOrEqual(start) {
+				columns = append(columns, dto.ColumnRes{Direction: constant.ColumnO, Low: bottom, High: start, Start: bar.Day, End: bar.Day})
+			}
+			continue
+		}
+
+		last := &columns[len(columns)-1]
+		switch {
+		case last.Direction == constant.ColumnX && top.GreaterThan(last.High):
+			last.High, last.End = top, bar.Day
+		case last.Direction == constant.ColumnX && bottom.LessThanOrEqual(last.High.Sub(turn)):
+			columns = append(columns, dto.ColumnRes{Direction: constant.ColumnO, Low: bottom, High: last.High.Sub(box), Start: bar.Day, End: bar.Day})
+		case last.Direction == constant.ColumnO && bottom.LessThan(last.Low):
+			last.Low, last.End = bottom, bar.Day
+		case last.Direction == constant.ColumnO && top.GreaterThanOrEqual(last.Low.Add(turn)):
+			columns = append(columns, dto.ColumnRes{Direction: constant.ColumnX, Low: last.Low.Add(box), High: top, Start: bar.Day, End: bar.Day})
+		}
+	}
+	for i := range columns {
+		columns[i].Boxes = int(columns[i].High.Sub(columns[i].Low).Div(box).IntPart()) + 1
+	}
+	return columns
+}
@@ -0,0 +1,233 @@
+package chart
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"fmt"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+// Bars on consecutive days from 2025-06-02, each given as
+// open, high, low and close
+func bars(candles ...[4]float64) []dto.DailyOHLCVRes {
+	start := util.Date("2025-06-02")
+	out := make([]dto.DailyOHLCVRes, len(candles))
+	for i, c := range candles {
+		out[i] = dto.DailyOHLCVRes{
+			Day: start.AddDate(0, 0, i),
+			OHLC: map[string]decimal.Decimal{
+				"open":  decimal.NewFromFloat(c[0]),
+				"high":  decimal.NewFromFloat(c[1]),
+				"low":   decimal.NewFromFloat(c[2]),
+				"close": decimal.NewFromFloat(c[3]),
+			},
+			Volume: 100,
+		}
+	}
+	return out
+}
+
+// Bars that only matter for their closes
+func closes(values ...float64) []dto.DailyOHLCVRes {
+	candles := make([][4]float64, len(values))
+	for i, value := range values {
+		candles[i] = [4]float64{value, value, value, value}
+	}
+	return bars(candles...)
+}
+
+func TestUnitChartNormalize(t *testing.T) {
+	box := decimal.NewFromInt(2)
+	negative := decimal.NewFromInt(-1)
+	tiny := decimal.RequireFromString("1.99")
+
+	testCases := []struct {
+		name             string
+		params           dto.BoxParams
+		expectedATR      int
+		expectedReversal int
+		expectedErr      error
+	}{
+		{
+			name:             "defaults to the atr box",
+			expectedATR:      constant.DefaultBoxATRPeriod,
+			expectedReversal: constant.DefaultReversal,
+		},
+		{
+			name:             "fixed box",
+			params:           dto.BoxParams{Box: &box, Reversal: 2},
+			expectedReversal: 2,
+		},
+		{
+			name:        "both box and atr",
+			params:      dto.BoxParams{Box: &box, ATR: 10},
+			expectedErr: constant.ErrInvalidBox,
+		},
+		{
+			name:        "box not positive",
+			params:      dto.BoxParams{Box: &negative},
+			expectedErr: constant.ErrInvalidBox,
+		},
+		{
+			name:             "box at the smallest fraction of the highest close",
+			params:           dto.BoxParams{Box: &box},
+			expectedReversal: constant.DefaultReversal,
+		},
+		{
+			name:        "box too small for the price",
+			params:      dto.BoxParams{Box: &tiny},
+			expectedErr: constant.ErrInvalidBox,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			err := Normalize(&tt.params, closes(300, 400, 350))
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, tt.params.ATR, tt.expectedATR)
+				assert.Equal(t, tt.params.Reversal, tt.expectedReversal)
+			}
+		})
+	}
+}
+
+func TestUnitChartATRBox(t *testing.T) {
+	// True ranges 2, 4 and 5, so a 2-bar ATR of 3 then 4
+	series := bars(
+		[4]float64{10, 11, 9, 10},
+		[4]float64{11, 12, 10, 11},
+		[4]float64{14, 15, 13, 14},
+		[4]float64{10, 11, 9, 10},
+	)
+	assert.Equal(t, ATRBox(series, 2).String(), "4")
+	assert.Equal(t, ATRBox(series, 4), nil)
+	assert.Equal(t, ATRBox(closes(10, 10, 10), 2), nil)
+}
+
+func TestUnitChartHeikinAshi(t *testing.T) {
+	//when
+	candles := HeikinAshi(bars(
+		[4]float64{10, 12, 9, 11},
+		[4]float64{11, 13, 10, 12},
+		[4]float64{12, 12.5, 8, 9},
+	))
+
+	//then
+	found := make([]string, len(candles))
+	for i, c := range candles {
+		found[i] = fmt.Sprintf("%s %s %s %s %s %d", c.Day,
+			c.OHLC["open"], c.OHLC["high"], c.OHLC["low"], c.OHLC["close"], c.Volume)
+	}
+	assert.Equal(t, found, []string{
+		"2025-06-02 10.5 12 9 10.5 100",
+		"2025-06-03 10.5 13 10 11.5 100",
+		"2025-06-04 11 12.5 8 10.375 100",
+	})
+}
+
+func TestUnitChartRenko(t *testing.T) {
+	testCases := []struct {
+		name     string
+		bars     []dto.DailyOHLCVRes
+		box      float64
+		expected []string
+	}{
+		{
+			name: "several bricks a bar and a two-box turn",
+			bars: closes(10, 12.5, 11, 9, 8.4),
+			box:  1,
+			expected: []string{
+				"2025-06-03 up 10 11",
+				"2025-06-03 up 11 12",
+				"2025-06-05 down 11 10",
+				"2025-06-05 down 10 9",
+			},
+		},
+		{
+			name:     "moves within a box",
+			bars:     closes(10, 10.9, 9.1),
+			box:      1,
+			expected: []string{},
+		},
+		{
+			name:     "no bars",
+			box:      1,
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			bricks := Renko(tt.bars, decimal.NewFromFloat(tt.box))
+
+			//then
+			found := make([]string, len(bricks))
+			for i, b := range bricks {
+				found[i] = fmt.Sprintf("%s %s %s %s", b.Date, b.Direction, b.Open, b.Close)
+			}
+			assert.Equal(t, found, tt.expected)
+		})
+	}
+}
+
+func TestUnitChartPointFigure(t *testing.T) {
+	testCases := []struct {
+		name     string
+		bars     []dto.DailyOHLCVRes
+		box      float64
+		reversal int
+		expected []string
+	}{
+		{
+			name:     "columns turn after the reversal",
+			bars:     closes(10.5, 11.2, 13.7, 12.1, 10.9, 9.5, 12.4, 13),
+			box:      1,
+			reversal: 3,
+			expected: []string{
+				"x 11 13 3 2025-06-03 2025-06-04",
+				"o 10 12 3 2025-06-07 2025-06-07",
+				"x 11 13 3 2025-06-09 2025-06-09",
+			},
+		},
+		{
+			name:     "first column falling",
+			bars:     closes(10, 9.5, 8, 9),
+			box:      0.5,
+			reversal: 1,
+			expected: []string{
+				"o 8 9.5 4 2025-06-03 2025-06-04",
+				"x 8.5 9 2 2025-06-05 2025-06-05",
+			},
+		},
+		{
+			name:     "moves within a box",
+			bars:     closes(10.5, 10.9, 10.1),
+			box:      1,
+			reversal: 3,
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			columns := PointFigure(tt.bars, decimal.NewFromFloat(tt.box), tt.reversal)
+
+			//then
+			found := make([]string, len(columns))
+			for i, c := range columns {
+				found[i] = fmt.Sprintf("%s %s %s %d %s %s", c.Direction, c.Low, c.High, c.Boxes, c.Start, c.End)
+			}
+			assert.Equal(t, found, tt.expected)
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package configs
 
 import (
+	"Backend/constant"
 	"log"
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -17,3 +19,28 @@ func EnvMongoURL() string {
 
 	return os.Getenv("MONGOURL")
 }
+
+// Refresh scheduler settings, falling back to the defaults in `constant`
+func EnvScheduler() (schedule, timezone string, disabled bool) {
+	schedule = os.Getenv("REFRESH_SCHEDULE")
+	if schedule == "" {
+		schedule = constant.DefaultRefreshSchedule
+	}
+
+	timezone = os.Getenv("REFRESH_TIMEZONE")
+	if timezone == "" {
+		timezone = constant.DefaultRefreshTimezone
+	}
+
+	disabled, _ = strconv.ParseBool(os.Getenv("SCHEDULER_DISABLED"))
+	return schedule, timezone, disabled
+}
+
+// Number of background workers processing collection jobs
+func EnvJobWorkers() int {
+	workers, err := strconv.Atoi(os.Getenv("JOB_WORKERS"))
+	if err != nil || workers <= 0 {
+		return constant.DefaultJobWorkers
+	}
+	return workers
+}
@@ -0,0 +1,15 @@
+package constant
+
+var (
+	// Corporate action types
+	ActionSplit    string = "split"
+	ActionDividend string = "dividend"
+
+	// Price adjustment modes, selected with ?adjust=
+	AdjustNone   string = "none"
+	AdjustSplits string = "splits"
+	AdjustTotal  string = "total"
+
+	// Decimal places kept in adjusted prices
+	AdjustedPricePlaces int32 = 4
+)
@@ -0,0 +1,29 @@
+package constant
+
+import "time"
+
+var (
+	// Alert rule types
+	AlertCloseAbove    string = "close_above"
+	AlertCloseBelow    string = "close_below"
+	AlertPercentChange string = "percent_change"
+	AlertVolumeSpike   string = "volume_above_average"
+	AlertRSIAbove      string = "rsi_above"
+	AlertRSIBelow      string = "rsi_below"
+
+	// Delivery statuses of a trigger
+	DeliveryPending   string = "pending"
+	DeliveryRetrying  string = "retrying"
+	DeliveryDelivered string = "delivered"
+	DeliveryFailed    string = "failed"
+
+	// Webhook requests carry an HMAC-SHA256 of "<timestamp>.<body>",
+	// keyed with ALERT_WEBHOOK_SECRET
+	WebhookSignatureHeader string = "X-Signature-256"
+	WebhookTimestampHeader string = "X-Signature-Timestamp"
+
+	// Webhook delivery attempts, backing off from the base delay
+	WebhookMaxAttempts int           = 5
+	WebhookRetryDelay  time.Duration = 30 * time.Second
+	WebhookTimeout     time.Duration = 10 * time.Second
+)
@@ -0,0 +1,44 @@
+package constant
+
+var (
+	// Decimal places kept in computed statistics and indicators
+	AnalyticsPlaces int32 = 4
+	// Covariances of daily returns are tiny, so they keep more
+	CovariancePlaces int32 = 8
+
+	// Indicator types
+	IndicatorSMA    string = "sma"
+	IndicatorEMA    string = "ema"
+	IndicatorRSI    string = "rsi"
+	IndicatorMACD   string = "macd"
+	IndicatorBBands string = "bbands"
+
+	// Periods used when none is asked for
+	DefaultIndicatorPeriods = map[string]int{
+		IndicatorSMA:    20,
+		IndicatorEMA:    20,
+		IndicatorRSI:    14,
+		IndicatorBBands: 20,
+	}
+
+	// MACD uses the usual 12/26/9 EMA periods
+	MACDFast   int = 12
+	MACDSlow   int = 26
+	MACDSignal int = 9
+
+	// Bollinger bands sit this many standard deviations from the average
+	BBandsWidth int64 = 2
+
+	// Annual risk-free rate used when none is asked for or configured
+	DefaultRiskFreeRate string = "0"
+
+	// Trailing returns in a rolling correlation when none is asked for
+	DefaultCorrelationWindow int = 20
+
+	// Benchmark used when none is asked for or configured
+	DefaultBenchmark string = "SPY"
+	// Trailing returns in a rolling beta when none is asked for
+	DefaultBetaWindow int = 60
+	// Fewest trailing returns a beta can be taken over
+	MinBetaWindow int = 2
+)
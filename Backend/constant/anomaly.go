@@ -0,0 +1,33 @@
+package constant
+
+var (
+	// Scoring methods, selected with ?method=
+	AnomalyZScore string = "zscore"
+	AnomalyMAD    string = "mad"
+
+	// What is scored on each bar
+	AnomalyReturn string = "return"
+	AnomalyVolume string = "volume"
+
+	// Which way a flagged value strays from the window
+	AnomalyHigh string = "high"
+	AnomalyLow  string = "low"
+
+	// Annotation of stored daily bars with their anomaly flags, selected
+	// with ?annotate=
+	AnnotateAnomalies string = "anomalies"
+	// Every annotation ?annotate= takes
+	Annotations = []string{AnnotatePatterns, AnnotateAnomalies}
+
+	// Trailing bars a value is compared with when none is asked for
+	DefaultAnomalyWindow int = 20
+	// Scores flagged when none is asked for; modified z-scores from the
+	// median absolute deviation are usually held to 3.5
+	DefaultAnomalyThresholds = map[string]string{
+		AnomalyZScore: "3",
+		AnomalyMAD:    "3.5",
+	}
+	// Scales the median absolute deviation to a standard deviation
+	// of normally distributed values
+	MADScale string = "0.6745"
+)
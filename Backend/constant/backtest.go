@@ -0,0 +1,23 @@
+package constant
+
+var (
+	// Backtest strategies
+	StrategySMACrossover string = "sma_crossover"
+	StrategyBreakout     string = "breakout"
+	StrategyBuyAndHold   string = "buy_and_hold"
+
+	// Moving averages of the crossover when none are asked for
+	DefaultFastPeriod int = 20
+	DefaultSlowPeriod int = 50
+
+	// Breakouts enter above the highest high of the entry days and exit
+	// below the lowest low of the exit days
+	DefaultBreakoutDays int = 20
+	DefaultExitDays     int = 10
+
+	// Starting cash when none is asked for
+	DefaultInitialCapital string = "10000"
+
+	// Decimal places kept in money amounts of a backtest
+	MoneyPlaces int32 = 2
+)
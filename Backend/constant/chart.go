@@ -0,0 +1,22 @@
+package constant
+
+var (
+	// Decimal places kept in transformed chart prices
+	ChartPricePlaces int32 = 4
+
+	// Where a Renko or point-and-figure box size comes from
+	BoxFixed string = "fixed"
+	BoxATR   string = "atr"
+	// ATR period sizing the box when no fixed box is asked for
+	DefaultBoxATRPeriod int = 14
+	// Boxes against a point-and-figure column that start the next one
+	DefaultReversal int = 3
+
+	// Renko brick directions
+	BrickUp   string = "up"
+	BrickDown string = "down"
+
+	// Point-and-figure columns of rising (x) and falling (o) prices
+	ColumnX string = "x"
+	ColumnO string = "o"
+)
@@ -8,6 +8,8 @@ import (
 type CustomError struct {
 	StatusCode int
 	Message    string
+	// Sentinel that errors.Is matches a detailed error by
+	kind error
 }
 
 func NewCError(StatusCode int, Message string) CustomError {
@@ -18,6 +20,10 @@ func (err CustomError) Error() string {
 	return err.Message
 }
 
+func (err CustomError) Unwrap() error {
+	return err.kind
+}
+
 var (
 	// GetSymbols handler
 	ErrNoKeywords = NewCError(http.StatusBadRequest,
@@ -29,8 +35,265 @@ var (
 	ErrStockAlready = NewCError(http.StatusBadRequest,
 		"The stock (symbol) is already tracked in the database"+
 			"and monitored regularly")
+
+	// Refresh scheduler
+	ErrSchedulerBusy = NewCError(http.StatusConflict,
+		"a refresh run is already in progress")
+
+	// GetJob handler
+	ErrNoJobId = NewCError(http.StatusBadRequest,
+		"please provide job ID")
+	ErrInvalidJobId = NewCError(http.StatusBadRequest,
+		"job ID is not valid")
+	ErrJobNotFound = NewCError(http.StatusNotFound,
+		"job not found")
+	ErrJobInterrupted = NewCError(http.StatusInternalServerError,
+		"job was interrupted by a restart too many times; not retried")
+
+	// BackfillSymbol handler
+	ErrNoBackfillFrom = NewCError(http.StatusBadRequest,
+		"please provide from date")
+	ErrDateRange = NewCError(http.StatusBadRequest,
+		"from date must not be after to date")
+	ErrSymbolNotTracked = NewCError(http.StatusNotFound,
+		"the stock (symbol) is not tracked in the database")
+
+	// Quarantine review handlers
+	ErrNoQuarantineId = NewCError(http.StatusBadRequest,
+		"please provide quarantined bar ID")
+	ErrInvalidQuarantineId = NewCError(http.StatusBadRequest,
+		"quarantined bar ID is not valid")
+	ErrQuarantineNotFound = NewCError(http.StatusNotFound,
+		"quarantined bar not found")
+	ErrQuarantineReviewed = NewCError(http.StatusConflict,
+		"quarantined bar has already been reviewed")
+	ErrInvalidQuarantineStatus = NewCError(http.StatusBadRequest,
+		"status must be pending, accepted or rejected")
+
+	// Price adjustment
+	ErrInvalidAdjust = NewCError(http.StatusBadRequest,
+		"adjust must be none, splits or total")
+	ErrInvalidInterval = NewCError(http.StatusBadRequest,
+		"interval must be day, week, month, quarter or year")
+
+	// Indicators handler
+	ErrNoIndicator = NewCError(http.StatusBadRequest,
+		"please provide indicator type, e.g. type=sma,rsi")
+	ErrNoBars = NewCError(http.StatusNotFound,
+		"no stored bars in the requested window")
+
+	// Stats handler
+	ErrNotEnoughBars = NewCError(http.StatusUnprocessableEntity,
+		"at least two bars are needed in the requested window")
+	ErrInvalidRiskFree = NewCError(http.StatusBadRequest,
+		"risk_free must be an annual rate as a decimal fraction, e.g. 0.04")
+
+	// Portfolio handlers
+	ErrNoPortfolioId = NewCError(http.StatusBadRequest,
+		"please provide portfolio id")
+	ErrInvalidPortfolioId = NewCError(http.StatusBadRequest,
+		"portfolio id is not valid")
+	ErrPortfolioNotFound = NewCError(http.StatusNotFound,
+		"no portfolio with this id")
+	ErrInvalidHolding = NewCError(http.StatusBadRequest,
+		"each holding needs a symbol, listed once, and a positive quantity")
+
+	// Ledger handlers
+	ErrNoTransactionId = NewCError(http.StatusBadRequest,
+		"please provide transaction id")
+	ErrInvalidTransactionId = NewCError(http.StatusBadRequest,
+		"transaction id is not valid")
+	ErrTransactionNotFound = NewCError(http.StatusNotFound,
+		"no transaction with this id in the portfolio")
+	ErrInvalidTransactionType = NewCError(http.StatusBadRequest,
+		"type must be buy, sell, dividend, fee or split")
+	ErrInvalidTransaction = NewCError(http.StatusBadRequest,
+		"transactions need a date and, except fees, a symbol; buys and sells "+
+			"a positive quantity and price, dividends and fees a positive "+
+			"amount, splits a positive ratio")
+	ErrOversold = NewCError(http.StatusUnprocessableEntity,
+		"a sale exceeds the quantity held at the time")
+	ErrInvalidLotMethod = NewCError(http.StatusBadRequest,
+		"method must be fifo, lifo or average")
+
+	// Alert handlers
+	ErrNoAlertId = NewCError(http.StatusBadRequest,
+		"please provide alert id")
+	ErrInvalidAlertId = NewCError(http.StatusBadRequest,
+		"alert id is not valid")
+	ErrAlertNotFound = NewCError(http.StatusNotFound,
+		"no alert rule with this id")
+	ErrInvalidAlertType = NewCError(http.StatusBadRequest,
+		"type must be close_above, close_below, percent_change, "+
+			"volume_above_average, rsi_above or rsi_below")
+	ErrInvalidAlertRule = NewCError(http.StatusBadRequest,
+		"close alerts need a positive level; percent_change needs days and a "+
+			"non-zero threshold (percent); volume_above_average needs days and "+
+			"a positive threshold (multiple); rsi alerts a threshold below 100")
+	ErrInvalidWebhookURL = NewCError(http.StatusBadRequest,
+		"webhook_url must be an http or https URL of a public host, not "+
+			"loopback, private or link-local")
+	ErrNoWebhookSecret = NewCError(http.StatusServiceUnavailable,
+		"ALERT_WEBHOOK_SECRET is not configured, so webhooks can't be signed")
+	ErrWebhookFailed = NewCError(http.StatusBadGateway,
+		"webhook delivery failed")
+
+	// Fundamentals handlers
+	ErrFundamentalsNotFound = NewCError(http.StatusNotFound,
+		"no fundamentals for this symbol; sync them first")
+
+	// FX handlers
+	ErrInvalidCurrency = NewCError(http.StatusBadRequest,
+		"currency must be a three-letter code, e.g. USD")
+	ErrSameCurrency = NewCError(http.StatusBadRequest,
+		"base and quote must be different currencies")
+	ErrFXRatesNotFound = NewCError(http.StatusNotFound,
+		"no FX rates for this currency pair; sync them first")
+	ErrUnknownFXPair = NewCError(http.StatusNotFound,
+		"the data provider has no rates for this currency pair")
+	// Matches every ErrNoFXRate
+	ErrMissingFXRate = NewCError(http.StatusUnprocessableEntity,
+		"no FX rate on or before a bar's date; sync FX rates first")
+
+	// Patterns and anomalies handlers
+	ErrInvalidAnnotation = NewCError(http.StatusBadRequest,
+		"annotate must be a comma list of patterns and anomalies, "+
+			"and annotations need the day interval")
+	ErrInvalidAnomaly = NewCError(http.StatusBadRequest,
+		"method must be zscore or mad, threshold positive and window at least 3")
+
+	// Chart transform handlers
+	ErrInvalidBox = NewCError(http.StatusBadRequest,
+		"give either box as a price of at least 0.5% of the window's highest "+
+			"close or atr as a period of at least 1, and reversal at least 1")
+	ErrNoBoxSize = NewCError(http.StatusUnprocessableEntity,
+		"too few bars up to the window end for an ATR box size; give a fixed box")
+
+	// Screen handler
+	ErrInvalidScreenField = NewCError(http.StatusBadRequest,
+		"field must be close, change, volume_ratio, price_vs_sma, "+
+			"new_52w_high, new_52w_low or sector")
+	ErrInvalidScreenCondition = NewCError(http.StatusBadRequest,
+		"numeric conditions need op gt, gte, lt, lte, eq or ne and positive days; "+
+			"sector conditions a sector with op eq or ne")
+	ErrInvalidScreenLogic = NewCError(http.StatusBadRequest,
+		"logic must be and or or")
+	ErrInvalidScreenSort = NewCError(http.StatusBadRequest,
+		"sort must be symbol, sector or a column of the conditions, "+
+			"with order asc or desc")
+
+	// Backtest handlers
+	ErrNoBacktestId = NewCError(http.StatusBadRequest,
+		"please provide backtest id")
+	ErrInvalidBacktestId = NewCError(http.StatusBadRequest,
+		"backtest id is not valid")
+	ErrBacktestNotFound = NewCError(http.StatusNotFound,
+		"no backtest with this id")
+	ErrInvalidStrategy = NewCError(http.StatusBadRequest,
+		"strategy must be sma_crossover, breakout or buy_and_hold")
+	ErrInvalidBacktest = NewCError(http.StatusBadRequest,
+		"periods and days must be positive with fast below slow, initial "+
+			"capital positive, commission and slippage fractions from 0 to below 1")
+
+	// Correlation handler
+	ErrNotEnoughSymbols = NewCError(http.StatusBadRequest,
+		"please provide at least two symbols, e.g. symbols=AAPL,MSFT")
+	ErrInvalidPair = NewCError(http.StatusBadRequest,
+		"pair must be two of the requested symbols, e.g. pair=AAPL,MSFT")
+	ErrBenchmarkIsSymbol = NewCError(http.StatusBadRequest,
+		"benchmark must be another symbol")
+	ErrInvalidBetaWindow = NewCError(http.StatusBadRequest,
+		"window must be at least 2 returns")
+	ErrNotEnoughCommonDates = NewCError(http.StatusUnprocessableEntity,
+		"the symbols share too few trading dates in the requested window")
 )
 
+func ErrUnknownIndicator(name string) error {
+	return NewCError(
+		http.StatusBadRequest,
+		fmt.Sprintf(
+			"unknown indicator %q; use sma, ema, rsi, macd or bbands",
+			name,
+		),
+	)
+}
+
+func ErrUnknownPattern(name string) error {
+	return NewCError(
+		http.StatusBadRequest,
+		fmt.Sprintf(
+			"unknown pattern %q; use doji, hammer, engulfing, morning_star, "+
+				"evening_star, harami or three_white_soldiers",
+			name,
+		),
+	)
+}
+
+func ErrInvalidNumber(field string) error {
+	return NewCError(
+		http.StatusBadRequest,
+		fmt.Sprintf(
+			"%s must be a positive whole number",
+			field,
+		),
+	)
+}
+
+func ErrInvalidDate(field string) error {
+	return NewCError(
+		http.StatusBadRequest,
+		fmt.Sprintf(
+			"%s must be a date formatted as %s",
+			field,
+			LayoutISO,
+		),
+	)
+}
+
+func ErrNoFXRate(base, quote, date string) error {
+	err := NewCError(
+		http.StatusUnprocessableEntity,
+		fmt.Sprintf(
+			"no %s/%s rate on or before %s; sync FX rates first",
+			base,
+			quote,
+			date,
+		),
+	)
+	err.kind = ErrMissingFXRate
+	return err
+}
+
+func ErrInvalidBody(err error) error {
+	return NewCError(
+		http.StatusBadRequest,
+		fmt.Sprintf(
+			"request body is not valid: %s",
+			err.Error(),
+		),
+	)
+}
+
+func ErrUnknownProvider(provider string) error {
+	return NewCError(
+		http.StatusBadRequest,
+		fmt.Sprintf(
+			"unknown data provider %q",
+			provider,
+		),
+	)
+}
+
+func ErrInvalidSchedule(err string) error {
+	return NewCError(
+		http.StatusInternalServerError,
+		fmt.Sprintf(
+			"invalid refresh schedule: %s",
+			err,
+		),
+	)
+}
+
 // Fetching data from e.g. Alpha Vantage API
 
 func ErrAlphaGet(err error) error {
@@ -73,6 +336,41 @@ func ErrAlphaParseBody(err string) error {
 	)
 }
 
+// Fetching data from other providers
+
+func ErrProviderGet(provider string, err error) error {
+	return NewCError(
+		http.StatusBadGateway,
+		fmt.Sprintf(
+			"%s GET error: %s",
+			provider,
+			err.Error(),
+		),
+	)
+}
+
+func ErrProviderReadAll(provider string, err error) error {
+	return NewCError(
+		http.StatusBadGateway,
+		fmt.Sprintf(
+			"%s body-io.ReadAll-parse error: %s",
+			provider,
+			err.Error(),
+		),
+	)
+}
+
+func ErrProviderParseBody(provider string, err string) error {
+	return NewCError(
+		http.StatusBadGateway,
+		fmt.Sprintf(
+			"%s response-body-parse error: %s",
+			provider,
+			err,
+		),
+	)
+}
+
 // Unexpected information text from Alpha Vantage API
 // (and corresponding constant error)
 var (
@@ -82,4 +380,10 @@ var (
 		"https://www.alphavantage.co/premium/ to instantly remove " +
 		"all daily rate limits."
 	ErrAPIExceed = NewCError(http.StatusBadGateway, "exceeded API-use limit today")
+
+	// Free keys are told e.g. "The **outputsize=full** parameter value
+	// is a premium feature for the TIME_SERIES_DAILY endpoint. ..."
+	APIPremiumFeature = "is a premium feature"
+	ErrAPIPremium     = NewCError(http.StatusBadGateway,
+		"the request needs a premium API plan")
 )
@@ -0,0 +1,25 @@
+package constant
+
+var (
+	// Currency of tickers listed in the US, and of rates quoted against
+	// an unknown currency
+	DefaultCurrency string = "USD"
+
+	// Decimal places kept in converted prices
+	ConvertedPricePlaces int32 = 4
+	// Rates of a pair stored the other way round are inverted to these
+	InvertedRatePlaces int32 = 10
+
+	// Currencies quoted in a fraction of another, e.g. pence sterling,
+	// with how many of them make one of the other
+	MinorCurrencies = map[string]MinorCurrency{
+		"GBX": {Major: "GBP", Units: 100},
+		"ZAC": {Major: "ZAR", Units: 100},
+		"ILA": {Major: "ILS", Units: 100},
+	}
+)
+
+type MinorCurrency struct {
+	Major string
+	Units int64
+}
@@ -0,0 +1,25 @@
+package constant
+
+import "time"
+
+var (
+	// Job statuses
+	JobQueued    string = "queued"
+	JobRunning   string = "running"
+	JobWaiting   string = "waiting"
+	JobSucceeded string = "succeeded"
+	JobFailed    string = "failed"
+
+	// Job types
+	JobTypeCollect  string = "collect"
+	JobTypeBackfill string = "backfill"
+	JobTypeAlert    string = "alert"
+
+	// Worker pool
+	DefaultJobWorkers int           = 2
+	JobPollInterval   time.Duration = 2 * time.Second
+	JobTimeout        time.Duration = 5 * time.Minute
+	// Claims after which a job left running by a stopped process
+	// is failed instead of queued again
+	JobMaxAttempts int = 5
+)
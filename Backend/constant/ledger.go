@@ -0,0 +1,15 @@
+package constant
+
+var (
+	// Ledger transaction types
+	TransactionBuy      string = "buy"
+	TransactionSell     string = "sell"
+	TransactionDividend string = "dividend"
+	TransactionFee      string = "fee"
+	TransactionSplit    string = "split"
+
+	// Lot accounting methods, selected with ?method=
+	LotFIFO    string = "fifo"
+	LotLIFO    string = "lifo"
+	LotAverage string = "average"
+)
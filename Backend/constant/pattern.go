@@ -0,0 +1,45 @@
+package constant
+
+var (
+	// Candlestick patterns, selected with ?type=
+	PatternDoji               string = "doji"
+	PatternHammer             string = "hammer"
+	PatternEngulfing          string = "engulfing"
+	PatternMorningStar        string = "morning_star"
+	PatternEveningStar        string = "evening_star"
+	PatternHarami             string = "harami"
+	PatternThreeWhiteSoldiers string = "three_white_soldiers"
+	Patterns                         = []string{
+		PatternDoji,
+		PatternHammer,
+		PatternEngulfing,
+		PatternMorningStar,
+		PatternEveningStar,
+		PatternHarami,
+		PatternThreeWhiteSoldiers,
+	}
+
+	// Annotation of stored daily bars with the patterns ending on them,
+	// selected with ?annotate=
+	AnnotatePatterns string = "patterns"
+
+	// What a pattern signals
+	DirectionBullish string = "bullish"
+	DirectionBearish string = "bearish"
+	DirectionNeutral string = "neutral"
+
+	// A doji's body is at most this share of its range
+	DojiBodyRatio string = "0.1"
+	// A long body is at least this share of its range
+	LongBodyRatio string = "0.5"
+	// A hammer's lower shadow is at least this many bodies long
+	HammerShadowRatio int64 = 2
+	// A star's body is at most this share of the first candle's body
+	StarBodyRatio string = "0.3"
+	// Soldiers close near their highs: upper shadows at most this share
+	// of their bodies
+	SoldierShadowRatio string = "0.3"
+	// A hammer needs the close before it below the one this many bars
+	// earlier
+	PatternTrendBars int = 3
+)
@@ -0,0 +1,19 @@
+package constant
+
+var (
+	// Reasons a bar is quarantined
+	ReasonHighBelowLow    string = "high is below low"
+	ReasonOpenOutOfRange  string = "open is outside the low-high range"
+	ReasonCloseOutOfRange string = "close is outside the low-high range"
+	ReasonBadPrice        string = "price is zero or negative"
+	ReasonBadVolume       string = "volume is zero or negative"
+	ReasonPriceJump       string = "close moved beyond the day-over-day threshold"
+
+	// Quarantine review statuses
+	QuarantinePending  string = "pending"
+	QuarantineAccepted string = "accepted"
+	QuarantineRejected string = "rejected"
+
+	// Largest day-over-day close move, as a fraction of the previous close
+	DefaultMaxDailyJump string = "0.5"
+)
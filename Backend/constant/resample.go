@@ -0,0 +1,19 @@
+package constant
+
+var (
+	// Bar intervals, selected with ?interval=
+	IntervalDay     string = "day"
+	IntervalWeek    string = "week"
+	IntervalMonth   string = "month"
+	IntervalQuarter string = "quarter"
+	IntervalYear    string = "year"
+
+	// Bars of each interval in a year, for annualizing
+	PeriodsPerYear = map[string]int{
+		IntervalDay:     252,
+		IntervalWeek:    52,
+		IntervalMonth:   12,
+		IntervalQuarter: 4,
+		IntervalYear:    1,
+	}
+)
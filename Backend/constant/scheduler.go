@@ -0,0 +1,23 @@
+package constant
+
+import "time"
+
+var (
+	// Cron-like schedule: minute hour day-of-month month day-of-week
+	// Default is 17:30 New York time on weekdays, after US market close
+	DefaultRefreshSchedule string = "30 17 * * 1-5"
+	DefaultRefreshTimezone string = "America/New_York"
+
+	// Free-tier Alpha Vantage daily quota
+	DefaultAlphaDailyQuota int = 25
+
+	// Upper bound for a single refresh run
+	RefreshRunTimeout time.Duration = 10 * time.Minute
+
+	// Number of past runs returned by the admin endpoint
+	SchedulerRunsShown int64 = 20
+
+	// How a refresh run was started
+	TriggerSchedule string = "schedule"
+	TriggerManual   string = "manual"
+)
@@ -0,0 +1,35 @@
+package constant
+
+var (
+	// Screen condition fields
+	ScreenClose       string = "close"
+	ScreenChange      string = "change"
+	ScreenVolumeRatio string = "volume_ratio"
+	ScreenPriceVsSMA  string = "price_vs_sma"
+	ScreenNewHigh     string = "new_52w_high"
+	ScreenNewLow      string = "new_52w_low"
+	ScreenSector      string = "sector"
+
+	// Comparisons of a condition's value
+	ScreenGT  string = "gt"
+	ScreenGTE string = "gte"
+	ScreenLT  string = "lt"
+	ScreenLTE string = "lte"
+	ScreenEQ  string = "eq"
+	ScreenNE  string = "ne"
+
+	// How conditions combine
+	ScreenAnd string = "and"
+	ScreenOr  string = "or"
+
+	// Bars looked back on when a condition asks for none
+	DefaultScreenDays = map[string]int{
+		ScreenChange:      1,
+		ScreenVolumeRatio: 20,
+		ScreenPriceVsSMA:  50,
+	}
+
+	// Sort orders of the matches
+	SortAsc  string = "asc"
+	SortDesc string = "desc"
+)
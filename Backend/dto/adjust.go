@@ -0,0 +1,27 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// Split value is new shares per old share (e.g. 4 for a 4-for-1 split),
+// dividend value is the cash amount per share; both take effect on Date
+type CorporateActionRes struct {
+	Symbol string          `json:"symbol"`
+	Date   DateOnly        `json:"date"`
+	Type   string          `json:"type"`
+	Value  decimal.Decimal `json:"value"`
+}
+
+// CorporateActions, SyncCorporateActions
+type CorporateActionsReq struct {
+	Symbol string
+}
+
+// StoredData
+type StoredDataReq struct {
+	Adjust   string
+	Interval string
+	Currency string
+	// Annotations added to each daily bar
+	Annotate []string
+	Anomaly  AnomalyParams
+}
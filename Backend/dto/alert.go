@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Creating an alert rule. Close alerts fire when the close crosses
+// Level; percent_change when the change over Days reaches Threshold
+// percent (a fall when negative); volume_above_average when volume
+// reaches Threshold times its average over the Days before; rsi alerts
+// when RSI over Period crosses Threshold
+type AlertRuleReq struct {
+	Symbol     string          `json:"symbol" binding:"required"`
+	Type       string          `json:"type" binding:"required"`
+	Level      decimal.Decimal `json:"level"`
+	Threshold  decimal.Decimal `json:"threshold"`
+	Days       int             `json:"days"`
+	Period     int             `json:"period"`
+	WebhookURL string          `json:"webhook_url" binding:"required,url"`
+}
+
+type AlertRuleRes struct {
+	Id         string          `json:"id"`
+	Symbol     string          `json:"symbol"`
+	Type       string          `json:"type"`
+	Level      decimal.Decimal `json:"level"`
+	Threshold  decimal.Decimal `json:"threshold"`
+	Days       int             `json:"days"`
+	Period     int             `json:"period"`
+	WebhookURL string          `json:"webhook_url"`
+	// Latest bar the rule was evaluated on
+	LastChecked *DateOnly `json:"last_checked"`
+	CreatedAt   time.Time `json:"created_at"`
+	// Collection queued for a symbol that wasn't tracked yet
+	Collecting *JobRes `json:"collecting,omitempty"`
+}
+
+// GetAlertRule, DeleteAlertRule, AlertTriggers
+type GetAlertReq struct {
+	Id string
+}
+
+// AlertRules; all symbols when Symbol is empty
+type AlertRulesReq struct {
+	Symbol string
+}
+
+// A firing of a rule and the delivery of its webhook
+type AlertTriggerRes struct {
+	Id          string          `json:"id"`
+	RuleId      string          `json:"rule_id"`
+	Symbol      string          `json:"symbol"`
+	Type        string          `json:"type"`
+	Date        DateOnly        `json:"date"`
+	Close       decimal.Decimal `json:"close"`
+	Value       decimal.Decimal `json:"value"`
+	Message     string          `json:"message"`
+	WebhookURL  string          `json:"webhook_url"`
+	Status      string          `json:"status"`
+	Attempts    int             `json:"attempts"`
+	LastError   string          `json:"last_error,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	DeliveredAt *time.Time      `json:"delivered_at"`
+}
+
+// Body of a webhook request
+type AlertWebhookReq struct {
+	TriggerId string          `json:"trigger_id"`
+	RuleId    string          `json:"rule_id"`
+	Symbol    string          `json:"symbol"`
+	Type      string          `json:"type"`
+	Date      DateOnly        `json:"date"`
+	Close     decimal.Decimal `json:"close"`
+	Value     decimal.Decimal `json:"value"`
+	Message   string          `json:"message"`
+}
@@ -20,9 +20,43 @@ type AlphaCollectSymbolMeta struct {
 	Symbol        string `json:"2. Symbol"`
 	LastRefreshed string `json:"3. Last Refreshed"`
 	OutputSize    string `json:"4. Output Size"`
+	TimeZone      string `json:"5. Time Zone"`
+}
+
+// Corporate actions
+type AlphaSplitRes struct {
+	EffectiveDate string `json:"effective_date"`
+	SplitFactor   string `json:"split_factor"`
+}
+
+type AlphaSplitsRes struct {
+	Data []AlphaSplitRes `json:"data"`
+}
+
+type AlphaDividendRes struct {
+	ExDividendDate string `json:"ex_dividend_date"`
+	Amount         string `json:"amount"`
+}
+
+type AlphaDividendsRes struct {
+	Data []AlphaDividendRes `json:"data"`
 }
 
 type AlphaStockDataRes struct {
 	MetaData   AlphaCollectSymbolMeta         `json:"Meta Data"`
 	TimeSeries map[string](map[string]string) `json:"Time Series (Daily)"`
 }
+
+// Fundamentals
+type AlphaOverviewRes struct {
+	Symbol   string `json:"Symbol"`
+	Name     string `json:"Name"`
+	Exchange string `json:"Exchange"`
+	Sector   string `json:"Sector"`
+	Industry string `json:"Industry"`
+}
+
+// FX rates
+type AlphaFXDailyRes struct {
+	TimeSeries map[string](map[string]string) `json:"Time Series FX (Daily)"`
+}
@@ -0,0 +1,46 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// How bars are scored against the Window bars before them; zero values
+// take the defaults
+type AnomalyParams struct {
+	Method    string
+	Threshold *decimal.Decimal
+	Window    int
+}
+
+// Anomalies
+type AnomaliesReq struct {
+	Series SeriesReq
+	Params AnomalyParams
+}
+
+// Direction is high or low; Score is how far Value strays from the
+// window, in standard (or MAD-scaled) deviations
+type AnomalyFlagRes struct {
+	Metric    string          `json:"metric"`
+	Value     decimal.Decimal `json:"value"`
+	Score     decimal.Decimal `json:"score"`
+	Direction string          `json:"direction"`
+}
+
+type AnomalyDateRes struct {
+	Date   DateOnly         `json:"date"`
+	Close  decimal.Decimal  `json:"close"`
+	Volume int              `json:"volume"`
+	Flags  []AnomalyFlagRes `json:"flags"`
+}
+
+// Only dates with a flag are listed; Counts are per metric
+type AnomaliesRes struct {
+	Symbol    string           `json:"symbol"`
+	Adjust    string           `json:"adjust"`
+	Interval  string           `json:"interval"`
+	Currency  string           `json:"currency"`
+	Method    string           `json:"method"`
+	Threshold decimal.Decimal  `json:"threshold"`
+	Window    int              `json:"window"`
+	Counts    map[string]int   `json:"counts"`
+	Dates     []AnomalyDateRes `json:"dates"`
+}
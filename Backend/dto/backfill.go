@@ -0,0 +1,9 @@
+package dto
+
+// BackfillSymbol
+type BackfillReq struct {
+	Symbol   string
+	From     *DateOnly
+	To       *DateOnly
+	Provider string
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Running a backtest. Commission is a fraction of the traded value and
+// slippage a fraction of the price, both paid on entries and exits.
+// Fast and Slow are the crossover's SMA periods; Days and ExitDays the
+// breakout's entry and exit lookbacks. Zero values take the defaults.
+type BacktestReq struct {
+	Symbol         string          `json:"symbol" binding:"required"`
+	Strategy       string          `json:"strategy" binding:"required"`
+	From           *DateOnly       `json:"from"`
+	To             *DateOnly       `json:"to"`
+	Adjust         string          `json:"adjust"`
+	InitialCapital decimal.Decimal `json:"initial_capital"`
+	Commission     decimal.Decimal `json:"commission"`
+	Slippage       decimal.Decimal `json:"slippage"`
+	Fast           int             `json:"fast"`
+	Slow           int             `json:"slow"`
+	Days           int             `json:"days"`
+	ExitDays       int             `json:"exit_days"`
+}
+
+// A round trip; open trades have no exit and are marked at the last close
+type BacktestTradeRes struct {
+	EntryDate  DateOnly         `json:"entry_date"`
+	EntryPrice decimal.Decimal  `json:"entry_price"`
+	Quantity   decimal.Decimal  `json:"quantity"`
+	ExitDate   *DateOnly        `json:"exit_date"`
+	ExitPrice  *decimal.Decimal `json:"exit_price"`
+	Commission decimal.Decimal  `json:"commission"`
+	PnL        decimal.Decimal  `json:"pnl"`
+	Return     decimal.Decimal  `json:"return"`
+	Open       bool             `json:"open"`
+}
+
+type EquityPointRes struct {
+	Date     DateOnly        `json:"date"`
+	Cash     decimal.Decimal `json:"cash"`
+	Position decimal.Decimal `json:"position"`
+	Equity   decimal.Decimal `json:"equity"`
+}
+
+// Returns and ratios are fractions. WinRate is null without closed
+// trades, Sharpe when equity doesn't vary. BuyAndHoldReturn is the
+// baseline over the same bars and costs.
+type BacktestSummaryRes struct {
+	FinalEquity      decimal.Decimal  `json:"final_equity"`
+	TotalReturn      decimal.Decimal  `json:"total_return"`
+	AnnualizedReturn decimal.Decimal  `json:"annualized_return"`
+	MaxDrawdown      DrawdownRes      `json:"max_drawdown"`
+	Sharpe           *decimal.Decimal `json:"sharpe"`
+	Trades           int              `json:"trades"`
+	WinRate          *decimal.Decimal `json:"win_rate"`
+	Exposure         decimal.Decimal  `json:"exposure"`
+	Commission       decimal.Decimal  `json:"commission"`
+	BuyAndHoldReturn decimal.Decimal  `json:"buy_and_hold_return"`
+}
+
+type BacktestRes struct {
+	Id             string             `json:"id"`
+	Symbol         string             `json:"symbol"`
+	Strategy       string             `json:"strategy"`
+	Adjust         string             `json:"adjust"`
+	From           DateOnly           `json:"from"`
+	To             DateOnly           `json:"to"`
+	InitialCapital decimal.Decimal    `json:"initial_capital"`
+	Commission     decimal.Decimal    `json:"commission"`
+	Slippage       decimal.Decimal    `json:"slippage"`
+	Fast           int                `json:"fast,omitempty"`
+	Slow           int                `json:"slow,omitempty"`
+	Days           int                `json:"days,omitempty"`
+	ExitDays       int                `json:"exit_days,omitempty"`
+	Summary        BacktestSummaryRes `json:"summary"`
+	Trades         []BacktestTradeRes `json:"trades"`
+	Equity         []EquityPointRes   `json:"equity"`
+	CreatedAt      time.Time          `json:"created_at"`
+}
+
+// GetBacktest
+type GetBacktestReq struct {
+	Id string
+}
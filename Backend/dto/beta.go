@@ -0,0 +1,70 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// Beta
+type BetaReq struct {
+	Series SeriesReq
+	// Empty for the configured default
+	Benchmark string
+	Window    int
+	// Annual rate as a fraction; nil for the configured default
+	RiskFree *decimal.Decimal
+}
+
+// Betas of all tracked symbols
+type BetasReq struct {
+	From      *DateOnly
+	To        *DateOnly
+	Adjust    string
+	Interval  string
+	Currency  string
+	Benchmark string
+	RiskFree  *decimal.Decimal
+}
+
+// Alpha is annualized Jensen's alpha and TrackingError annualized, as
+// fractions. Ratios are null when returns don't vary enough.
+type BetaStatsRes struct {
+	Symbol           string           `json:"symbol"`
+	From             DateOnly         `json:"from"`
+	To               DateOnly         `json:"to"`
+	Periods          int              `json:"periods"`
+	Beta             *decimal.Decimal `json:"beta"`
+	Alpha            *decimal.Decimal `json:"alpha"`
+	RSquared         *decimal.Decimal `json:"r_squared"`
+	Correlation      *decimal.Decimal `json:"correlation"`
+	TrackingError    decimal.Decimal  `json:"tracking_error"`
+	InformationRatio *decimal.Decimal `json:"information_ratio"`
+}
+
+// Values are null until the window holds enough returns
+type RollingBetaRes struct {
+	Window int               `json:"window"`
+	WarmUp int               `json:"warm_up"`
+	Points []RollingPointRes `json:"points"`
+}
+
+// While the benchmark is still being collected, only Collecting is set
+type BetaRes struct {
+	Symbol      string          `json:"symbol"`
+	Benchmark   string          `json:"benchmark"`
+	Adjust      string          `json:"adjust,omitempty"`
+	Interval    string          `json:"interval,omitempty"`
+	Currency    string          `json:"currency,omitempty"`
+	Stats       *BetaStatsRes   `json:"stats"`
+	Rolling     *RollingBetaRes `json:"rolling,omitempty"`
+	Conversions []ConversionRes `json:"conversions,omitempty"`
+	Collecting  *JobRes         `json:"collecting,omitempty"`
+}
+
+// Symbols without enough dates in common with the benchmark are skipped
+type BetasRes struct {
+	Benchmark  string         `json:"benchmark"`
+	Adjust     string         `json:"adjust,omitempty"`
+	Interval   string         `json:"interval,omitempty"`
+	Currency   string         `json:"currency,omitempty"`
+	Symbols    []BetaStatsRes `json:"symbols"`
+	Skipped    []string       `json:"skipped"`
+	Collecting *JobRes        `json:"collecting,omitempty"`
+}
@@ -0,0 +1,73 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// HeikinAshi
+type HeikinAshiReq struct {
+	Series SeriesReq
+}
+
+type HeikinAshiRes struct {
+	Symbol   string          `json:"symbol"`
+	Adjust   string          `json:"adjust"`
+	Interval string          `json:"interval"`
+	Currency string          `json:"currency"`
+	Candles  []DailyOHLCVRes `json:"candles"`
+}
+
+// How Renko bricks and point-and-figure boxes are sized: a fixed Box,
+// or the ATR over the given period at the window end; zero values take
+// the defaults
+type BoxParams struct {
+	Box      *decimal.Decimal
+	ATR      int
+	Reversal int
+}
+
+// Renko, PointFigure
+type BoxChartReq struct {
+	Series SeriesReq
+	Params BoxParams
+}
+
+// A brick completed by the close of Date; one bar can complete several
+type BrickRes struct {
+	Date      DateOnly        `json:"date"`
+	Open      decimal.Decimal `json:"open"`
+	Close     decimal.Decimal `json:"close"`
+	Direction string          `json:"direction"`
+}
+
+type RenkoRes struct {
+	Symbol    string          `json:"symbol"`
+	Adjust    string          `json:"adjust"`
+	Interval  string          `json:"interval"`
+	Currency  string          `json:"currency"`
+	Box       decimal.Decimal `json:"box"`
+	BoxSource string          `json:"box_source"`
+	ATR       int             `json:"atr,omitempty"`
+	Bricks    []BrickRes      `json:"bricks"`
+}
+
+// A column of x (rising) or o (falling) boxes at the price levels from
+// Low to High, built by the closes from Start to End
+type ColumnRes struct {
+	Direction string          `json:"direction"`
+	Low       decimal.Decimal `json:"low"`
+	High      decimal.Decimal `json:"high"`
+	Boxes     int             `json:"boxes"`
+	Start     DateOnly        `json:"start"`
+	End       DateOnly        `json:"end"`
+}
+
+type PointFigureRes struct {
+	Symbol    string          `json:"symbol"`
+	Adjust    string          `json:"adjust"`
+	Interval  string          `json:"interval"`
+	Currency  string          `json:"currency"`
+	Box       decimal.Decimal `json:"box"`
+	BoxSource string          `json:"box_source"`
+	ATR       int             `json:"atr,omitempty"`
+	Reversal  int             `json:"reversal"`
+	Columns   []ColumnRes     `json:"columns"`
+}
@@ -0,0 +1,40 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// Compare
+type CompareReq struct {
+	Symbols  []string
+	From     *DateOnly
+	To       *DateOnly
+	Adjust   string
+	Interval string
+	// Empty to keep each symbol's native currency
+	Currency string
+}
+
+// Values follow CompareRes.Dates: null before the symbol's first bar,
+// and carried forward over dates only other symbols traded on
+type CompareSeriesRes struct {
+	Symbol string   `json:"symbol"`
+	Start  DateOnly `json:"start"`
+	// Currency the values are in
+	Currency   string             `json:"currency"`
+	Conversion *ConversionRes     `json:"conversion,omitempty"`
+	Values     []*decimal.Decimal `json:"values"`
+	// Growth from the symbol's own start, and from CompareRes.CommonStart
+	Return       decimal.Decimal `json:"return"`
+	CommonReturn decimal.Decimal `json:"common_return"`
+}
+
+// Each close is rebased to 100 at its symbol's first bar in the window
+type CompareRes struct {
+	Symbols     []string           `json:"symbols"`
+	Adjust      string             `json:"adjust"`
+	Interval    string             `json:"interval"`
+	From        DateOnly           `json:"from"`
+	To          DateOnly           `json:"to"`
+	CommonStart DateOnly           `json:"common_start"`
+	Dates       []DateOnly         `json:"dates"`
+	Series      []CompareSeriesRes `json:"series"`
+}
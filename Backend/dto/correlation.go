@@ -0,0 +1,46 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// Correlation
+type CorrelationReq struct {
+	Symbols  []string
+	From     *DateOnly
+	To       *DateOnly
+	Adjust   string
+	Interval string
+	// Empty to keep each symbol's native currency
+	Currency string
+	// Two of Symbols for a rolling correlation; empty for none
+	Pair   []string
+	Window int
+}
+
+type RollingPointRes struct {
+	Date  DateOnly         `json:"date"`
+	Value *decimal.Decimal `json:"value"`
+}
+
+// Values are null until the window holds enough returns
+type RollingCorrelationRes struct {
+	Pair   []string          `json:"pair"`
+	Window int               `json:"window"`
+	WarmUp int               `json:"warm_up"`
+	Points []RollingPointRes `json:"points"`
+}
+
+// Matrices follow the order of Symbols; correlations are null where a
+// symbol's returns don't vary
+type CorrelationRes struct {
+	Symbols     []string               `json:"symbols"`
+	Adjust      string                 `json:"adjust"`
+	Interval    string                 `json:"interval"`
+	From        DateOnly               `json:"from"`
+	To          DateOnly               `json:"to"`
+	Days        int                    `json:"days"`
+	Currency    string                 `json:"currency,omitempty"`
+	Conversions []ConversionRes        `json:"conversions,omitempty"`
+	Correlation [][]*decimal.Decimal   `json:"correlation"`
+	Covariance  [][]decimal.Decimal    `json:"covariance"`
+	Rolling     *RollingCorrelationRes `json:"rolling,omitempty"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+// Fundamentals, SyncFundamentals
+type FundamentalsReq struct {
+	Symbol string
+}
+
+type FundamentalsRes struct {
+	Symbol    string    `json:"symbol"`
+	Name      string    `json:"name"`
+	Exchange  string    `json:"exchange"`
+	Sector    string    `json:"sector"`
+	Industry  string    `json:"industry"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
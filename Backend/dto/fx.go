@@ -0,0 +1,44 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// FXRates, SyncFXRates
+type FXRatesReq struct {
+	Base  string
+	Quote string
+	From  *DateOnly
+	To    *DateOnly
+}
+
+// Units of Quote one unit of Base buys at the day's close
+type FXRateRes struct {
+	Base  string          `json:"base"`
+	Quote string          `json:"quote"`
+	Date  DateOnly        `json:"date"`
+	Rate  decimal.Decimal `json:"rate"`
+}
+
+type FXSyncRes struct {
+	Base  string   `json:"base"`
+	Quote string   `json:"quote"`
+	Rates int      `json:"rates"`
+	From  DateOnly `json:"from"`
+	To    DateOnly `json:"to"`
+}
+
+// Rate applied to a bar; RateDate is before Date when no rate was
+// published that day and the last one was carried forward
+type ConversionRateRes struct {
+	Date     DateOnly        `json:"date"`
+	RateDate DateOnly        `json:"rate_date"`
+	Rate     decimal.Decimal `json:"rate"`
+}
+
+// Prices converted from the symbol's native currency; Rates covers the
+// daily bars of the requested window
+type ConversionRes struct {
+	Symbol   string              `json:"symbol"`
+	Native   string              `json:"native"`
+	Currency string              `json:"currency"`
+	Rates    []ConversionRateRes `json:"rates"`
+}
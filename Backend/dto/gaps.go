@@ -0,0 +1,30 @@
+package dto
+
+// Consecutive missing sessions
+type GapRangeRes struct {
+	From     DateOnly `json:"from"`
+	To       DateOnly `json:"to"`
+	Sessions int      `json:"sessions"`
+}
+
+type SymbolGapsRes struct {
+	Symbol           string    `json:"symbol"`
+	FirstBar         *DateOnly `json:"first_bar"`
+	LastRefreshed    DateOnly  `json:"last_refreshed"`
+	ExpectedSessions int       `json:"expected_sessions"`
+	StoredSessions   int       `json:"stored_sessions"`
+	MissingSessions  int       `json:"missing_sessions"`
+	// Sessions whose bar is held in (or rejected from) quarantine;
+	// not missing, so never refetched
+	QuarantinedSessions int           `json:"quarantined_sessions"`
+	Missing             []DateOnly    `json:"missing,omitempty"`
+	Ranges              []GapRangeRes `json:"ranges"`
+	Jobs                []*JobRes     `json:"jobs,omitempty"`
+}
+
+// SymbolGaps, GapsSummary
+type GapsReq struct {
+	Symbol   string
+	Refetch  bool
+	Provider string
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// Indicators
+type IndicatorsReq struct {
+	Series SeriesReq
+	Types  []string
+	Period int
+}
+
+type IndicatorRes struct {
+	Name   string         `json:"name"`
+	Params map[string]int `json:"params"`
+	// Value keys this indicator adds to each point
+	Keys []string `json:"keys"`
+	// Leading points without a value, for lack of earlier bars
+	WarmUp int `json:"warm_up"`
+}
+
+// Values are null while their indicator is warming up
+type IndicatorPointRes struct {
+	Date   DateOnly                    `json:"date"`
+	Close  decimal.Decimal             `json:"close"`
+	Values map[string]*decimal.Decimal `json:"values"`
+}
+
+type IndicatorsRes struct {
+	Symbol     string              `json:"symbol"`
+	Adjust     string              `json:"adjust"`
+	Interval   string              `json:"interval"`
+	Currency   string              `json:"currency"`
+	Conversion *ConversionRes      `json:"conversion,omitempty"`
+	Indicators []IndicatorRes      `json:"indicators"`
+	Points     []IndicatorPointRes `json:"points"`
+}
@@ -0,0 +1,53 @@
+package dto
+
+import "time"
+
+type JobErrorRes struct {
+	StatusCode int    `json:"status_code"`
+	Message    string `json:"message"`
+}
+
+// How far a backfill has got, kept so it can resume
+type JobProgressRes struct {
+	Cursor          *DateOnly `json:"cursor"`
+	Inserted        int       `json:"inserted"`
+	SkippedExisting int       `json:"skipped_existing"`
+	Quarantined     int       `json:"quarantined"`
+	FilledFrom      *DateOnly `json:"filled_from"`
+	FilledTo        *DateOnly `json:"filled_to"`
+}
+
+type JobRes struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Symbol   string `json:"symbol"`
+	Provider string `json:"provider,omitempty"`
+	// What the job works on besides the symbol, e.g. an alert trigger id
+	Ref        string          `json:"ref,omitempty"`
+	From       *DateOnly       `json:"from,omitempty"`
+	To         *DateOnly       `json:"to,omitempty"`
+	Status     string          `json:"status"`
+	Attempts   int             `json:"attempts"`
+	Error      *JobErrorRes    `json:"error"`
+	Progress   *JobProgressRes `json:"progress,omitempty"`
+	Result     map[string]any  `json:"result"`
+	CreatedAt  time.Time       `json:"created_at"`
+	StartedAt  *time.Time      `json:"started_at"`
+	ResumeAt   *time.Time      `json:"resume_at,omitempty"`
+	FinishedAt *time.Time      `json:"finished_at"`
+}
+
+// Enqueueing a job
+type JobReq struct {
+	Type     string
+	Symbol   string
+	Provider string
+	Ref      string
+	From     *DateOnly
+	To       *DateOnly
+}
+
+// GetJob
+type GetJobReq struct {
+	Id string
+}
@@ -0,0 +1,113 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Recording a transaction; PortfolioId comes from the path. Buys and
+// sells use Quantity and Price, dividends and fees Amount (cash), and
+// splits Ratio (new shares per old one)
+type TransactionReq struct {
+	PortfolioId string          `json:"-"`
+	Symbol      string          `json:"symbol"`
+	Type        string          `json:"type" binding:"required"`
+	Date        DateOnly        `json:"date"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	Price       decimal.Decimal `json:"price"`
+	Amount      decimal.Decimal `json:"amount"`
+	Ratio       decimal.Decimal `json:"ratio"`
+}
+
+type TransactionRes struct {
+	Id          string          `json:"id"`
+	PortfolioId string          `json:"portfolio_id"`
+	Symbol      string          `json:"symbol,omitempty"`
+	Type        string          `json:"type"`
+	Date        DateOnly        `json:"date"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	Price       decimal.Decimal `json:"price"`
+	Amount      decimal.Decimal `json:"amount"`
+	Ratio       decimal.Decimal `json:"ratio"`
+	CreatedAt   time.Time       `json:"created_at"`
+	// Collection job queued for an untracked symbol
+	Collecting *JobRes `json:"collecting,omitempty"`
+}
+
+// DeleteTransaction
+type GetTransactionReq struct {
+	PortfolioId string
+	Id          string
+}
+
+// Lots, Realized
+type LotsReq struct {
+	PortfolioId string
+	Method      string
+}
+
+// An open lot; with average cost, one per symbol. Close and the values
+// marked to it are null until the symbol has stored bars
+type LotRes struct {
+	Symbol      string           `json:"symbol"`
+	Currency    string           `json:"currency"`
+	Opened      DateOnly         `json:"opened"`
+	Quantity    decimal.Decimal  `json:"quantity"`
+	UnitCost    decimal.Decimal  `json:"unit_cost"`
+	Cost        decimal.Decimal  `json:"cost"`
+	Close       *decimal.Decimal `json:"close"`
+	CloseDate   *DateOnly        `json:"close_date"`
+	MarketValue *decimal.Decimal `json:"market_value"`
+	Unrealized  *decimal.Decimal `json:"unrealized"`
+}
+
+// The part of a lot a sale closed
+type ClosedLotRes struct {
+	Symbol       string          `json:"symbol"`
+	Currency     string          `json:"currency"`
+	Opened       DateOnly        `json:"opened"`
+	Closed       DateOnly        `json:"closed"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	UnitCost     decimal.Decimal `json:"unit_cost"`
+	UnitProceeds decimal.Decimal `json:"unit_proceeds"`
+	Cost         decimal.Decimal `json:"cost"`
+	Proceeds     decimal.Decimal `json:"proceeds"`
+	Gain         decimal.Decimal `json:"gain"`
+}
+
+// Sums over the lots of one currency that have a close
+type LotsTotalRes struct {
+	Cost        decimal.Decimal `json:"cost"`
+	MarketValue decimal.Decimal `json:"market_value"`
+	Unrealized  decimal.Decimal `json:"unrealized"`
+}
+
+// Totals are keyed by currency, as lots priced in different ones can't
+// be added up
+type LotsRes struct {
+	PortfolioId string                  `json:"portfolio_id"`
+	Method      string                  `json:"method"`
+	Lots        []LotRes                `json:"lots"`
+	Totals      map[string]LotsTotalRes `json:"totals"`
+}
+
+// Net is realized gains plus dividends less fees
+type RealizedTotalRes struct {
+	Gain      decimal.Decimal `json:"gain"`
+	Dividends decimal.Decimal `json:"dividends"`
+	Fees      decimal.Decimal `json:"fees"`
+	Net       decimal.Decimal `json:"net"`
+}
+
+// Dividends are keyed by symbol, totals by currency. Fees recorded
+// without a symbol count in the currency of the rest of the ledger, or
+// stay unassigned when it has more than one.
+type RealizedRes struct {
+	PortfolioId    string                      `json:"portfolio_id"`
+	Method         string                      `json:"method"`
+	Closed         []ClosedLotRes              `json:"closed"`
+	Dividends      map[string]decimal.Decimal  `json:"dividends"`
+	Totals         map[string]RealizedTotalRes `json:"totals"`
+	UnassignedFees decimal.Decimal             `json:"unassigned_fees"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// Patterns
+type PatternsReq struct {
+	Series SeriesReq
+	// Empty for all patterns
+	Types []string
+}
+
+// A pattern formed by the last Bars candles up to its date
+type PatternRes struct {
+	Name      string `json:"name"`
+	Direction string `json:"direction"`
+	Bars      int    `json:"bars"`
+}
+
+type PatternDateRes struct {
+	Date     DateOnly        `json:"date"`
+	Close    decimal.Decimal `json:"close"`
+	Patterns []PatternRes    `json:"patterns"`
+}
+
+// Only dates with a pattern are listed; Counts are per pattern name
+type PatternsRes struct {
+	Symbol   string           `json:"symbol"`
+	Adjust   string           `json:"adjust"`
+	Interval string           `json:"interval"`
+	Currency string           `json:"currency"`
+	Types    []string         `json:"types"`
+	Counts   map[string]int   `json:"counts"`
+	Dates    []PatternDateRes `json:"dates"`
+}
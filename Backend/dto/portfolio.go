@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type HoldingRes struct {
+	Symbol   string          `json:"symbol" binding:"required"`
+	Quantity decimal.Decimal `json:"quantity"`
+}
+
+// Creating or replacing a portfolio; Id comes from the path
+type PortfolioReq struct {
+	Id       string       `json:"-"`
+	Name     string       `json:"name" binding:"required"`
+	Holdings []HoldingRes `json:"holdings" binding:"dive"`
+}
+
+type PortfolioRes struct {
+	Id        string       `json:"id"`
+	Name      string       `json:"name"`
+	Holdings  []HoldingRes `json:"holdings"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	// Collection jobs queued for holdings of untracked symbols
+	Collecting []*JobRes `json:"collecting,omitempty"`
+}
+
+// GetPortfolio, DeletePortfolio
+type GetPortfolioReq struct {
+	Id string
+}
+
+// Valuation
+type ValuationReq struct {
+	Id       string
+	From     *DateOnly
+	To       *DateOnly
+	Adjust   string
+	Interval string
+	Currency string
+}
+
+// Contribution is the holding's gain since the first point, as a
+// fraction of the portfolio's value then; they add up to Return
+type HoldingValueRes struct {
+	Quantity     decimal.Decimal `json:"quantity"`
+	Close        decimal.Decimal `json:"close"`
+	Value        decimal.Decimal `json:"value"`
+	Weight       decimal.Decimal `json:"weight"`
+	Contribution decimal.Decimal `json:"contribution"`
+}
+
+type ValuationPointRes struct {
+	Date     DateOnly                   `json:"date"`
+	Value    decimal.Decimal            `json:"value"`
+	Return   decimal.Decimal            `json:"return"`
+	Holdings map[string]HoldingValueRes `json:"holdings"`
+}
+
+// Points start once every valued holding has a close, carrying closes
+// forward over dates a holding didn't trade on; holdings without
+// stored bars yet are listed as pending and left out. Holdings priced in
+// another currency than asked, or than the first valued holding when
+// none is, are converted, listing the rates used
+type ValuationRes struct {
+	Id          string              `json:"id"`
+	Name        string              `json:"name"`
+	Adjust      string              `json:"adjust"`
+	Interval    string              `json:"interval"`
+	Currency    string              `json:"currency,omitempty"`
+	Pending     []string            `json:"pending,omitempty"`
+	Points      []ValuationPointRes `json:"points"`
+	Conversions []ConversionRes     `json:"conversions,omitempty"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+type QuarantinedBarRes struct {
+	Id         string        `json:"id"`
+	Symbol     string        `json:"symbol"`
+	Bar        DailyOHLCVRes `json:"bar"`
+	Reasons    []string      `json:"reasons"`
+	Status     string        `json:"status"`
+	CreatedAt  time.Time     `json:"created_at"`
+	ReviewedAt *time.Time    `json:"reviewed_at"`
+}
+
+// QuarantinedBars
+type QuarantineReq struct {
+	Symbol string
+	Status string
+}
+
+// AcceptQuarantined, RejectQuarantined
+type ReviewQuarantineReq struct {
+	Id     string
+	Accept bool
+}
@@ -0,0 +1,49 @@
+package dto
+
+import "time"
+
+// RefreshSymbol
+type RefreshSymbolReq struct {
+	Symbol        string
+	LastRefreshed DateOnly
+}
+
+type RefreshSymbolRes struct {
+	Symbol        string   `json:"symbol"`
+	LastRefreshed DateOnly `json:"last_refreshed"`
+	Added         int      `json:"added"`
+	Quarantined   int      `json:"quarantined"`
+}
+
+// RefreshTracked
+type RefreshTrackedReq struct {
+	Trigger string
+}
+
+type RefreshFailure struct {
+	Symbol  string `json:"symbol"`
+	Message string `json:"message"`
+}
+
+type SchedulerRunRes struct {
+	Trigger    string           `json:"trigger"`
+	StartedAt  time.Time        `json:"started_at"`
+	FinishedAt time.Time        `json:"finished_at"`
+	Budget     int              `json:"budget"`
+	APICalls   int              `json:"api_calls"`
+	Refreshed  []string         `json:"refreshed"`
+	UpToDate   []string         `json:"up_to_date"`
+	Skipped    []string         `json:"skipped"`
+	Failures   []RefreshFailure `json:"failures"`
+}
+
+// Scheduler status for admin endpoints
+type SchedulerStatusRes struct {
+	Schedule string             `json:"schedule"`
+	Timezone string             `json:"timezone"`
+	Disabled bool               `json:"disabled"`
+	Paused   bool               `json:"paused"`
+	Running  bool               `json:"running"`
+	NextRun  *time.Time         `json:"next_run"`
+	Runs     []*SchedulerRunRes `json:"runs,omitempty"`
+}
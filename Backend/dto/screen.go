@@ -0,0 +1,45 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// A screen condition. Numeric fields compare their value with Value
+// using Op: close, change (percent over Days bars), volume_ratio (volume
+// over its average of the Days bars before) and price_vs_sma (percent
+// the close is above its Days-bar SMA). new_52w_high and new_52w_low
+// hold on symbols making one; sector compares stored fundamentals.
+type ScreenConditionReq struct {
+	Field  string          `json:"field" binding:"required"`
+	Op     string          `json:"op"`
+	Value  decimal.Decimal `json:"value"`
+	Days   int             `json:"days"`
+	Sector string          `json:"sector"`
+}
+
+// Conditions combine with Logic, and by default. Sort is symbol,
+// sector or one of the conditions' columns.
+type ScreenReq struct {
+	Conditions []ScreenConditionReq `json:"conditions" binding:"required,min=1,dive"`
+	Logic      string               `json:"logic"`
+	Adjust     string               `json:"adjust"`
+	Sort       string               `json:"sort"`
+	Order      string               `json:"order"`
+	Limit      int                  `json:"limit"`
+}
+
+// Values are keyed by column; null when there aren't enough bars
+type ScreenMatchRes struct {
+	Symbol string                      `json:"symbol"`
+	Date   DateOnly                    `json:"date"`
+	Sector string                      `json:"sector,omitempty"`
+	Values map[string]*decimal.Decimal `json:"values"`
+}
+
+type ScreenRes struct {
+	Logic    string           `json:"logic"`
+	Columns  []string         `json:"columns"`
+	Sort     string           `json:"sort"`
+	Order    string           `json:"order"`
+	Screened int              `json:"screened"`
+	Matched  int              `json:"matched"`
+	Matches  []ScreenMatchRes `json:"matches"`
+}
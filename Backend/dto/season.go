@@ -0,0 +1,70 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// Seasonality, Heatmap
+type SeasonalityReq struct {
+	Series SeriesReq
+}
+
+// Returns falling in one weekday, month or week of the year; HitRate
+// is the share of them above zero
+type SeasonBucketRes struct {
+	Key     int             `json:"key"`
+	Label   string          `json:"label"`
+	Count   int             `json:"count"`
+	Mean    decimal.Decimal `json:"mean"`
+	Median  decimal.Decimal `json:"median"`
+	HitRate decimal.Decimal `json:"hit_rate"`
+}
+
+// Weekdays bucket daily returns; Months and Weeks bucket returns
+// compounded over each calendar month and ISO week, leaving out the
+// periods the window only partly covers. Only buckets with returns are
+// listed.
+type SeasonalityRes struct {
+	Symbol   string            `json:"symbol"`
+	Adjust   string            `json:"adjust"`
+	Currency string            `json:"currency"`
+	From     DateOnly          `json:"from"`
+	To       DateOnly          `json:"to"`
+	Days     int               `json:"days"`
+	Weekdays []SeasonBucketRes `json:"weekdays"`
+	Months   []SeasonBucketRes `json:"months"`
+	Weeks    []SeasonBucketRes `json:"weeks"`
+}
+
+// Weekday counts from 1 for Monday; Week is the ISO week
+type HeatmapDayRes struct {
+	Date    DateOnly        `json:"date"`
+	Week    int             `json:"week"`
+	Weekday int             `json:"weekday"`
+	Return  decimal.Decimal `json:"return"`
+}
+
+// Partial when the window covers only part of the period
+type HeatmapMonthRes struct {
+	Month   int             `json:"month"`
+	Return  decimal.Decimal `json:"return"`
+	Partial bool            `json:"partial"`
+}
+
+type HeatmapYearRes struct {
+	Year    int               `json:"year"`
+	Return  decimal.Decimal   `json:"return"`
+	Partial bool              `json:"partial"`
+	Months  []HeatmapMonthRes `json:"months"`
+	Days    []HeatmapDayRes   `json:"days"`
+}
+
+// Min and Max bound the daily returns, for a colour scale
+type HeatmapRes struct {
+	Symbol   string           `json:"symbol"`
+	Adjust   string           `json:"adjust"`
+	Currency string           `json:"currency"`
+	From     DateOnly         `json:"from"`
+	To       DateOnly         `json:"to"`
+	Min      decimal.Decimal  `json:"min"`
+	Max      decimal.Decimal  `json:"max"`
+	Years    []HeatmapYearRes `json:"years"`
+}
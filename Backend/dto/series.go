@@ -0,0 +1,27 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// Which stored bars of a symbol to analyse, and how to present them
+type SeriesReq struct {
+	Symbol   string
+	From     *DateOnly
+	To       *DateOnly
+	Adjust   string
+	Interval string
+	// Empty for the symbol's native currency
+	Currency string
+	// Set when the series is loaded in another currency
+	Conversion *ConversionRes
+}
+
+// A bar aggregated over a period, labelled by the period's first
+// calendar day; Start and End are its first and last sessions
+type BarRes struct {
+	Period   DateOnly                   `json:"period"`
+	Start    DateOnly                   `json:"start"`
+	End      DateOnly                   `json:"end"`
+	Sessions int                        `json:"sessions"`
+	OHLC     map[string]decimal.Decimal `json:"ohlc"`
+	Volume   int                        `json:"volume"`
+}
@@ -0,0 +1,51 @@
+package dto
+
+import "github.com/shopspring/decimal"
+
+// Stats
+type StatsReq struct {
+	Series SeriesReq
+	// Annual rate as a fraction; nil for the configured default
+	RiskFree *decimal.Decimal
+}
+
+type ReturnPointRes struct {
+	Date   DateOnly        `json:"date"`
+	Close  decimal.Decimal `json:"close"`
+	Simple decimal.Decimal `json:"simple"`
+	Log    decimal.Decimal `json:"log"`
+}
+
+type DrawdownRes struct {
+	Value  decimal.Decimal `json:"value"`
+	Peak   DateOnly        `json:"peak"`
+	Trough DateOnly        `json:"trough"`
+}
+
+type DayReturnRes struct {
+	Date   DateOnly        `json:"date"`
+	Return decimal.Decimal `json:"return"`
+}
+
+// Ratios are null when returns don't vary enough to measure risk.
+// Returns are per bar, so best and worst days are best and worst
+// periods of a resampled series, and annualizing follows the interval.
+type StatsRes struct {
+	Symbol               string           `json:"symbol"`
+	Adjust               string           `json:"adjust"`
+	Interval             string           `json:"interval"`
+	Currency             string           `json:"currency"`
+	Conversion           *ConversionRes   `json:"conversion,omitempty"`
+	From                 DateOnly         `json:"from"`
+	To                   DateOnly         `json:"to"`
+	Days                 int              `json:"days"`
+	RiskFreeRate         decimal.Decimal  `json:"risk_free_rate"`
+	CumulativeReturn     decimal.Decimal  `json:"cumulative_return"`
+	AnnualizedVolatility decimal.Decimal  `json:"annualized_volatility"`
+	Sharpe               *decimal.Decimal `json:"sharpe"`
+	Sortino              *decimal.Decimal `json:"sortino"`
+	MaxDrawdown          DrawdownRes      `json:"max_drawdown"`
+	BestDay              DayReturnRes     `json:"best_day"`
+	WorstDay             DayReturnRes     `json:"worst_day"`
+	Returns              []ReturnPointRes `json:"returns"`
+}
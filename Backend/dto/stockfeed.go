@@ -9,10 +9,33 @@ import (
 
 type DateOnly time.Time
 
+// Calendar date of t in its own location, e.g. an exchange's local time,
+// stored as UTC midnight so it reads back the same from the database
+func NewDateOnly(t time.Time) DateOnly {
+	return DateOnly(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+}
+
 func (d DateOnly) MarshalJSON() ([]byte, error) {
 	return json.Marshal(time.Time(d).Format("2006-01-02"))
 }
 
+func (d *DateOnly) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	t, err := time.Parse("2006-01-02", text)
+	if err != nil {
+		return err
+	}
+	*d = DateOnly(t)
+	return nil
+}
+
+func (d DateOnly) String() string {
+	return time.Time(d).Format("2006-01-02")
+}
+
 func (d DateOnly) Weekday() time.Weekday {
 	return time.Time(d).Weekday()
 }
@@ -32,14 +55,22 @@ func (d DateOnly) After(e DateOnly) bool {
 // General use containers for stock data
 type SymbolDataMeta struct {
 	Symbol        string   `json:"symbol"`
+	Exchange      string   `json:"exchange,omitempty"`
+	TimeZone      string   `json:"time_zone,omitempty"`
+	Currency      string   `json:"currency,omitempty"`
 	LastRefreshed DateOnly `json:"last_refreshed"`
 	Size          int      `json:"size"`
+	Quarantined   int      `json:"quarantined,omitempty"`
 }
 
 type DailyOHLCVRes struct {
 	Day    DateOnly                   `json:"day"`
 	OHLC   map[string]decimal.Decimal `json:"ohlc"`
 	Volume int                        `json:"volume"`
+	// Candlestick patterns ending on the day, when annotated
+	Patterns []PatternRes `json:"patterns,omitempty"`
+	// Return and volume anomalies of the day, when annotated
+	Anomalies []AnomalyFlagRes `json:"anomalies,omitempty"`
 }
 
 type DataPerSymbol struct {
@@ -47,15 +78,30 @@ type DataPerSymbol struct {
 	TimeSeries []DailyOHLCVRes
 }
 
+type HolidayRes struct {
+	Date DateOnly `json:"date"`
+	Name string   `json:"name"`
+}
+
+// Monday and Friday are the first and last trading sessions of the
+// exchange's week, so e.g. a week ending on Good Friday is labelled with
+// its Thursday, and a Tadawul week runs from Sunday to Thursday
 type WeekRes struct {
-	Monday    DateOnly        `json:"monday"`
-	Friday    DateOnly        `json:"friday"`
-	DailyData []DailyOHLCVRes `json:"daily_data"`
+	Monday      DateOnly        `json:"monday"`
+	Friday      DateOnly        `json:"friday"`
+	Holidays    []HolidayRes    `json:"holidays,omitempty"`
+	EarlyCloses []DateOnly      `json:"early_closes,omitempty"`
+	DailyData   []DailyOHLCVRes `json:"daily_data"`
 }
 
+// Daily bars come grouped by week; other intervals as aggregated bars
 type StockDataRes struct {
 	MetaData *SymbolDataMeta `json:"meta_data"`
-	Weeks    []*WeekRes      `json:"weeks_covered"`
+	Weeks    []*WeekRes      `json:"weeks_covered,omitempty"`
+	Interval string          `json:"interval,omitempty"`
+	Bars     []BarRes        `json:"bars,omitempty"`
+	// Set when prices were converted to another currency
+	Conversion *ConversionRes `json:"conversion,omitempty"`
 }
 
 // GetSymbols
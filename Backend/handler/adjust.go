@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) CorporateActions(ctx *gin.Context) {
+	hd.corporateActions(ctx, false)
+}
+
+func (hd *Handler) SyncCorporateActions(ctx *gin.Context) {
+	hd.corporateActions(ctx, true)
+}
+
+func (hd *Handler) corporateActions(ctx *gin.Context, sync bool) {
+	// request validation
+	symbol := ctx.Param("symbol")
+	if symbol == "" {
+		ctx.Error(constant.ErrNoSymbol)
+		return
+	}
+	var req dto.CorporateActionsReq
+	req.Symbol = symbol
+
+	// usecase
+	var actions []dto.CorporateActionRes
+	var err error
+	if sync {
+		actions, err = hd.uc.SyncCorporateActions(ctx, &req)
+	} else {
+		actions, err = hd.uc.CorporateActions(ctx, &req)
+	}
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    actions,
+		})
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/scheduler"
+	"Backend/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminHandlerItf interface {
+	SchedulerStatus(*gin.Context)
+	PauseScheduler(*gin.Context)
+	ResumeScheduler(*gin.Context)
+	TriggerScheduler(*gin.Context)
+
+	QuarantinedBars(*gin.Context)
+	AcceptQuarantined(*gin.Context)
+	RejectQuarantined(*gin.Context)
+}
+
+type AdminHandler struct {
+	uc usecase.UsecaseItf
+	sc scheduler.SchedulerItf
+}
+
+func NewAdminHandler(uc usecase.UsecaseItf, sc scheduler.SchedulerItf) *AdminHandler {
+	return &AdminHandler{
+		uc: uc,
+		sc: sc,
+	}
+}
+
+func (hd *AdminHandler) SchedulerStatus(ctx *gin.Context) {
+	status := hd.sc.Status()
+
+	// usecase
+	runs, err := hd.uc.SchedulerRuns(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	status.Runs = runs
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    status,
+		})
+}
+
+func (hd *AdminHandler) PauseScheduler(ctx *gin.Context) {
+	hd.sc.Pause()
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": "scheduler paused",
+			"error":   nil,
+			"data":    hd.sc.Status(),
+		})
+}
+
+func (hd *AdminHandler) ResumeScheduler(ctx *gin.Context) {
+	hd.sc.Resume()
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": "scheduler resumed",
+			"error":   nil,
+			"data":    hd.sc.Status(),
+		})
+}
+
+func (hd *AdminHandler) TriggerScheduler(ctx *gin.Context) {
+	err := hd.sc.Trigger()
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted,
+		gin.H{
+			"message": "refresh run started",
+			"error":   nil,
+			"data":    hd.sc.Status(),
+		})
+}
+
+func (hd *AdminHandler) QuarantinedBars(ctx *gin.Context) {
+	// request validation
+	var req dto.QuarantineReq
+	req.Symbol = ctx.Query("symbol")
+	req.Status = ctx.Query("status")
+
+	// usecase
+	bars, err := hd.uc.QuarantinedBars(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    bars,
+		})
+}
+
+func (hd *AdminHandler) AcceptQuarantined(ctx *gin.Context) {
+	hd.reviewQuarantined(ctx, true)
+}
+
+func (hd *AdminHandler) RejectQuarantined(ctx *gin.Context) {
+	hd.reviewQuarantined(ctx, false)
+}
+
+func (hd *AdminHandler) reviewQuarantined(ctx *gin.Context, accept bool) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoQuarantineId)
+		return
+	}
+	var req dto.ReviewQuarantineReq
+	req.Id = id
+	req.Accept = accept
+
+	// usecase
+	bar, err := hd.uc.ReviewQuarantined(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	message := "bar rejected"
+	if accept {
+		message = "bar accepted and stored"
+	}
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": message,
+			"error":   nil,
+			"data":    bar,
+		})
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) CreateAlertRule(ctx *gin.Context) {
+	// request validation
+	var req dto.AlertRuleReq
+	err := bindJSON(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	// usecase
+	rule, err := hd.uc.CreateAlertRule(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    rule,
+		})
+}
+
+func (hd *Handler) AlertRules(ctx *gin.Context) {
+	// request validation
+	var req dto.AlertRulesReq
+	req.Symbol = ctx.Query("symbol")
+
+	// usecase
+	rules, err := hd.uc.AlertRules(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    rules,
+		})
+}
+
+func (hd *Handler) GetAlertRule(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoAlertId)
+		return
+	}
+	var req dto.GetAlertReq
+	req.Id = id
+
+	// usecase
+	rule, err := hd.uc.GetAlertRule(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    rule,
+		})
+}
+
+func (hd *Handler) DeleteAlertRule(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoAlertId)
+		return
+	}
+	var req dto.GetAlertReq
+	req.Id = id
+
+	// usecase
+	err := hd.uc.DeleteAlertRule(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    nil,
+		})
+}
+
+func (hd *Handler) AlertTriggers(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoAlertId)
+		return
+	}
+	var req dto.GetAlertReq
+	req.Id = id
+
+	// usecase
+	triggers, err := hd.uc.AlertTriggers(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    triggers,
+		})
+}
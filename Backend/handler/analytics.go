@@ -0,0 +1,338 @@
+package handler
+
+import (
+	"Backend/dto"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) Indicators(ctx *gin.Context) {
+	// request validation
+	series, err := seriesQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	period, err := intQuery(ctx, "period")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.IndicatorsReq
+	req.Series = *series
+	req.Types = listQuery(ctx, "type")
+	for i, name := range req.Types {
+		req.Types[i] = strings.ToLower(name)
+	}
+	req.Period = period
+
+	// usecase
+	indicators, err := hd.uc.Indicators(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    indicators,
+		})
+}
+
+func (hd *Handler) Stats(ctx *gin.Context) {
+	// request validation
+	series, err := seriesQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	riskFree, err := riskFreeQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.StatsReq
+	req.Series = *series
+	req.RiskFree = riskFree
+
+	// usecase
+	stats, err := hd.uc.Stats(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    stats,
+		})
+}
+
+func (hd *Handler) Correlation(ctx *gin.Context) {
+	// request validation
+	from, err := dateQuery(ctx, "from")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	to, err := dateQuery(ctx, "to")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	window, err := intQuery(ctx, "window")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.CorrelationReq
+	req.Symbols = listQuery(ctx, "symbols")
+	req.From = from
+	req.To = to
+	req.Adjust = ctx.Query("adjust")
+	req.Interval = ctx.Query("interval")
+	req.Currency = ctx.Query("currency")
+	req.Pair = listQuery(ctx, "pair")
+	req.Window = window
+
+	// usecase
+	correlation, err := hd.uc.Correlation(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    correlation,
+		})
+}
+
+func (hd *Handler) Compare(ctx *gin.Context) {
+	// request validation
+	from, err := dateQuery(ctx, "from")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	to, err := dateQuery(ctx, "to")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.CompareReq
+	req.Symbols = listQuery(ctx, "symbols")
+	req.From = from
+	req.To = to
+	req.Adjust = ctx.Query("adjust")
+	req.Interval = ctx.Query("interval")
+	req.Currency = ctx.Query("currency")
+
+	// usecase
+	comparison, err := hd.uc.Compare(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    comparison,
+		})
+}
+
+func (hd *Handler) Beta(ctx *gin.Context) {
+	// request validation
+	series, err := seriesQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	window, err := intQuery(ctx, "window")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	riskFree, err := riskFreeQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.BetaReq
+	req.Series = *series
+	req.Benchmark = ctx.Query("benchmark")
+	req.Window = window
+	req.RiskFree = riskFree
+
+	// usecase
+	beta, err := hd.uc.Beta(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	// Accepted while the benchmark is still being collected
+	status := http.StatusOK
+	if beta.Collecting != nil {
+		status = http.StatusAccepted
+	}
+	ctx.JSON(status,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    beta,
+		})
+}
+
+func (hd *Handler) Betas(ctx *gin.Context) {
+	// request validation
+	from, err := dateQuery(ctx, "from")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	to, err := dateQuery(ctx, "to")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	riskFree, err := riskFreeQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.BetasReq
+	req.From = from
+	req.To = to
+	req.Adjust = ctx.Query("adjust")
+	req.Interval = ctx.Query("interval")
+	req.Currency = ctx.Query("currency")
+	req.Benchmark = ctx.Query("benchmark")
+	req.RiskFree = riskFree
+
+	// usecase
+	betas, err := hd.uc.Betas(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	status := http.StatusOK
+	if betas.Collecting != nil {
+		status = http.StatusAccepted
+	}
+	ctx.JSON(status,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    betas,
+		})
+}
+
+func (hd *Handler) Patterns(ctx *gin.Context) {
+	// request validation
+	series, err := seriesQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.PatternsReq
+	req.Series = *series
+	req.Types = listQuery(ctx, "type")
+	for i, name := range req.Types {
+		req.Types[i] = strings.ToLower(name)
+	}
+
+	// usecase
+	patterns, err := hd.uc.Patterns(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    patterns,
+		})
+}
+
+func (hd *Handler) Seasonality(ctx *gin.Context) {
+	hd.seasonality(ctx, false)
+}
+
+func (hd *Handler) Heatmap(ctx *gin.Context) {
+	hd.seasonality(ctx, true)
+}
+
+func (hd *Handler) seasonality(ctx *gin.Context, heatmap bool) {
+	// request validation
+	series, err := seriesQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.SeasonalityReq
+	req.Series = *series
+
+	// usecase
+	var data any
+	if heatmap {
+		data, err = hd.uc.Heatmap(ctx, &req)
+	} else {
+		data, err = hd.uc.Seasonality(ctx, &req)
+	}
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    data,
+		})
+}
+
+func (hd *Handler) Anomalies(ctx *gin.Context) {
+	// request validation
+	series, err := seriesQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	params, err := anomalyQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.AnomaliesReq
+	req.Series = *series
+	req.Params = *params
+
+	// usecase
+	anomalies, err := hd.uc.Anomalies(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    anomalies,
+		})
+}
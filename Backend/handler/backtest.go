@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) RunBacktest(ctx *gin.Context) {
+	// request validation
+	var req dto.BacktestReq
+	err := bindJSON(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	// usecase
+	backtest, err := hd.uc.RunBacktest(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    backtest,
+		})
+}
+
+func (hd *Handler) GetBacktest(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoBacktestId)
+		return
+	}
+	var req dto.GetBacktestReq
+	req.Id = id
+
+	// usecase
+	backtest, err := hd.uc.GetBacktest(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    backtest,
+		})
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"Backend/constant"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// JSON request body bound into req; validation errors are kept for the
+// error middleware to list by field, anything else the body got wrong
+// (malformed JSON, wrong types, bad dates) is a bad request too
+func bindJSON(ctx *gin.Context, req any) error {
+	err := ctx.ShouldBindJSON(req)
+	if err == nil {
+		return nil
+	}
+	var ve validator.ValidationErrors
+	if errors.As(err, &ve) {
+		return err
+	}
+	return constant.ErrInvalidBody(err)
+}
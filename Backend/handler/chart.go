@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) HeikinAshi(ctx *gin.Context) {
+	// request validation
+	series, err := seriesQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.HeikinAshiReq
+	req.Series = *series
+
+	// usecase
+	candles, err := hd.uc.HeikinAshi(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    candles,
+		})
+}
+
+func (hd *Handler) Renko(ctx *gin.Context) {
+	hd.boxChart(ctx, false)
+}
+
+func (hd *Handler) PointFigure(ctx *gin.Context) {
+	hd.boxChart(ctx, true)
+}
+
+func (hd *Handler) boxChart(ctx *gin.Context, pointFigure bool) {
+	// request validation
+	series, err := seriesQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	params, err := boxQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.BoxChartReq
+	req.Series = *series
+	req.Params = *params
+
+	// usecase
+	var data any
+	if pointFigure {
+		data, err = hd.uc.PointFigure(ctx, &req)
+	} else {
+		data, err = hd.uc.Renko(ctx, &req)
+	}
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    data,
+		})
+}
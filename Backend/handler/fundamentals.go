@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) Fundamentals(ctx *gin.Context) {
+	hd.fundamentals(ctx, false)
+}
+
+func (hd *Handler) SyncFundamentals(ctx *gin.Context) {
+	hd.fundamentals(ctx, true)
+}
+
+func (hd *Handler) fundamentals(ctx *gin.Context, sync bool) {
+	// request validation
+	symbol := ctx.Param("symbol")
+	if symbol == "" {
+		ctx.Error(constant.ErrNoSymbol)
+		return
+	}
+	var req dto.FundamentalsReq
+	req.Symbol = symbol
+
+	// usecase
+	var fundamentals *dto.FundamentalsRes
+	var err error
+	if sync {
+		fundamentals, err = hd.uc.SyncFundamentals(ctx, &req)
+	} else {
+		fundamentals, err = hd.uc.Fundamentals(ctx, &req)
+	}
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    fundamentals,
+		})
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) FXRates(ctx *gin.Context) {
+	// request validation
+	from, err := dateQuery(ctx, "from")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	to, err := dateQuery(ctx, "to")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.FXRatesReq
+	req.Base = ctx.Param("base")
+	req.Quote = ctx.Param("quote")
+	req.From = from
+	req.To = to
+
+	// usecase
+	rates, err := hd.uc.FXRates(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    rates,
+		})
+}
+
+func (hd *Handler) SyncFXRates(ctx *gin.Context) {
+	// request validation
+	var req dto.FXRatesReq
+	req.Base = ctx.Param("base")
+	req.Quote = ctx.Param("quote")
+
+	// usecase
+	synced, err := hd.uc.SyncFXRates(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    synced,
+		})
+}
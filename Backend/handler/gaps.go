@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) SymbolGaps(ctx *gin.Context) {
+	hd.symbolGaps(ctx, false)
+}
+
+func (hd *Handler) RefetchSymbolGaps(ctx *gin.Context) {
+	hd.symbolGaps(ctx, true)
+}
+
+func (hd *Handler) symbolGaps(ctx *gin.Context, refetch bool) {
+	// request validation
+	symbol := ctx.Param("symbol")
+	if symbol == "" {
+		ctx.Error(constant.ErrNoSymbol)
+		return
+	}
+	var req dto.GapsReq
+	req.Symbol = symbol
+	req.Refetch = refetch
+	req.Provider = ctx.Query("provider")
+
+	// usecase
+	gaps, err := hd.uc.SymbolGaps(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	status := http.StatusOK
+	if refetch {
+		status = http.StatusAccepted
+	}
+	ctx.JSON(status,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    gaps,
+		})
+}
+
+func (hd *Handler) GapsSummary(ctx *gin.Context) {
+	hd.gapsSummary(ctx, false)
+}
+
+func (hd *Handler) RefetchGaps(ctx *gin.Context) {
+	hd.gapsSummary(ctx, true)
+}
+
+func (hd *Handler) gapsSummary(ctx *gin.Context, refetch bool) {
+	var req dto.GapsReq
+	req.Refetch = refetch
+	req.Provider = ctx.Query("provider")
+
+	// usecase
+	summary, err := hd.uc.GapsSummary(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	status := http.StatusOK
+	if refetch {
+		status = http.StatusAccepted
+	}
+	ctx.JSON(status,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    summary,
+		})
+}
@@ -5,15 +5,18 @@ import (
 	"Backend/dto"
 	mocks "Backend/mocks/usecase"
 	"Backend/usecase"
-	"Backend/util"
 	"errors"
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/assert"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
 )
 
 func TestUnitHandlerGetSymbols(t *testing.T) {
@@ -164,7 +167,7 @@ func TestUnitHandlerCollectSymbol(t *testing.T) {
 				req.Symbol = "IBM"
 
 				// usecase mechanism
-				mock.On("CollectSymbol", ctx, &req).Return(nil, constant.ErrAPIExceed)
+				mock.On("EnqueueCollect", ctx, &req).Return(nil, constant.ErrStockAlready)
 
 				return mock
 			},
@@ -176,7 +179,7 @@ func TestUnitHandlerCollectSymbol(t *testing.T) {
 				var ce constant.CustomError
 				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
 				log.Println(ce)
-				assert.Equal(t, errors.Is(ce, constant.ErrAPIExceed), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrStockAlready), true)
 			},
 		},
 		{
@@ -190,53 +193,24 @@ func TestUnitHandlerCollectSymbol(t *testing.T) {
 				req.Symbol = "AAPL"
 
 				// output from usecase
-				// - whole stock data structure
-				var stockData dto.StockDataRes
-
-				// - meta data (and time setup code)
-				var meta dto.SymbolDataMeta
-				meta.Symbol = "AAPL"
-				meta.Size = 3
-
-				dateGen := util.NewDateGenerator("2025-06-01")
-				meta.LastRefreshed = dto.DateOnly(dateGen.Current())
-
-				stockData.MetaData = &meta
-
-				// - weeks
-				ohlcvGen := util.NewOHLCVGenerator(dateGen, 100, 1)
-				stockData.Weeks = []*dto.WeekRes{
-					{
-						Monday: dateGen.Next(),
-						Friday: dateGen.Next(),
-						DailyData: []dto.DailyOHLCVRes{
-							ohlcvGen.Next(),
-						},
-					},
-					{
-						Monday: dateGen.Next(),
-						Friday: dateGen.Next(),
-						DailyData: []dto.DailyOHLCVRes{
-							ohlcvGen.Next(),
-							ohlcvGen.Next(),
-						},
-					},
+				job := dto.JobRes{
+					Id:        "6851a1f0c2a4b1e6d4f3a2b1",
+					Type:      constant.JobTypeCollect,
+					Symbol:    "AAPL",
+					Status:    constant.JobQueued,
+					CreatedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
 				}
 
 				// usecase mechanism
-				mock.On("CollectSymbol", ctx, &req).Return(&stockData, nil)
+				mock.On("EnqueueCollect", ctx, &req).Return(&job, nil)
 
 				return mock
 			},
-			expectedStatus: http.StatusCreated,
-			expectedBody: `{"data":{"meta_data":{"symbol":"AAPL","last_refreshed":` +
-				`"2025-06-01","size":3},"weeks_covered":[{"monday":"2025-06-02","friday":` +
-				`"2025-06-03","daily_data":[{"day":"2025-06-04","ohlc":{"close":"101",` +
-				`"high":"102","low":"103","open":"104"},"volume":1}]},{"monday":"2025-06-05"` +
-				`,"friday":"2025-06-06","daily_data":[{"day":"2025-06-07","ohlc":{"close":` +
-				`"201","high":"202","low":"203","open":"204"},"volume":2},{"day":"2025-06-08"` +
-				`,"ohlc":{"close":"301","high":"302","low":"303","open":"304"},"volume":3}]}]}` +
-				`,"error":null,"message":null}`,
+			expectedStatus: http.StatusAccepted,
+			expectedBody: `{"data":{"id":"6851a1f0c2a4b1e6d4f3a2b1","type":"collect",` +
+				`"symbol":"AAPL","status":"queued","attempts":0,"error":null,` +
+				`"result":null,"created_at":"2025-06-01T12:00:00Z","started_at":null,` +
+				`"finished_at":null},"error":null,"message":null}`,
 			expectedError: func(ctx *gin.Context) {
 				assert.Equal(t, len(ctx.Errors), 0)
 			},
@@ -276,3 +250,1448 @@ func TestUnitHandlerCollectSymbol(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitHandlerCreatePortfolio(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "malformed JSON body",
+			body: `{"name": "Core"`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "field of the wrong type",
+			body: `{"name": "Core", "holdings": [{"symbol": "AAPL", "quantity": true}]}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "required field missing",
+			body: `{"holdings": []}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ve validator.ValidationErrors
+				assert.Equal(t, errors.As(ctx.Errors[0], &ve), true)
+				assert.Equal(t, ve[0].Field(), "Name")
+			},
+		},
+		{
+			name: "usecase returns error",
+			body: `{"name": "Core", "holdings": [{"symbol": "AAPL", "quantity": "-1"}]}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.PortfolioReq
+				req.Name = "Core"
+				req.Holdings = []dto.HoldingRes{
+					{Symbol: "AAPL", Quantity: decimal.RequireFromString("-1")},
+				}
+
+				// usecase mechanism
+				mock.On("CreatePortfolio", ctx, &req).Return(nil, constant.ErrInvalidHolding)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrInvalidHolding), true)
+			},
+		},
+		{
+			name: "handling successful usecase outcome",
+			body: `{"name": "Core", "holdings": [{"symbol": "AAPL", "quantity": "10"}]}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.PortfolioReq
+				req.Name = "Core"
+				req.Holdings = []dto.HoldingRes{
+					{Symbol: "AAPL", Quantity: decimal.RequireFromString("10")},
+				}
+
+				// output from usecase
+				created := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+				portfolio := dto.PortfolioRes{
+					Id:        "6851a1f0c2a4b1e6d4f3a2b1",
+					Name:      "Core",
+					Holdings:  req.Holdings,
+					CreatedAt: created,
+					UpdatedAt: created,
+				}
+
+				// usecase mechanism
+				mock.On("CreatePortfolio", ctx, &req).Return(&portfolio, nil)
+
+				return mock
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"data":{"id":"6851a1f0c2a4b1e6d4f3a2b1","name":"Core",` +
+				`"holdings":[{"symbol":"AAPL","quantity":"10"}],` +
+				`"created_at":"2025-06-01T12:00:00Z","updated_at":"2025-06-01T12:00:00Z"},` +
+				`"error":null,"message":null}`,
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 0)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("POST", "/portfolios", strings.NewReader(tt.body))
+			c.Request = r
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.CreatePortfolio(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerUpdatePortfolio(t *testing.T) {
+	testCases := []struct {
+		name           string
+		id             string
+		body           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "no path parameter provided",
+			id:   "",
+			body: `{"name": "Core"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrNoPortfolioId), true)
+			},
+		},
+		{
+			name: "malformed JSON body",
+			id:   "6851a1f0c2a4b1e6d4f3a2b1",
+			body: `name=Core`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "usecase returns error",
+			id:   "6851a1f0c2a4b1e6d4f3a2b1",
+			body: `{"name": "Core"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.PortfolioReq
+				req.Id = "6851a1f0c2a4b1e6d4f3a2b1"
+				req.Name = "Core"
+
+				// usecase mechanism
+				mock.On("UpdatePortfolio", ctx, &req).Return(nil, constant.ErrPortfolioNotFound)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrPortfolioNotFound), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("PUT", "/portfolios/"+tt.id, strings.NewReader(tt.body))
+			c.Request = r
+			c.Params = gin.Params{{Key: "id", Value: tt.id}}
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.UpdatePortfolio(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerValuePortfolio(t *testing.T) {
+	testCases := []struct {
+		name           string
+		link           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "from is not a date",
+			link: "/portfolios/6851a1f0c2a4b1e6d4f3a2b1/valuation?from=June",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.Message, constant.ErrInvalidDate("from").Error())
+			},
+		},
+		{
+			name: "to is not a date",
+			link: "/portfolios/6851a1f0c2a4b1e6d4f3a2b1/valuation?to=2025-13-01",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.Message, constant.ErrInvalidDate("to").Error())
+			},
+		},
+		{
+			name: "query passed on to usecase",
+			link: "/portfolios/6851a1f0c2a4b1e6d4f3a2b1/valuation" +
+				"?from=2025-06-02&adjust=split&interval=weekly&currency=GBP",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				from := dto.DateOnly(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC))
+				var req dto.ValuationReq
+				req.Id = "6851a1f0c2a4b1e6d4f3a2b1"
+				req.From = &from
+				req.Adjust = "split"
+				req.Interval = "weekly"
+				req.Currency = "GBP"
+
+				// usecase mechanism
+				mock.On("ValuePortfolio", ctx, &req).Return(nil, constant.ErrNoBars)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrNoBars), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("GET", tt.link, nil)
+			c.Request = r
+			c.Params = gin.Params{{Key: "id", Value: "6851a1f0c2a4b1e6d4f3a2b1"}}
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.ValuePortfolio(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerAddTransaction(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "malformed JSON body",
+			body: `{"type": "buy",`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "date not formatted as a day",
+			body: `{"type": "buy", "symbol": "AAPL", "date": "06/02/2025", ` +
+				`"quantity": "10", "price": "200"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "required field missing",
+			body: `{"symbol": "AAPL", "date": "2025-06-02"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ve validator.ValidationErrors
+				assert.Equal(t, errors.As(ctx.Errors[0], &ve), true)
+				assert.Equal(t, ve[0].Field(), "Type")
+			},
+		},
+		{
+			name: "usecase returns error",
+			body: `{"type": "sell", "symbol": "AAPL", "date": "2025-06-02", ` +
+				`"quantity": "10", "price": "200"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.TransactionReq
+				req.PortfolioId = "6851a1f0c2a4b1e6d4f3a2b1"
+				req.Type = "sell"
+				req.Symbol = "AAPL"
+				req.Date = dto.DateOnly(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC))
+				req.Quantity = decimal.RequireFromString("10")
+				req.Price = decimal.RequireFromString("200")
+
+				// usecase mechanism
+				mock.On("AddTransaction", ctx, &req).Return(nil, constant.ErrOversold)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrOversold), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("POST", "/portfolios/6851a1f0c2a4b1e6d4f3a2b1/transactions",
+				strings.NewReader(tt.body))
+			c.Request = r
+			c.Params = gin.Params{{Key: "id", Value: "6851a1f0c2a4b1e6d4f3a2b1"}}
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.AddTransaction(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerCreateAlertRule(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "malformed JSON body",
+			body: `{"symbol": "AAPL", "type": "price_above"`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "field of the wrong type",
+			body: `{"symbol": "AAPL", "type": "price_above", "level": "200", ` +
+				`"days": "five", "webhook_url": "https://example.com/hook"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "webhook url not a url",
+			body: `{"symbol": "AAPL", "type": "price_above", "level": "200", ` +
+				`"webhook_url": "example"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ve validator.ValidationErrors
+				assert.Equal(t, errors.As(ctx.Errors[0], &ve), true)
+				assert.Equal(t, ve[0].Field(), "WebhookURL")
+			},
+		},
+		{
+			name: "usecase returns error",
+			body: `{"symbol": "AAPL", "type": "price_above", "level": "200", ` +
+				`"webhook_url": "https://example.com/hook"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.AlertRuleReq
+				req.Symbol = "AAPL"
+				req.Type = "price_above"
+				req.Level = decimal.RequireFromString("200")
+				req.WebhookURL = "https://example.com/hook"
+
+				// usecase mechanism
+				mock.On("CreateAlertRule", ctx, &req).Return(nil, constant.ErrNoWebhookSecret)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrNoWebhookSecret), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("POST", "/alerts", strings.NewReader(tt.body))
+			c.Request = r
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.CreateAlertRule(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerRunBacktest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "malformed JSON body",
+			body: `{"symbol": "AAPL" "strategy": "buy_and_hold"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "from not formatted as a day",
+			body: `{"symbol": "AAPL", "strategy": "buy_and_hold", "from": "2025-06"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "required field missing",
+			body: `{"symbol": "AAPL"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ve validator.ValidationErrors
+				assert.Equal(t, errors.As(ctx.Errors[0], &ve), true)
+				assert.Equal(t, ve[0].Field(), "Strategy")
+			},
+		},
+		{
+			name: "usecase returns error",
+			body: `{"symbol": "AAPL", "strategy": "momentum"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.BacktestReq
+				req.Symbol = "AAPL"
+				req.Strategy = "momentum"
+
+				// usecase mechanism
+				mock.On("RunBacktest", ctx, &req).Return(nil, constant.ErrInvalidStrategy)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrInvalidStrategy), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("POST", "/backtests", strings.NewReader(tt.body))
+			c.Request = r
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.RunBacktest(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerScreen(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "malformed JSON body",
+			body: `{"conditions": [`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "conditions not a list",
+			body: `{"conditions": {"field": "close", "op": "gt", "value": "100"}}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.StatusCode, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "no conditions",
+			body: `{"conditions": []}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ve validator.ValidationErrors
+				assert.Equal(t, errors.As(ctx.Errors[0], &ve), true)
+				assert.Equal(t, ve[0].Field(), "Conditions")
+			},
+		},
+		{
+			name: "usecase returns error",
+			body: `{"conditions": [{"field": "close", "op": "gt", "value": "100"}], ` +
+				`"logic": "xor"}`,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.ScreenReq
+				req.Conditions = []dto.ScreenConditionReq{
+					{Field: "close", Op: "gt", Value: decimal.RequireFromString("100")},
+				}
+				req.Logic = "xor"
+
+				// usecase mechanism
+				mock.On("Screen", ctx, &req).Return(nil, constant.ErrInvalidScreenLogic)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrInvalidScreenLogic), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("POST", "/screen", strings.NewReader(tt.body))
+			c.Request = r
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.Screen(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerDateQuery(t *testing.T) {
+	testCases := []struct {
+		name         string
+		query        string
+		expectedDate string
+		expectedErr  error
+	}{
+		{
+			name:  "not given",
+			query: "",
+		},
+		{
+			name:         "ISO date",
+			query:        "from=2025-06-02",
+			expectedDate: "2025-06-02",
+		},
+		{
+			name:        "not a date",
+			query:       "from=yesterday",
+			expectedErr: constant.ErrInvalidDate("from"),
+		},
+		{
+			name:        "date and time",
+			query:       "from=2025-06-02T10:00:00Z",
+			expectedErr: constant.ErrInvalidDate("from"),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/?"+tt.query, nil)
+
+			//when
+			date, err := dateQuery(c, "from")
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if tt.expectedDate == "" {
+				assert.Equal(t, date, (*dto.DateOnly)(nil))
+			} else {
+				assert.Equal(t, date.String(), tt.expectedDate)
+			}
+		})
+	}
+}
+
+func TestUnitHandlerIntQuery(t *testing.T) {
+	testCases := []struct {
+		name        string
+		query       string
+		expected    int
+		expectedErr error
+	}{
+		{
+			name:     "not given",
+			query:    "",
+			expected: 0,
+		},
+		{
+			name:     "positive whole number",
+			query:    "window=20",
+			expected: 20,
+		},
+		{
+			name:        "zero",
+			query:       "window=0",
+			expectedErr: constant.ErrInvalidNumber("window"),
+		},
+		{
+			name:        "negative",
+			query:       "window=-5",
+			expectedErr: constant.ErrInvalidNumber("window"),
+		},
+		{
+			name:        "fraction",
+			query:       "window=2.5",
+			expectedErr: constant.ErrInvalidNumber("window"),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/?"+tt.query, nil)
+
+			//when
+			n, err := intQuery(c, "window")
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			assert.Equal(t, n, tt.expected)
+		})
+	}
+}
+
+func TestUnitHandlerListQuery(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "not given",
+			query:    "",
+			expected: nil,
+		},
+		{
+			name:     "comma list with spaces and empty entries",
+			query:    "symbols=AAPL,%20MSFT,,IBM,",
+			expected: []string{"AAPL", "MSFT", "IBM"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/?"+tt.query, nil)
+
+			//when
+			values := listQuery(c, "symbols")
+
+			//then
+			assert.Equal(t, values, tt.expected)
+		})
+	}
+}
+
+func TestUnitHandlerRiskFreeQuery(t *testing.T) {
+	testCases := []struct {
+		name        string
+		query       string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:  "not given",
+			query: "",
+		},
+		{
+			name:     "annual rate",
+			query:    "risk_free=0.04",
+			expected: "0.04",
+		},
+		{
+			name:        "percent sign",
+			query:       "risk_free=4%25",
+			expectedErr: constant.ErrInvalidRiskFree,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/?"+tt.query, nil)
+
+			//when
+			riskFree, err := riskFreeQuery(c)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if tt.expected == "" {
+				assert.Equal(t, riskFree, (*decimal.Decimal)(nil))
+			} else {
+				assert.Equal(t, riskFree.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnitHandlerSeriesQuery(t *testing.T) {
+	from := dto.DateOnly(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		name        string
+		symbol      string
+		query       string
+		expected    *dto.SeriesReq
+		expectedErr error
+	}{
+		{
+			name:        "no path parameter provided",
+			symbol:      "",
+			query:       "",
+			expectedErr: constant.ErrNoSymbol,
+		},
+		{
+			name:        "to is not a date",
+			symbol:      "AAPL",
+			query:       "to=06/13/2025",
+			expectedErr: constant.ErrInvalidDate("to"),
+		},
+		{
+			name:   "window, adjustment and currency passed on",
+			symbol: "AAPL",
+			query:  "from=2025-06-02&adjust=total&interval=week&currency=GBP",
+			expected: &dto.SeriesReq{
+				Symbol:   "AAPL",
+				From:     &from,
+				Adjust:   "total",
+				Interval: "week",
+				Currency: "GBP",
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/?"+tt.query, nil)
+			c.Params = gin.Params{{Key: "symbol", Value: tt.symbol}}
+
+			//when
+			series, err := seriesQuery(c)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			assert.Equal(t, series, tt.expected)
+		})
+	}
+}
+
+func TestUnitHandlerBeta(t *testing.T) {
+	testCases := []struct {
+		name           string
+		link           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "window not a positive whole number",
+			link: "/data/AAPL/beta?window=zero",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.Message, constant.ErrInvalidNumber("window").Error())
+			},
+		},
+		{
+			name: "risk-free rate not a number",
+			link: "/data/AAPL/beta?risk_free=high",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrInvalidRiskFree), true)
+			},
+		},
+		{
+			name: "usecase returns error",
+			link: "/data/AAPL/beta?window=1",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.BetaReq
+				req.Series = dto.SeriesReq{Symbol: "AAPL"}
+				req.Window = 1
+
+				// usecase mechanism
+				mock.On("Beta", ctx, &req).Return(nil, constant.ErrInvalidBetaWindow)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrInvalidBetaWindow), true)
+			},
+		},
+		{
+			name: "benchmark still being collected",
+			link: "/data/AAPL/beta?benchmark=QQQ",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.BetaReq
+				req.Series = dto.SeriesReq{Symbol: "AAPL"}
+				req.Benchmark = "QQQ"
+
+				// output from usecase
+				beta := dto.BetaRes{
+					Symbol:    "AAPL",
+					Benchmark: "QQQ",
+					Collecting: &dto.JobRes{
+						Id:        "6851a1f0c2a4b1e6d4f3a2b1",
+						Type:      constant.JobTypeCollect,
+						Symbol:    "QQQ",
+						Status:    constant.JobQueued,
+						CreatedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+					},
+				}
+
+				// usecase mechanism
+				mock.On("Beta", ctx, &req).Return(&beta, nil)
+
+				return mock
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedBody: `{"data":{"symbol":"AAPL","benchmark":"QQQ","stats":null,` +
+				`"collecting":{"id":"6851a1f0c2a4b1e6d4f3a2b1","type":"collect",` +
+				`"symbol":"QQQ","status":"queued","attempts":0,"error":null,` +
+				`"result":null,"created_at":"2025-06-01T12:00:00Z","started_at":null,` +
+				`"finished_at":null}},"error":null,"message":null}`,
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 0)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("GET", tt.link, nil)
+			c.Request = r
+			c.Params = gin.Params{{Key: "symbol", Value: "AAPL"}}
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.Beta(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerAnomalies(t *testing.T) {
+	testCases := []struct {
+		name           string
+		link           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "threshold not a number",
+			link: "/data/AAPL/anomalies?threshold=three",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrInvalidAnomaly), true)
+			},
+		},
+		{
+			name: "window not a positive whole number",
+			link: "/data/AAPL/anomalies?window=-20",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.Message, constant.ErrInvalidNumber("window").Error())
+			},
+		},
+		{
+			name: "scoring passed on to usecase",
+			link: "/data/AAPL/anomalies?method=MAD&threshold=3.5&window=2",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				threshold := decimal.RequireFromString("3.5")
+				var req dto.AnomaliesReq
+				req.Series = dto.SeriesReq{Symbol: "AAPL"}
+				req.Params = dto.AnomalyParams{
+					Method:    constant.AnomalyMAD,
+					Threshold: &threshold,
+					Window:    2,
+				}
+
+				// usecase mechanism
+				mock.On("Anomalies", ctx, &req).Return(nil, constant.ErrInvalidAnomaly)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrInvalidAnomaly), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("GET", tt.link, nil)
+			c.Request = r
+			c.Params = gin.Params{{Key: "symbol", Value: "AAPL"}}
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.Anomalies(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerBoxChart(t *testing.T) {
+	testCases := []struct {
+		name           string
+		link           string
+		pointFigure    bool
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "box not a number",
+			link: "/data/AAPL/renko?box=big",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrInvalidBox), true)
+			},
+		},
+		{
+			name:        "reversal not a positive whole number",
+			link:        "/data/AAPL/point-figure?reversal=0",
+			pointFigure: true,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.Message, constant.ErrInvalidNumber("reversal").Error())
+			},
+		},
+		{
+			name: "renko sizing passed on to usecase",
+			link: "/data/AAPL/renko?box=2.5",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				box := decimal.RequireFromString("2.5")
+				var req dto.BoxChartReq
+				req.Series = dto.SeriesReq{Symbol: "AAPL"}
+				req.Params = dto.BoxParams{Box: &box}
+
+				// usecase mechanism
+				mock.On("Renko", ctx, &req).Return(nil, constant.ErrInvalidBox)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrInvalidBox), true)
+			},
+		},
+		{
+			name:        "point-and-figure sizing passed on to usecase",
+			link:        "/data/AAPL/point-figure?atr=14&reversal=3",
+			pointFigure: true,
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.BoxChartReq
+				req.Series = dto.SeriesReq{Symbol: "AAPL"}
+				req.Params = dto.BoxParams{ATR: 14, Reversal: 3}
+
+				// usecase mechanism
+				mock.On("PointFigure", ctx, &req).Return(nil, constant.ErrNoBoxSize)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrNoBoxSize), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("GET", tt.link, nil)
+			c.Request = r
+			c.Params = gin.Params{{Key: "symbol", Value: "AAPL"}}
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			if tt.pointFigure {
+				hd.PointFigure(c)
+			} else {
+				hd.Renko(c)
+			}
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
+
+func TestUnitHandlerCorrelation(t *testing.T) {
+	testCases := []struct {
+		name           string
+		link           string
+		ucSetup        func(*gin.Context) usecase.UsecaseItf
+		expectedStatus int
+		expectedBody   string
+		expectedError  func(*gin.Context)
+	}{
+		{
+			name: "from is not a date",
+			link: "/correlation?symbols=AAPL,MSFT&from=2025-02-30",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.Message, constant.ErrInvalidDate("from").Error())
+			},
+		},
+		{
+			name: "window not a positive whole number",
+			link: "/correlation?symbols=AAPL,MSFT&pair=AAPL,MSFT&window=x",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				return new(mocks.UsecaseItf)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, ce.Message, constant.ErrInvalidNumber("window").Error())
+			},
+		},
+		{
+			name: "symbols and pair passed on to usecase",
+			link: "/correlation?symbols=AAPL,%20MSFT,&pair=AAPL,MSFT&window=10",
+			ucSetup: func(ctx *gin.Context) usecase.UsecaseItf {
+				mock := new(mocks.UsecaseItf)
+
+				// input to usecase
+				var req dto.CorrelationReq
+				req.Symbols = []string{"AAPL", "MSFT"}
+				req.Pair = []string{"AAPL", "MSFT"}
+				req.Window = 10
+
+				// usecase mechanism
+				mock.On("Correlation", ctx, &req).Return(nil, constant.ErrNotEnoughCommonDates)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "",
+			expectedError: func(ctx *gin.Context) {
+				assert.Equal(t, len(ctx.Errors), 1)
+
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(ctx.Errors[0], &ce), true)
+				assert.Equal(t, errors.Is(ce, constant.ErrNotEnoughCommonDates), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			r := httptest.NewRequest("GET", tt.link, nil)
+			c.Request = r
+
+			hd := NewHandler(tt.ucSetup(c))
+
+			//when
+			hd.Correlation(c)
+
+			//then
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			tt.expectedError(c)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) AddTransaction(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoPortfolioId)
+		return
+	}
+	var req dto.TransactionReq
+	err := bindJSON(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.PortfolioId = id
+
+	// usecase
+	transaction, err := hd.uc.AddTransaction(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    transaction,
+		})
+}
+
+func (hd *Handler) Transactions(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoPortfolioId)
+		return
+	}
+	var req dto.GetPortfolioReq
+	req.Id = id
+
+	// usecase
+	transactions, err := hd.uc.Transactions(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    transactions,
+		})
+}
+
+func (hd *Handler) DeleteTransaction(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoPortfolioId)
+		return
+	}
+	txId := ctx.Param("txid")
+	if txId == "" {
+		ctx.Error(constant.ErrNoTransactionId)
+		return
+	}
+	var req dto.GetTransactionReq
+	req.PortfolioId = id
+	req.Id = txId
+
+	// usecase
+	err := hd.uc.DeleteTransaction(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    nil,
+		})
+}
+
+func (hd *Handler) Lots(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoPortfolioId)
+		return
+	}
+	var req dto.LotsReq
+	req.PortfolioId = id
+	req.Method = ctx.Query("method")
+
+	// usecase
+	lots, err := hd.uc.Lots(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    lots,
+		})
+}
+
+func (hd *Handler) Realized(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoPortfolioId)
+		return
+	}
+	var req dto.LotsReq
+	req.PortfolioId = id
+	req.Method = ctx.Query("method")
+
+	// usecase
+	realized, err := hd.uc.Realized(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    realized,
+		})
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) CreatePortfolio(ctx *gin.Context) {
+	// request validation
+	var req dto.PortfolioReq
+	err := bindJSON(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	// usecase
+	portfolio, err := hd.uc.CreatePortfolio(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    portfolio,
+		})
+}
+
+func (hd *Handler) Portfolios(ctx *gin.Context) {
+	// usecase
+	portfolios, err := hd.uc.Portfolios(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    portfolios,
+		})
+}
+
+func (hd *Handler) GetPortfolio(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoPortfolioId)
+		return
+	}
+	var req dto.GetPortfolioReq
+	req.Id = id
+
+	// usecase
+	portfolio, err := hd.uc.GetPortfolio(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    portfolio,
+		})
+}
+
+func (hd *Handler) UpdatePortfolio(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoPortfolioId)
+		return
+	}
+	var req dto.PortfolioReq
+	err := bindJSON(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.Id = id
+
+	// usecase
+	portfolio, err := hd.uc.UpdatePortfolio(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    portfolio,
+		})
+}
+
+func (hd *Handler) DeletePortfolio(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoPortfolioId)
+		return
+	}
+	var req dto.GetPortfolioReq
+	req.Id = id
+
+	// usecase
+	err := hd.uc.DeletePortfolio(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    nil,
+		})
+}
+
+func (hd *Handler) ValuePortfolio(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoPortfolioId)
+		return
+	}
+	from, err := dateQuery(ctx, "from")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	to, err := dateQuery(ctx, "to")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.ValuationReq
+	req.Id = id
+	req.From = from
+	req.To = to
+	req.Adjust = ctx.Query("adjust")
+	req.Interval = ctx.Query("interval")
+	req.Currency = ctx.Query("currency")
+
+	// usecase
+	valuation, err := hd.uc.ValuePortfolio(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    valuation,
+		})
+}
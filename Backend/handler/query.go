@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// Optional date from url query; nil when not given
+func dateQuery(ctx *gin.Context, key string) (*dto.DateOnly, error) {
+	text := ctx.Query(key)
+	if text == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(constant.LayoutISO, text)
+	if err != nil {
+		return nil, constant.ErrInvalidDate(key)
+	}
+	date := dto.DateOnly(t)
+	return &date, nil
+}
+
+// Optional positive whole number from url query; 0 when not given
+func intQuery(ctx *gin.Context, key string) (int, error) {
+	text := ctx.Query(key)
+	if text == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(text)
+	if err != nil || n < 1 {
+		return 0, constant.ErrInvalidNumber(key)
+	}
+	return n, nil
+}
+
+// Comma-separated url query values; nil when not given
+func listQuery(ctx *gin.Context, key string) []string {
+	var values []string
+	for _, value := range strings.Split(ctx.Query(key), ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// Optional annual risk-free rate from url query; nil when not given
+func riskFreeQuery(ctx *gin.Context) (*decimal.Decimal, error) {
+	text := ctx.Query("risk_free")
+	if text == "" {
+		return nil, nil
+	}
+	riskFree, err := decimal.NewFromString(text)
+	if err != nil {
+		return nil, constant.ErrInvalidRiskFree
+	}
+	return &riskFree, nil
+}
+
+// Anomaly scoring from url query; zero values where not given
+func anomalyQuery(ctx *gin.Context) (*dto.AnomalyParams, error) {
+	window, err := intQuery(ctx, "window")
+	if err != nil {
+		return nil, err
+	}
+	params := &dto.AnomalyParams{
+		Method: strings.ToLower(ctx.Query("method")),
+		Window: window,
+	}
+	if text := ctx.Query("threshold"); text != "" {
+		threshold, err := decimal.NewFromString(text)
+		if err != nil {
+			return nil, constant.ErrInvalidAnomaly
+		}
+		params.Threshold = &threshold
+	}
+	return params, nil
+}
+
+// Renko and point-and-figure box sizing from url query; zero values
+// where not given
+func boxQuery(ctx *gin.Context) (*dto.BoxParams, error) {
+	atr, err := intQuery(ctx, "atr")
+	if err != nil {
+		return nil, err
+	}
+	reversal, err := intQuery(ctx, "reversal")
+	if err != nil {
+		return nil, err
+	}
+	params := &dto.BoxParams{ATR: atr, Reversal: reversal}
+	if text := ctx.Query("box"); text != "" {
+		box, err := decimal.NewFromString(text)
+		if err != nil {
+			return nil, constant.ErrInvalidBox
+		}
+		params.Box = &box
+	}
+	return params, nil
+}
+
+// Symbol from the path, with the window, adjustment and currency from
+// url query
+func seriesQuery(ctx *gin.Context) (*dto.SeriesReq, error) {
+	symbol := ctx.Param("symbol")
+	if symbol == "" {
+		return nil, constant.ErrNoSymbol
+	}
+	from, err := dateQuery(ctx, "from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := dateQuery(ctx, "to")
+	if err != nil {
+		return nil, err
+	}
+	return &dto.SeriesReq{
+		Symbol:   symbol,
+		From:     from,
+		To:       to,
+		Adjust:   ctx.Query("adjust"),
+		Interval: ctx.Query("interval"),
+		Currency: ctx.Query("currency"),
+	}, nil
+}
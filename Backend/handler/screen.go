@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"Backend/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (hd *Handler) Screen(ctx *gin.Context) {
+	// request validation
+	var req dto.ScreenReq
+	err := bindJSON(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	// usecase
+	screen, err := hd.uc.Screen(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    screen,
+		})
+}
@@ -5,6 +5,7 @@ import (
 	"Backend/dto"
 	"Backend/usecase"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,6 +15,50 @@ type HandlerItf interface {
 	CollectSymbol(*gin.Context)
 	DeleteSymbol(*gin.Context)
 	StoredData(*gin.Context)
+	CorporateActions(*gin.Context)
+	SyncCorporateActions(*gin.Context)
+	Indicators(*gin.Context)
+	Stats(*gin.Context)
+	Correlation(*gin.Context)
+	Compare(*gin.Context)
+	Beta(*gin.Context)
+	Betas(*gin.Context)
+	Patterns(*gin.Context)
+	Anomalies(*gin.Context)
+	Seasonality(*gin.Context)
+	Heatmap(*gin.Context)
+	HeikinAshi(*gin.Context)
+	Renko(*gin.Context)
+	PointFigure(*gin.Context)
+	CreatePortfolio(*gin.Context)
+	Portfolios(*gin.Context)
+	GetPortfolio(*gin.Context)
+	UpdatePortfolio(*gin.Context)
+	DeletePortfolio(*gin.Context)
+	ValuePortfolio(*gin.Context)
+	AddTransaction(*gin.Context)
+	Transactions(*gin.Context)
+	DeleteTransaction(*gin.Context)
+	Lots(*gin.Context)
+	Realized(*gin.Context)
+	CreateAlertRule(*gin.Context)
+	AlertRules(*gin.Context)
+	GetAlertRule(*gin.Context)
+	DeleteAlertRule(*gin.Context)
+	AlertTriggers(*gin.Context)
+	RunBacktest(*gin.Context)
+	GetBacktest(*gin.Context)
+	Fundamentals(*gin.Context)
+	SyncFundamentals(*gin.Context)
+	Screen(*gin.Context)
+	FXRates(*gin.Context)
+	SyncFXRates(*gin.Context)
+	GetJob(*gin.Context)
+	BackfillSymbol(*gin.Context)
+	SymbolGaps(*gin.Context)
+	RefetchSymbolGaps(*gin.Context)
+	GapsSummary(*gin.Context)
+	RefetchGaps(*gin.Context)
 }
 
 type Handler struct {
@@ -77,17 +122,17 @@ func (hd *Handler) CollectSymbol(ctx *gin.Context) {
 	req.Symbol = symbol
 
 	// usecase
-	stockData, err := hd.uc.CollectSymbol(ctx, &req)
+	job, err := hd.uc.EnqueueCollect(ctx, &req)
 	if err != nil {
 		ctx.Error(err)
 		return
 	}
 
-	ctx.JSON(http.StatusCreated,
+	ctx.JSON(http.StatusAccepted,
 		gin.H{
 			"message": nil,
 			"error":   nil,
-			"data":    stockData,
+			"data":    job,
 		})
 }
 
@@ -117,8 +162,24 @@ func (hd *Handler) DeleteSymbol(ctx *gin.Context) {
 }
 
 func (hd *Handler) StoredData(ctx *gin.Context) {
+	// request validation
+	var req dto.StoredDataReq
+	req.Adjust = ctx.Query("adjust")
+	req.Interval = ctx.Query("interval")
+	req.Currency = ctx.Query("currency")
+	req.Annotate = listQuery(ctx, "annotate")
+	for i, name := range req.Annotate {
+		req.Annotate[i] = strings.ToLower(name)
+	}
+	params, err := anomalyQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.Anomaly = *params
+
 	// usecase
-	data, err := hd.uc.StoredData(ctx)
+	data, err := hd.uc.StoredData(ctx, &req)
 	if err != nil {
 		ctx.Error(err)
 		return
@@ -131,3 +192,70 @@ func (hd *Handler) StoredData(ctx *gin.Context) {
 			"data":    data,
 		})
 }
+
+func (hd *Handler) BackfillSymbol(ctx *gin.Context) {
+	// request validation
+	symbol := ctx.Param("symbol")
+	if symbol == "" {
+		ctx.Error(constant.ErrNoSymbol)
+		return
+	}
+	from, err := dateQuery(ctx, "from")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	if from == nil {
+		ctx.Error(constant.ErrNoBackfillFrom)
+		return
+	}
+	to, err := dateQuery(ctx, "to")
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	var req dto.BackfillReq
+	req.Symbol = symbol
+	req.From = from
+	req.To = to
+	req.Provider = ctx.Query("provider")
+
+	// usecase
+	job, err := hd.uc.EnqueueBackfill(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    job,
+		})
+}
+
+func (hd *Handler) GetJob(ctx *gin.Context) {
+	// request validation
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.Error(constant.ErrNoJobId)
+		return
+	}
+	var req dto.GetJobReq
+	req.Id = id
+
+	// usecase
+	job, err := hd.uc.GetJob(ctx, &req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK,
+		gin.H{
+			"message": nil,
+			"error":   nil,
+			"data":    job,
+		})
+}
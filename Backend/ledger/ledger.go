@@ -0,0 +1,143 @@
+package ledger
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+func ValidMethod(method string) bool {
+	switch method {
+	case constant.LotFIFO, constant.LotLIFO, constant.LotAverage:
+		return true
+	}
+	return false
+}
+
+// Lots left open and closed by replaying a portfolio's transactions
+type Book struct {
+	Open      []dto.LotRes
+	Closed    []dto.ClosedLotRes
+	Dividends map[string]decimal.Decimal
+	// By symbol; fees recorded without one under ""
+	Fees map[string]decimal.Decimal
+}
+
+// Replay transactions in date order, same-day ones in the order given.
+// Sales close the oldest lots first with fifo, the newest with lifo,
+// and a symbol's single pooled lot with average. Splits scale open
+// quantities up by the ratio, keeping their cost.
+func Replay(transactions []dto.TransactionRes, method string) (*Book, error) {
+	sorted := make([]dto.TransactionRes, len(transactions))
+	copy(sorted, transactions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	book := &Book{
+		Open:      make([]dto.LotRes, 0),
+		Closed:    make([]dto.ClosedLotRes, 0),
+		Dividends: make(map[string]decimal.Decimal),
+		Fees:      make(map[string]decimal.Decimal),
+	}
+	lots := make(map[string][]dto.LotRes)
+	var symbols []string
+	for _, tx := range sorted {
+		if _, ok := lots[tx.Symbol]; !ok && tx.Symbol != "" {
+			symbols = append(symbols, tx.Symbol)
+		}
+		held := lots[tx.Symbol]
+
+		switch tx.Type {
+		case constant.TransactionBuy:
+			if method == constant.LotAverage && len(held) > 0 {
+				pooled := &held[0]
+				pooled.Quantity = pooled.Quantity.Add(tx.Quantity)
+				pooled.Cost = pooled.Cost.Add(tx.Quantity.Mul(tx.Price))
+				pooled.UnitCost = pooled.Cost.Div(pooled.Quantity)
+				break
+			}
+			held = append(held, dto.LotRes{
+				Symbol:   tx.Symbol,
+				Opened:   tx.Date,
+				Quantity: tx.Quantity,
+				UnitCost: tx.Price,
+				Cost:     tx.Quantity.Mul(tx.Price),
+			})
+
+		case constant.TransactionSell:
+			var err error
+			held, err = sell(book, held, tx, method)
+			if err != nil {
+				return nil, err
+			}
+
+		case constant.TransactionSplit:
+			for i := range held {
+				held[i].Quantity = held[i].Quantity.Mul(tx.Ratio)
+				held[i].UnitCost = held[i].Cost.Div(held[i].Quantity)
+			}
+
+		case constant.TransactionDividend:
+			book.Dividends[tx.Symbol] = book.Dividends[tx.Symbol].Add(tx.Amount)
+
+		case constant.TransactionFee:
+			book.Fees[tx.Symbol] = book.Fees[tx.Symbol].Add(tx.Amount)
+		}
+		if tx.Symbol != "" {
+			lots[tx.Symbol] = held
+		}
+	}
+
+	for _, symbol := range symbols {
+		book.Open = append(book.Open, lots[symbol]...)
+	}
+	return book, nil
+}
+
+// Close lots of one symbol for a sale, returning those still open
+func sell(book *Book, held []dto.LotRes, tx dto.TransactionRes, method string) ([]dto.LotRes, error) {
+	total := decimal.Zero
+	for _, lot := range held {
+		total = total.Add(lot.Quantity)
+	}
+	if tx.Quantity.GreaterThan(total) {
+		return nil, constant.ErrOversold
+	}
+
+	left := tx.Quantity
+	for left.IsPositive() {
+		i := 0
+		if method == constant.LotLIFO {
+			i = len(held) - 1
+		}
+		lot := &held[i]
+		quantity := decimal.Min(left, lot.Quantity)
+		cost := lot.Cost
+		if quantity.LessThan(lot.Quantity) {
+			cost = quantity.Mul(lot.UnitCost)
+		}
+		proceeds := quantity.Mul(tx.Price)
+		book.Closed = append(book.Closed, dto.ClosedLotRes{
+			Symbol:       tx.Symbol,
+			Opened:       lot.Opened,
+			Closed:       tx.Date,
+			Quantity:     quantity,
+			UnitCost:     lot.UnitCost,
+			UnitProceeds: tx.Price,
+			Cost:         cost,
+			Proceeds:     proceeds,
+			Gain:         proceeds.Sub(cost),
+		})
+
+		left = left.Sub(quantity)
+		lot.Quantity = lot.Quantity.Sub(quantity)
+		lot.Cost = lot.Cost.Sub(cost)
+		if lot.Quantity.IsZero() {
+			held = append(held[:i], held[i+1:]...)
+		}
+	}
+	return held, nil
+}
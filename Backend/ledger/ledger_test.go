@@ -0,0 +1,103 @@
+package ledger
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+func trade(day, kind string, quantity, price int64) dto.TransactionRes {
+	return dto.TransactionRes{
+		Symbol:   "AAPL",
+		Type:     kind,
+		Date:     util.Date(day),
+		Quantity: decimal.NewFromInt(quantity),
+		Price:    decimal.NewFromInt(price),
+	}
+}
+
+func TestUnitLedgerReplay(t *testing.T) {
+	transactions := []dto.TransactionRes{
+		trade("2025-01-02", constant.TransactionBuy, 10, 100),
+		trade("2025-02-03", constant.TransactionBuy, 10, 130),
+		{Symbol: "AAPL", Type: constant.TransactionDividend, Date: util.Date("2025-02-10"), Amount: decimal.NewFromInt(5)},
+		{Type: constant.TransactionFee, Date: util.Date("2025-02-11"), Amount: decimal.NewFromInt(2)},
+		trade("2025-03-03", constant.TransactionSell, 15, 150),
+		{Symbol: "AAPL", Type: constant.TransactionSplit, Date: util.Date("2025-04-01"), Ratio: decimal.NewFromInt(2)},
+	}
+
+	testCases := []struct {
+		name           string
+		method         string
+		expectedClosed []string
+		expectedOpen   []string
+	}{
+		{
+			name:   "fifo",
+			method: constant.LotFIFO,
+			expectedClosed: []string{
+				"2025-01-02 10 @100 gain 500",
+				"2025-02-03 5 @130 gain 100",
+			},
+			expectedOpen: []string{"2025-02-03 10 @65 cost 650"},
+		},
+		{
+			name:   "lifo",
+			method: constant.LotLIFO,
+			expectedClosed: []string{
+				"2025-02-03 10 @130 gain 200",
+				"2025-01-02 5 @100 gain 250",
+			},
+			expectedOpen: []string{"2025-01-02 10 @50 cost 500"},
+		},
+		{
+			name:   "average cost",
+			method: constant.LotAverage,
+			expectedClosed: []string{
+				"2025-01-02 15 @115 gain 525",
+			},
+			expectedOpen: []string{"2025-01-02 10 @57.5 cost 575"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			book, err := Replay(transactions, tt.method)
+
+			//then
+			assert.Equal(t, err, nil)
+			closed := make([]string, len(book.Closed))
+			for i, lot := range book.Closed {
+				closed[i] = lot.Opened.String() + " " + lot.Quantity.String() + " @" +
+					lot.UnitCost.String() + " gain " + lot.Gain.String()
+			}
+			assert.Equal(t, closed, tt.expectedClosed)
+			open := make([]string, len(book.Open))
+			for i, lot := range book.Open {
+				open[i] = lot.Opened.String() + " " + lot.Quantity.String() + " @" +
+					lot.UnitCost.String() + " cost " + lot.Cost.String()
+			}
+			assert.Equal(t, open, tt.expectedOpen)
+			assert.Equal(t, book.Dividends["AAPL"].String(), "5")
+			assert.Equal(t, book.Fees[""].String(), "2")
+		})
+	}
+}
+
+func TestUnitLedgerReplayOversold(t *testing.T) {
+	//when
+	_, err := Replay([]dto.TransactionRes{
+		trade("2025-01-02", constant.TransactionBuy, 10, 100),
+		trade("2025-03-03", constant.TransactionSell, 4, 150),
+		// Only counts once it's sorted before the sale
+		trade("2025-02-03", constant.TransactionSell, 7, 150),
+	}, constant.LotFIFO)
+
+	//then
+	assert.Equal(t, err, constant.ErrOversold)
+}
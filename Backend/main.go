@@ -5,11 +5,16 @@ import (
 	"Backend/handler"
 	"Backend/middleware"
 	"Backend/repo"
+	"Backend/scheduler"
 	"Backend/usecase"
 	"Backend/util"
+	"Backend/worker"
+	"context"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -28,28 +33,131 @@ func main() {
 
 	// Setup app (in layers)
 	rp := repo.NewRepo()
+	if err := rp.EnsureIndexes(util.NewBackgroundContext(context.Background())); err != nil {
+		log.Fatalf("unique indexes not in place: %v", err)
+	}
 	uc := usecase.NewUsecase(rp, util.NewHttpClient())
 	hd := handler.NewHandler(uc)
 
+	// Setup background refresh of tracked symbols
+	schedule, timezone, disabled := configs.EnvScheduler()
+	sc, err := scheduler.NewScheduler(uc, schedule, timezone, disabled)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sc.Start()
+	defer sc.Stop()
+	ad := handler.NewAdminHandler(uc, sc)
+
+	// Setup workers for queued collection jobs
+	pool := worker.NewPool(uc, configs.EnvJobWorkers())
+	pool.Start()
+	defer pool.Stop()
+
 	// Get symbols
 	r.GET("/symbols", hd.GetSymbols)
 
-	// Collect and return stock data
+	// Queue collection of stock data
 	r.POST("/data/:symbol", hd.CollectSymbol)
 
+	// Queue filling of older data over a date range
+	r.POST("/data/:symbol/backfill", hd.BackfillSymbol)
+
+	// Sessions missing from stored data, optionally queueing backfills
+	r.GET("/data/:symbol/gaps", hd.SymbolGaps)
+	r.POST("/data/:symbol/gaps/refetch", hd.RefetchSymbolGaps)
+	r.GET("/gaps", hd.GapsSummary)
+	r.POST("/gaps/refetch", hd.RefetchGaps)
+
+	// Check on a queued collection or backfill
+	r.GET("/jobs/:id", hd.GetJob)
+
 	// Delete a recorded symbol and its data
 	r.DELETE("/data/:symbol", hd.DeleteSymbol)
 
-	// Get stored data
+	// Get stored data, optionally adjusted for splits and dividends
 	// Used when opening frontend
 	r.GET("/data", hd.StoredData)
 
+	// Splits and dividends used for adjustment
+	r.GET("/data/:symbol/actions", hd.CorporateActions)
+	r.POST("/data/:symbol/actions/sync", hd.SyncCorporateActions)
+	r.GET("/data/:symbol/fundamentals", hd.Fundamentals)
+	r.POST("/data/:symbol/fundamentals/sync", hd.SyncFundamentals)
+
+	// FX daily rates for ?currency= conversion
+	r.GET("/fx/:base/:quote", hd.FXRates)
+	r.POST("/fx/:base/:quote/sync", hd.SyncFXRates)
+
+	// Analytics computed from stored bars
+	r.GET("/data/:symbol/indicators", hd.Indicators)
+	r.GET("/data/:symbol/stats", hd.Stats)
+	r.GET("/correlation", hd.Correlation)
+	r.GET("/compare", hd.Compare)
+	r.GET("/data/:symbol/beta", hd.Beta)
+	r.GET("/beta", hd.Betas)
+	r.GET("/data/:symbol/patterns", hd.Patterns)
+	r.GET("/data/:symbol/anomalies", hd.Anomalies)
+	r.GET("/data/:symbol/seasonality", hd.Seasonality)
+	r.GET("/data/:symbol/heatmap", hd.Heatmap)
+
+	// Alternative chart transforms of stored bars
+	r.GET("/data/:symbol/heikin-ashi", hd.HeikinAshi)
+	r.GET("/data/:symbol/renko", hd.Renko)
+	r.GET("/data/:symbol/point-figure", hd.PointFigure)
+
+	// Portfolios of tracked symbols
+	r.POST("/portfolios", hd.CreatePortfolio)
+	r.GET("/portfolios", hd.Portfolios)
+	r.GET("/portfolios/:id", hd.GetPortfolio)
+	r.PUT("/portfolios/:id", hd.UpdatePortfolio)
+	r.DELETE("/portfolios/:id", hd.DeletePortfolio)
+	r.GET("/portfolios/:id/valuation", hd.ValuePortfolio)
+	r.POST("/portfolios/:id/transactions", hd.AddTransaction)
+	r.GET("/portfolios/:id/transactions", hd.Transactions)
+	r.DELETE("/portfolios/:id/transactions/:txid", hd.DeleteTransaction)
+	r.GET("/portfolios/:id/lots", hd.Lots)
+	r.GET("/portfolios/:id/realized", hd.Realized)
+	r.POST("/alerts", hd.CreateAlertRule)
+	r.GET("/alerts", hd.AlertRules)
+	r.GET("/alerts/:id", hd.GetAlertRule)
+	r.DELETE("/alerts/:id", hd.DeleteAlertRule)
+	r.GET("/alerts/:id/triggers", hd.AlertTriggers)
+	r.POST("/backtests", hd.RunBacktest)
+	r.GET("/backtests/:id", hd.GetBacktest)
+	r.POST("/screen", hd.Screen)
+
+	// Refresh scheduler administration
+	r.GET("/admin/scheduler", ad.SchedulerStatus)
+	r.POST("/admin/scheduler/pause", ad.PauseScheduler)
+	r.POST("/admin/scheduler/resume", ad.ResumeScheduler)
+	r.POST("/admin/scheduler/trigger", ad.TriggerScheduler)
+
+	// Data-quality quarantine review
+	r.GET("/admin/quarantine", ad.QuarantinedBars)
+	r.POST("/admin/quarantine/:id/accept", ad.AcceptQuarantined)
+	r.POST("/admin/quarantine/:id/reject", ad.RejectQuarantined)
+
 	// Run server
 	srv := &http.Server{
 		Addr:    os.Getenv("SERVER_PORT"),
 		Handler: r.Handler(),
 	}
-	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		log.Fatalf("listen: %s\n", err)
+	quit, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Printf("listen: %s\n", err)
+			stop()
+		}
+	}()
+
+	// On SIGINT/SIGTERM let requests in flight finish; the deferred stops
+	// then drain running jobs and the scheduler
+	<-quit.Done()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("shutdown: %s\n", err)
 	}
 }
@@ -0,0 +1,63 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AdminHandlerItf is an autogenerated mock type for the AdminHandlerItf type
+type AdminHandlerItf struct {
+	mock.Mock
+}
+
+// AcceptQuarantined provides a mock function with given fields: _a0
+func (_m *AdminHandlerItf) AcceptQuarantined(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// PauseScheduler provides a mock function with given fields: _a0
+func (_m *AdminHandlerItf) PauseScheduler(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// QuarantinedBars provides a mock function with given fields: _a0
+func (_m *AdminHandlerItf) QuarantinedBars(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// RejectQuarantined provides a mock function with given fields: _a0
+func (_m *AdminHandlerItf) RejectQuarantined(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// ResumeScheduler provides a mock function with given fields: _a0
+func (_m *AdminHandlerItf) ResumeScheduler(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// SchedulerStatus provides a mock function with given fields: _a0
+func (_m *AdminHandlerItf) SchedulerStatus(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// TriggerScheduler provides a mock function with given fields: _a0
+func (_m *AdminHandlerItf) TriggerScheduler(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// NewAdminHandlerItf creates a new instance of AdminHandlerItf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAdminHandlerItf(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AdminHandlerItf {
+	mock := &AdminHandlerItf{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -13,26 +13,246 @@ type HandlerItf struct {
 	mock.Mock
 }
 
+// AddTransaction provides a mock function with given fields: _a0
+func (_m *HandlerItf) AddTransaction(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// AlertRules provides a mock function with given fields: _a0
+func (_m *HandlerItf) AlertRules(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// AlertTriggers provides a mock function with given fields: _a0
+func (_m *HandlerItf) AlertTriggers(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Anomalies provides a mock function with given fields: _a0
+func (_m *HandlerItf) Anomalies(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// BackfillSymbol provides a mock function with given fields: _a0
+func (_m *HandlerItf) BackfillSymbol(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Beta provides a mock function with given fields: _a0
+func (_m *HandlerItf) Beta(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Betas provides a mock function with given fields: _a0
+func (_m *HandlerItf) Betas(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
 // CollectSymbol provides a mock function with given fields: _a0
 func (_m *HandlerItf) CollectSymbol(_a0 *gin.Context) {
 	_m.Called(_a0)
 }
 
+// Compare provides a mock function with given fields: _a0
+func (_m *HandlerItf) Compare(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// CorporateActions provides a mock function with given fields: _a0
+func (_m *HandlerItf) CorporateActions(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Correlation provides a mock function with given fields: _a0
+func (_m *HandlerItf) Correlation(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// CreateAlertRule provides a mock function with given fields: _a0
+func (_m *HandlerItf) CreateAlertRule(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// CreatePortfolio provides a mock function with given fields: _a0
+func (_m *HandlerItf) CreatePortfolio(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// DeleteAlertRule provides a mock function with given fields: _a0
+func (_m *HandlerItf) DeleteAlertRule(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// DeletePortfolio provides a mock function with given fields: _a0
+func (_m *HandlerItf) DeletePortfolio(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
 // DeleteSymbol provides a mock function with given fields: _a0
 func (_m *HandlerItf) DeleteSymbol(_a0 *gin.Context) {
 	_m.Called(_a0)
 }
 
+// DeleteTransaction provides a mock function with given fields: _a0
+func (_m *HandlerItf) DeleteTransaction(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// FXRates provides a mock function with given fields: _a0
+func (_m *HandlerItf) FXRates(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Fundamentals provides a mock function with given fields: _a0
+func (_m *HandlerItf) Fundamentals(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// GapsSummary provides a mock function with given fields: _a0
+func (_m *HandlerItf) GapsSummary(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// GetAlertRule provides a mock function with given fields: _a0
+func (_m *HandlerItf) GetAlertRule(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// GetBacktest provides a mock function with given fields: _a0
+func (_m *HandlerItf) GetBacktest(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// GetJob provides a mock function with given fields: _a0
+func (_m *HandlerItf) GetJob(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// GetPortfolio provides a mock function with given fields: _a0
+func (_m *HandlerItf) GetPortfolio(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
 // GetSymbols provides a mock function with given fields: _a0
 func (_m *HandlerItf) GetSymbols(_a0 *gin.Context) {
 	_m.Called(_a0)
 }
 
+// Heatmap provides a mock function with given fields: _a0
+func (_m *HandlerItf) Heatmap(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// HeikinAshi provides a mock function with given fields: _a0
+func (_m *HandlerItf) HeikinAshi(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Indicators provides a mock function with given fields: _a0
+func (_m *HandlerItf) Indicators(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Lots provides a mock function with given fields: _a0
+func (_m *HandlerItf) Lots(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Patterns provides a mock function with given fields: _a0
+func (_m *HandlerItf) Patterns(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// PointFigure provides a mock function with given fields: _a0
+func (_m *HandlerItf) PointFigure(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Portfolios provides a mock function with given fields: _a0
+func (_m *HandlerItf) Portfolios(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Realized provides a mock function with given fields: _a0
+func (_m *HandlerItf) Realized(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// RefetchGaps provides a mock function with given fields: _a0
+func (_m *HandlerItf) RefetchGaps(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// RefetchSymbolGaps provides a mock function with given fields: _a0
+func (_m *HandlerItf) RefetchSymbolGaps(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Renko provides a mock function with given fields: _a0
+func (_m *HandlerItf) Renko(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// RunBacktest provides a mock function with given fields: _a0
+func (_m *HandlerItf) RunBacktest(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Screen provides a mock function with given fields: _a0
+func (_m *HandlerItf) Screen(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Seasonality provides a mock function with given fields: _a0
+func (_m *HandlerItf) Seasonality(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Stats provides a mock function with given fields: _a0
+func (_m *HandlerItf) Stats(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
 // StoredData provides a mock function with given fields: _a0
 func (_m *HandlerItf) StoredData(_a0 *gin.Context) {
 	_m.Called(_a0)
 }
 
+// SymbolGaps provides a mock function with given fields: _a0
+func (_m *HandlerItf) SymbolGaps(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// SyncCorporateActions provides a mock function with given fields: _a0
+func (_m *HandlerItf) SyncCorporateActions(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// SyncFXRates provides a mock function with given fields: _a0
+func (_m *HandlerItf) SyncFXRates(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// SyncFundamentals provides a mock function with given fields: _a0
+func (_m *HandlerItf) SyncFundamentals(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Transactions provides a mock function with given fields: _a0
+func (_m *HandlerItf) Transactions(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// UpdatePortfolio provides a mock function with given fields: _a0
+func (_m *HandlerItf) UpdatePortfolio(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// ValuePortfolio provides a mock function with given fields: _a0
+func (_m *HandlerItf) ValuePortfolio(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
 // NewHandlerItf creates a new instance of HandlerItf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewHandlerItf(t interface {
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "Backend/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProviderItf is an autogenerated mock type for the ProviderItf type
+type ProviderItf struct {
+	mock.Mock
+}
+
+// DailyBars provides a mock function with given fields: symbol, from, to
+func (_m *ProviderItf) DailyBars(symbol string, from dto.DateOnly, to dto.DateOnly) ([]dto.DailyOHLCVRes, error) {
+	ret := _m.Called(symbol, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DailyBars")
+	}
+
+	var r0 []dto.DailyOHLCVRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.DateOnly, dto.DateOnly) ([]dto.DailyOHLCVRes, error)); ok {
+		return rf(symbol, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.DateOnly, dto.DateOnly) []dto.DailyOHLCVRes); ok {
+		r0 = rf(symbol, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DailyOHLCVRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.DateOnly, dto.DateOnly) error); ok {
+		r1 = rf(symbol, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaxRangeDays provides a mock function with no fields
+func (_m *ProviderItf) MaxRangeDays() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxRangeDays")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Name provides a mock function with no fields
+func (_m *ProviderItf) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// NewProviderItf creates a new instance of ProviderItf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProviderItf(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProviderItf {
+	mock := &ProviderItf{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
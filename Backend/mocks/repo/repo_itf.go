@@ -7,6 +7,8 @@ import (
 
 	gin "github.com/gin-gonic/gin"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // RepoItf is an autogenerated mock type for the RepoItf type
@@ -14,6 +16,232 @@ type RepoItf struct {
 	mock.Mock
 }
 
+// APICallsSince provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RepoItf) APICallsSince(_a0 *gin.Context, _a1 string, _a2 time.Time) (int, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for APICallsSince")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, time.Time) (int, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, time.Time) int); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActiveJob provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RepoItf) ActiveJob(_a0 *gin.Context, _a1 string, _a2 string) (*dto.JobRes, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActiveJob")
+	}
+
+	var r0 *dto.JobRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, string) (*dto.JobRes, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, string) *dto.JobRes); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.JobRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActiveJobs provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RepoItf) ActiveJobs(_a0 *gin.Context, _a1 string, _a2 string) ([]*dto.JobRes, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActiveJobs")
+	}
+
+	var r0 []*dto.JobRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, string) ([]*dto.JobRes, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, string) []*dto.JobRes); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.JobRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddAPICalls provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RepoItf) AddAPICalls(_a0 *gin.Context, _a1 string, _a2 int) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAPICalls")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, int) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlertRules provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) AlertRules(_a0 *gin.Context, _a1 *dto.AlertRulesReq) ([]*dto.AlertRuleRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertRules")
+	}
+
+	var r0 []*dto.AlertRuleRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertRulesReq) ([]*dto.AlertRuleRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertRulesReq) []*dto.AlertRuleRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.AlertRuleRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.AlertRulesReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertTriggers provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) AlertTriggers(_a0 *gin.Context, _a1 *dto.GetAlertReq) ([]*dto.AlertTriggerRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertTriggers")
+	}
+
+	var r0 []*dto.AlertTriggerRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) ([]*dto.AlertTriggerRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) []*dto.AlertTriggerRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.AlertTriggerRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetAlertReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AllFundamentals provides a mock function with given fields: _a0
+func (_m *RepoItf) AllFundamentals(_a0 *gin.Context) (map[string]dto.FundamentalsRes, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllFundamentals")
+	}
+
+	var r0 map[string]dto.FundamentalsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (map[string]dto.FundamentalsRes, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) map[string]dto.FundamentalsRes); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]dto.FundamentalsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BarDates provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) BarDates(_a0 *gin.Context, _a1 string) ([]dto.DateOnly, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BarDates")
+	}
+
+	var r0 []dto.DateOnly
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) ([]dto.DateOnly, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) []dto.DateOnly); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DateOnly)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckSymbolExists provides a mock function with given fields: _a0, _a1
 func (_m *RepoItf) CheckSymbolExists(_a0 *gin.Context, _a1 *dto.CollectSymbolReq) (bool, error) {
 	ret := _m.Called(_a0, _a1)
@@ -42,6 +270,102 @@ func (_m *RepoItf) CheckSymbolExists(_a0 *gin.Context, _a1 *dto.CollectSymbolReq
 	return r0, r1
 }
 
+// ClaimJob provides a mock function with given fields: _a0
+func (_m *RepoItf) ClaimJob(_a0 *gin.Context) (*dto.JobRes, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimJob")
+	}
+
+	var r0 *dto.JobRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.JobRes, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.JobRes); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.JobRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CorporateActions provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) CorporateActions(_a0 *gin.Context, _a1 string) ([]dto.CorporateActionRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CorporateActions")
+	}
+
+	var r0 []dto.CorporateActionRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) ([]dto.CorporateActionRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) []dto.CorporateActionRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CorporateActionRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAlertRule provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) DeleteAlertRule(_a0 *gin.Context, _a1 *dto.GetAlertReq) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlertRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePortfolio provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) DeletePortfolio(_a0 *gin.Context, _a1 *dto.GetPortfolioReq) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePortfolio")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteSymbol provides a mock function with given fields: _a0, _a1
 func (_m *RepoItf) DeleteSymbol(_a0 *gin.Context, _a1 *dto.DeleteSymbolReq) error {
 	ret := _m.Called(_a0, _a1)
@@ -60,16 +384,16 @@ func (_m *RepoItf) DeleteSymbol(_a0 *gin.Context, _a1 *dto.DeleteSymbolReq) erro
 	return r0
 }
 
-// InsertNewSymbolData provides a mock function with given fields: _a0, _a1
-func (_m *RepoItf) InsertNewSymbolData(_a0 *gin.Context, _a1 *dto.DataPerSymbol) error {
+// DeleteTransaction provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) DeleteTransaction(_a0 *gin.Context, _a1 *dto.GetTransactionReq) error {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InsertNewSymbolData")
+		panic("no return value specified for DeleteTransaction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.DataPerSymbol) error); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetTransactionReq) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -78,29 +402,29 @@ func (_m *RepoItf) InsertNewSymbolData(_a0 *gin.Context, _a1 *dto.DataPerSymbol)
 	return r0
 }
 
-// StoredData provides a mock function with given fields: _a0
-func (_m *RepoItf) StoredData(_a0 *gin.Context) ([]dto.DataPerSymbol, error) {
-	ret := _m.Called(_a0)
+// FXRates provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) FXRates(_a0 *gin.Context, _a1 *dto.FXRatesReq) ([]dto.FXRateRes, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StoredData")
+		panic("no return value specified for FXRates")
 	}
 
-	var r0 []dto.DataPerSymbol
+	var r0 []dto.FXRateRes
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*gin.Context) ([]dto.DataPerSymbol, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FXRatesReq) ([]dto.FXRateRes, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(*gin.Context) []dto.DataPerSymbol); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FXRatesReq) []dto.FXRateRes); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.DataPerSymbol)
+			r0 = ret.Get(0).([]dto.FXRateRes)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.FXRatesReq) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -108,6 +432,950 @@ func (_m *RepoItf) StoredData(_a0 *gin.Context) ([]dto.DataPerSymbol, error) {
 	return r0, r1
 }
 
+// GetAlertRule provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) GetAlertRule(_a0 *gin.Context, _a1 *dto.GetAlertReq) (*dto.AlertRuleRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlertRule")
+	}
+
+	var r0 *dto.AlertRuleRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) (*dto.AlertRuleRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) *dto.AlertRuleRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AlertRuleRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetAlertReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAlertTrigger provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) GetAlertTrigger(_a0 *gin.Context, _a1 string) (*dto.AlertTriggerRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlertTrigger")
+	}
+
+	var r0 *dto.AlertTriggerRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) (*dto.AlertTriggerRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) *dto.AlertTriggerRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AlertTriggerRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBacktest provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) GetBacktest(_a0 *gin.Context, _a1 *dto.GetBacktestReq) (*dto.BacktestRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBacktest")
+	}
+
+	var r0 *dto.BacktestRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetBacktestReq) (*dto.BacktestRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetBacktestReq) *dto.BacktestRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BacktestRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetBacktestReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFundamentals provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) GetFundamentals(_a0 *gin.Context, _a1 string) (*dto.FundamentalsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFundamentals")
+	}
+
+	var r0 *dto.FundamentalsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) (*dto.FundamentalsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) *dto.FundamentalsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FundamentalsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJob provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) GetJob(_a0 *gin.Context, _a1 *dto.GetJobReq) (*dto.JobRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJob")
+	}
+
+	var r0 *dto.JobRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetJobReq) (*dto.JobRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetJobReq) *dto.JobRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.JobRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetJobReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPortfolio provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) GetPortfolio(_a0 *gin.Context, _a1 *dto.GetPortfolioReq) (*dto.PortfolioRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPortfolio")
+	}
+
+	var r0 *dto.PortfolioRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) (*dto.PortfolioRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) *dto.PortfolioRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PortfolioRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetPortfolioReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQuarantined provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) GetQuarantined(_a0 *gin.Context, _a1 string) (*dto.QuarantinedBarRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuarantined")
+	}
+
+	var r0 *dto.QuarantinedBarRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) (*dto.QuarantinedBarRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) *dto.QuarantinedBarRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.QuarantinedBarRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSymbol provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) GetSymbol(_a0 *gin.Context, _a1 string) (*dto.SymbolDataMeta, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSymbol")
+	}
+
+	var r0 *dto.SymbolDataMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) (*dto.SymbolDataMeta, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) *dto.SymbolDataMeta); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SymbolDataMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertAlertRule provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertAlertRule(_a0 *gin.Context, _a1 *dto.AlertRuleReq) (*dto.AlertRuleRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertAlertRule")
+	}
+
+	var r0 *dto.AlertRuleRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertRuleReq) (*dto.AlertRuleRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertRuleReq) *dto.AlertRuleRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AlertRuleRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.AlertRuleReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertAlertTrigger provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertAlertTrigger(_a0 *gin.Context, _a1 *dto.AlertTriggerRes) (*dto.AlertTriggerRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertAlertTrigger")
+	}
+
+	var r0 *dto.AlertTriggerRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertTriggerRes) (*dto.AlertTriggerRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertTriggerRes) *dto.AlertTriggerRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AlertTriggerRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.AlertTriggerRes) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertBacktest provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertBacktest(_a0 *gin.Context, _a1 *dto.BacktestRes) (*dto.BacktestRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertBacktest")
+	}
+
+	var r0 *dto.BacktestRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BacktestRes) (*dto.BacktestRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BacktestRes) *dto.BacktestRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BacktestRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.BacktestRes) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertBars provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertBars(_a0 *gin.Context, _a1 *dto.DataPerSymbol) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertBars")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.DataPerSymbol) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertJob provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertJob(_a0 *gin.Context, _a1 *dto.JobReq) (*dto.JobRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertJob")
+	}
+
+	var r0 *dto.JobRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.JobReq) (*dto.JobRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.JobReq) *dto.JobRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.JobRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.JobReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertNewSymbolData provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertNewSymbolData(_a0 *gin.Context, _a1 *dto.DataPerSymbol) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertNewSymbolData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.DataPerSymbol) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertPortfolio provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertPortfolio(_a0 *gin.Context, _a1 *dto.PortfolioReq) (*dto.PortfolioRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertPortfolio")
+	}
+
+	var r0 *dto.PortfolioRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PortfolioReq) (*dto.PortfolioRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PortfolioReq) *dto.PortfolioRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PortfolioRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.PortfolioReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertQuarantine provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertQuarantine(_a0 *gin.Context, _a1 []dto.QuarantinedBarRes) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertQuarantine")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, []dto.QuarantinedBarRes) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertSchedulerRun provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertSchedulerRun(_a0 *gin.Context, _a1 *dto.SchedulerRunRes) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertSchedulerRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.SchedulerRunRes) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertTransaction provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) InsertTransaction(_a0 *gin.Context, _a1 *dto.TransactionReq) (*dto.TransactionRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertTransaction")
+	}
+
+	var r0 *dto.TransactionRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.TransactionReq) (*dto.TransactionRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.TransactionReq) *dto.TransactionRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TransactionRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.TransactionReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LatestBarBefore provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RepoItf) LatestBarBefore(_a0 *gin.Context, _a1 string, _a2 dto.DateOnly) (*dto.DailyOHLCVRes, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestBarBefore")
+	}
+
+	var r0 *dto.DailyOHLCVRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, dto.DateOnly) (*dto.DailyOHLCVRes, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, dto.DateOnly) *dto.DailyOHLCVRes); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyOHLCVRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string, dto.DateOnly) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAlertChecked provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RepoItf) MarkAlertChecked(_a0 *gin.Context, _a1 string, _a2 dto.DateOnly) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAlertChecked")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, dto.DateOnly) (bool, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, dto.DateOnly) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string, dto.DateOnly) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Portfolios provides a mock function with given fields: _a0
+func (_m *RepoItf) Portfolios(_a0 *gin.Context) ([]*dto.PortfolioRes, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Portfolios")
+	}
+
+	var r0 []*dto.PortfolioRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) ([]*dto.PortfolioRes, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) []*dto.PortfolioRes); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.PortfolioRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QuarantinedBars provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) QuarantinedBars(_a0 *gin.Context, _a1 *dto.QuarantineReq) ([]*dto.QuarantinedBarRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuarantinedBars")
+	}
+
+	var r0 []*dto.QuarantinedBarRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.QuarantineReq) ([]*dto.QuarantinedBarRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.QuarantineReq) []*dto.QuarantinedBarRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.QuarantinedBarRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.QuarantineReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequeueRunningJobs provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RepoItf) RequeueRunningJobs(_a0 *gin.Context, _a1 int, _a2 *dto.JobErrorRes) (int64, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueRunningJobs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, int, *dto.JobErrorRes) (int64, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, int, *dto.JobErrorRes) int64); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, int, *dto.JobErrorRes) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewQuarantined provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RepoItf) ReviewQuarantined(_a0 *gin.Context, _a1 string, _a2 string) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReviewQuarantined")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, string) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchedulerRuns provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) SchedulerRuns(_a0 *gin.Context, _a1 int64) ([]*dto.SchedulerRunRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SchedulerRuns")
+	}
+
+	var r0 []*dto.SchedulerRunRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, int64) ([]*dto.SchedulerRunRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, int64) []*dto.SchedulerRunRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.SchedulerRunRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoredData provides a mock function with given fields: _a0
+func (_m *RepoItf) StoredData(_a0 *gin.Context) ([]dto.DataPerSymbol, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoredData")
+	}
+
+	var r0 []dto.DataPerSymbol
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) ([]dto.DataPerSymbol, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) []dto.DataPerSymbol); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DataPerSymbol)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SymbolBars provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) SymbolBars(_a0 *gin.Context, _a1 string) ([]dto.DailyOHLCVRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SymbolBars")
+	}
+
+	var r0 []dto.DailyOHLCVRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) ([]dto.DailyOHLCVRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) []dto.DailyOHLCVRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DailyOHLCVRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrackedSymbols provides a mock function with given fields: _a0
+func (_m *RepoItf) TrackedSymbols(_a0 *gin.Context) ([]dto.SymbolDataMeta, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrackedSymbols")
+	}
+
+	var r0 []dto.SymbolDataMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) ([]dto.SymbolDataMeta, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) []dto.SymbolDataMeta); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.SymbolDataMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Transactions provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) Transactions(_a0 *gin.Context, _a1 *dto.GetPortfolioReq) ([]dto.TransactionRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transactions")
+	}
+
+	var r0 []dto.TransactionRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) ([]dto.TransactionRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) []dto.TransactionRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TransactionRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetPortfolioReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAlertDelivery provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) UpdateAlertDelivery(_a0 *gin.Context, _a1 *dto.AlertTriggerRes) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlertDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertTriggerRes) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateJob provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) UpdateJob(_a0 *gin.Context, _a1 *dto.JobRes) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.JobRes) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLastRefreshed provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) UpdateLastRefreshed(_a0 *gin.Context, _a1 *dto.SymbolDataMeta) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastRefreshed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.SymbolDataMeta) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePortfolio provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) UpdatePortfolio(_a0 *gin.Context, _a1 *dto.PortfolioReq) (*dto.PortfolioRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePortfolio")
+	}
+
+	var r0 *dto.PortfolioRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PortfolioReq) (*dto.PortfolioRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PortfolioReq) *dto.PortfolioRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PortfolioRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.PortfolioReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertCorporateActions provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) UpsertCorporateActions(_a0 *gin.Context, _a1 []dto.CorporateActionRes) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertCorporateActions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, []dto.CorporateActionRes) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertFXRates provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) UpsertFXRates(_a0 *gin.Context, _a1 []dto.FXRateRes) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertFXRates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, []dto.FXRateRes) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertFundamentals provides a mock function with given fields: _a0, _a1
+func (_m *RepoItf) UpsertFundamentals(_a0 *gin.Context, _a1 *dto.FundamentalsRes) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertFundamentals")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FundamentalsRes) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewRepoItf creates a new instance of RepoItf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepoItf(t interface {
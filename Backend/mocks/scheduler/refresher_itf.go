@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "Backend/dto"
+
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RefresherItf is an autogenerated mock type for the RefresherItf type
+type RefresherItf struct {
+	mock.Mock
+}
+
+// RefreshTracked provides a mock function with given fields: _a0, _a1
+func (_m *RefresherItf) RefreshTracked(_a0 *gin.Context, _a1 *dto.RefreshTrackedReq) (*dto.SchedulerRunRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTracked")
+	}
+
+	var r0 *dto.SchedulerRunRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.RefreshTrackedReq) (*dto.SchedulerRunRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.RefreshTrackedReq) *dto.SchedulerRunRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SchedulerRunRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.RefreshTrackedReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRefresherItf creates a new instance of RefresherItf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRefresherItf(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RefresherItf {
+	mock := &RefresherItf{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
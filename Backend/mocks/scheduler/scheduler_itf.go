@@ -0,0 +1,86 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "Backend/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SchedulerItf is an autogenerated mock type for the SchedulerItf type
+type SchedulerItf struct {
+	mock.Mock
+}
+
+// Pause provides a mock function with no fields
+func (_m *SchedulerItf) Pause() {
+	_m.Called()
+}
+
+// Resume provides a mock function with no fields
+func (_m *SchedulerItf) Resume() {
+	_m.Called()
+}
+
+// Start provides a mock function with no fields
+func (_m *SchedulerItf) Start() {
+	_m.Called()
+}
+
+// Status provides a mock function with no fields
+func (_m *SchedulerItf) Status() *dto.SchedulerStatusRes {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 *dto.SchedulerStatusRes
+	if rf, ok := ret.Get(0).(func() *dto.SchedulerStatusRes); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SchedulerStatusRes)
+		}
+	}
+
+	return r0
+}
+
+// Stop provides a mock function with no fields
+func (_m *SchedulerItf) Stop() {
+	_m.Called()
+}
+
+// Trigger provides a mock function with no fields
+func (_m *SchedulerItf) Trigger() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trigger")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSchedulerItf creates a new instance of SchedulerItf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSchedulerItf(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SchedulerItf {
+	mock := &SchedulerItf{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,9 +3,11 @@
 package mocks
 
 import (
+	calendar "Backend/calendar"
 	dto "Backend/dto"
 
 	gin "github.com/gin-gonic/gin"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,48 +16,58 @@ type UsecaseItf struct {
 	mock.Mock
 }
 
-// BuildStockData provides a mock function with given fields: _a0
-func (_m *UsecaseItf) BuildStockData(_a0 *dto.DataPerSymbol) *dto.StockDataRes {
-	ret := _m.Called(_a0)
+// AddTransaction provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) AddTransaction(_a0 *gin.Context, _a1 *dto.TransactionReq) (*dto.TransactionRes, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BuildStockData")
+		panic("no return value specified for AddTransaction")
 	}
 
-	var r0 *dto.StockDataRes
-	if rf, ok := ret.Get(0).(func(*dto.DataPerSymbol) *dto.StockDataRes); ok {
-		r0 = rf(_a0)
+	var r0 *dto.TransactionRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.TransactionReq) (*dto.TransactionRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.TransactionReq) *dto.TransactionRes); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.StockDataRes)
+			r0 = ret.Get(0).(*dto.TransactionRes)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.TransactionReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// CollectSymbol provides a mock function with given fields: _a0, _a1
-func (_m *UsecaseItf) CollectSymbol(_a0 *gin.Context, _a1 *dto.CollectSymbolReq) (*dto.StockDataRes, error) {
+// AlertRules provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) AlertRules(_a0 *gin.Context, _a1 *dto.AlertRulesReq) ([]*dto.AlertRuleRes, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CollectSymbol")
+		panic("no return value specified for AlertRules")
 	}
 
-	var r0 *dto.StockDataRes
+	var r0 []*dto.AlertRuleRes
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CollectSymbolReq) (*dto.StockDataRes, error)); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertRulesReq) ([]*dto.AlertRuleRes, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CollectSymbolReq) *dto.StockDataRes); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertRulesReq) []*dto.AlertRuleRes); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.StockDataRes)
+			r0 = ret.Get(0).([]*dto.AlertRuleRes)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.CollectSymbolReq) error); ok {
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.AlertRulesReq) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -64,46 +76,58 @@ func (_m *UsecaseItf) CollectSymbol(_a0 *gin.Context, _a1 *dto.CollectSymbolReq)
 	return r0, r1
 }
 
-// DeleteSymbol provides a mock function with given fields: _a0, _a1
-func (_m *UsecaseItf) DeleteSymbol(_a0 *gin.Context, _a1 *dto.DeleteSymbolReq) error {
+// AlertTriggers provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) AlertTriggers(_a0 *gin.Context, _a1 *dto.GetAlertReq) ([]*dto.AlertTriggerRes, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSymbol")
+		panic("no return value specified for AlertTriggers")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.DeleteSymbolReq) error); ok {
+	var r0 []*dto.AlertTriggerRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) ([]*dto.AlertTriggerRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) []*dto.AlertTriggerRes); ok {
 		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.AlertTriggerRes)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetAlertReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// GetSymbols provides a mock function with given fields: _a0, _a1
-func (_m *UsecaseItf) GetSymbols(_a0 *gin.Context, _a1 *dto.GetSymbolsReq) (*dto.AlphaSymbolsRes, error) {
+// Anomalies provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Anomalies(_a0 *gin.Context, _a1 *dto.AnomaliesReq) (*dto.AnomaliesRes, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSymbols")
+		panic("no return value specified for Anomalies")
 	}
 
-	var r0 *dto.AlphaSymbolsRes
+	var r0 *dto.AnomaliesRes
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetSymbolsReq) (*dto.AlphaSymbolsRes, error)); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AnomaliesReq) (*dto.AnomaliesRes, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetSymbolsReq) *dto.AlphaSymbolsRes); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AnomaliesReq) *dto.AnomaliesRes); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.AlphaSymbolsRes)
+			r0 = ret.Get(0).(*dto.AnomaliesRes)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetSymbolsReq) error); ok {
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.AnomaliesReq) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -112,66 +136,88 @@ func (_m *UsecaseItf) GetSymbols(_a0 *gin.Context, _a1 *dto.GetSymbolsReq) (*dto
 	return r0, r1
 }
 
-// GetUnexpectedInfo provides a mock function with given fields: _a0
-func (_m *UsecaseItf) GetUnexpectedInfo(_a0 []byte) error {
-	ret := _m.Called(_a0)
+// Backfill provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Backfill(_a0 *gin.Context, _a1 *dto.JobRes) (map[string]interface{}, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUnexpectedInfo")
+		panic("no return value specified for Backfill")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte) error); ok {
-		r0 = rf(_a0)
+	var r0 map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.JobRes) (map[string]interface{}, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.JobRes) map[string]interface{}); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.JobRes) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// NextWeek provides a mock function with given fields: _a0
-func (_m *UsecaseItf) NextWeek(_a0 dto.DateOnly) *dto.WeekRes {
-	ret := _m.Called(_a0)
+// Beta provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Beta(_a0 *gin.Context, _a1 *dto.BetaReq) (*dto.BetaRes, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for NextWeek")
+		panic("no return value specified for Beta")
 	}
 
-	var r0 *dto.WeekRes
-	if rf, ok := ret.Get(0).(func(dto.DateOnly) *dto.WeekRes); ok {
-		r0 = rf(_a0)
+	var r0 *dto.BetaRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BetaReq) (*dto.BetaRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BetaReq) *dto.BetaRes); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.WeekRes)
+			r0 = ret.Get(0).(*dto.BetaRes)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.BetaReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ParseOHLCV provides a mock function with given fields: _a0, _a1
-func (_m *UsecaseItf) ParseOHLCV(_a0 *gin.Context, _a1 *map[string]string) (*dto.DailyOHLCVRes, error) {
+// Betas provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Betas(_a0 *gin.Context, _a1 *dto.BetasReq) (*dto.BetasRes, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ParseOHLCV")
+		panic("no return value specified for Betas")
 	}
 
-	var r0 *dto.DailyOHLCVRes
+	var r0 *dto.BetasRes
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*gin.Context, *map[string]string) (*dto.DailyOHLCVRes, error)); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BetasReq) (*dto.BetasRes, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(*gin.Context, *map[string]string) *dto.DailyOHLCVRes); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BetasReq) *dto.BetasRes); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.DailyOHLCVRes)
+			r0 = ret.Get(0).(*dto.BetasRes)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*gin.Context, *map[string]string) error); ok {
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.BetasReq) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -180,42 +226,44 @@ func (_m *UsecaseItf) ParseOHLCV(_a0 *gin.Context, _a1 *map[string]string) (*dto
 	return r0, r1
 }
 
-// PrevWeekend provides a mock function with given fields: _a0
-func (_m *UsecaseItf) PrevWeekend(_a0 dto.DateOnly) dto.DateOnly {
+// BuildStockData provides a mock function with given fields: _a0
+func (_m *UsecaseItf) BuildStockData(_a0 *dto.DataPerSymbol) *dto.StockDataRes {
 	ret := _m.Called(_a0)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PrevWeekend")
+		panic("no return value specified for BuildStockData")
 	}
 
-	var r0 dto.DateOnly
-	if rf, ok := ret.Get(0).(func(dto.DateOnly) dto.DateOnly); ok {
+	var r0 *dto.StockDataRes
+	if rf, ok := ret.Get(0).(func(*dto.DataPerSymbol) *dto.StockDataRes); ok {
 		r0 = rf(_a0)
 	} else {
-		r0 = ret.Get(0).(dto.DateOnly)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StockDataRes)
+		}
 	}
 
 	return r0
 }
 
-// StoredData provides a mock function with given fields: _a0
-func (_m *UsecaseItf) StoredData(_a0 *gin.Context) ([]*dto.StockDataRes, error) {
+// ClaimJob provides a mock function with given fields: _a0
+func (_m *UsecaseItf) ClaimJob(_a0 *gin.Context) (*dto.JobRes, error) {
 	ret := _m.Called(_a0)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StoredData")
+		panic("no return value specified for ClaimJob")
 	}
 
-	var r0 []*dto.StockDataRes
+	var r0 *dto.JobRes
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*gin.Context) ([]*dto.StockDataRes, error)); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.JobRes, error)); ok {
 		return rf(_a0)
 	}
-	if rf, ok := ret.Get(0).(func(*gin.Context) []*dto.StockDataRes); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.JobRes); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dto.StockDataRes)
+			r0 = ret.Get(0).(*dto.JobRes)
 		}
 	}
 
@@ -228,6 +276,1560 @@ func (_m *UsecaseItf) StoredData(_a0 *gin.Context) ([]*dto.StockDataRes, error)
 	return r0, r1
 }
 
+// CollectSymbol provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) CollectSymbol(_a0 *gin.Context, _a1 *dto.CollectSymbolReq) (*dto.StockDataRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectSymbol")
+	}
+
+	var r0 *dto.StockDataRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CollectSymbolReq) (*dto.StockDataRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CollectSymbolReq) *dto.StockDataRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StockDataRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.CollectSymbolReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Compare provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Compare(_a0 *gin.Context, _a1 *dto.CompareReq) (*dto.CompareRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Compare")
+	}
+
+	var r0 *dto.CompareRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CompareReq) (*dto.CompareRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CompareReq) *dto.CompareRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CompareRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.CompareReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CorporateActions provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) CorporateActions(_a0 *gin.Context, _a1 *dto.CorporateActionsReq) ([]dto.CorporateActionRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CorporateActions")
+	}
+
+	var r0 []dto.CorporateActionRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CorporateActionsReq) ([]dto.CorporateActionRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CorporateActionsReq) []dto.CorporateActionRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CorporateActionRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.CorporateActionsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Correlation provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Correlation(_a0 *gin.Context, _a1 *dto.CorrelationReq) (*dto.CorrelationRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Correlation")
+	}
+
+	var r0 *dto.CorrelationRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CorrelationReq) (*dto.CorrelationRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CorrelationReq) *dto.CorrelationRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CorrelationRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.CorrelationReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAlertRule provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) CreateAlertRule(_a0 *gin.Context, _a1 *dto.AlertRuleReq) (*dto.AlertRuleRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAlertRule")
+	}
+
+	var r0 *dto.AlertRuleRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertRuleReq) (*dto.AlertRuleRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlertRuleReq) *dto.AlertRuleRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AlertRuleRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.AlertRuleReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePortfolio provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) CreatePortfolio(_a0 *gin.Context, _a1 *dto.PortfolioReq) (*dto.PortfolioRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePortfolio")
+	}
+
+	var r0 *dto.PortfolioRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PortfolioReq) (*dto.PortfolioRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PortfolioReq) *dto.PortfolioRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PortfolioRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.PortfolioReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAlertRule provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) DeleteAlertRule(_a0 *gin.Context, _a1 *dto.GetAlertReq) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlertRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePortfolio provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) DeletePortfolio(_a0 *gin.Context, _a1 *dto.GetPortfolioReq) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePortfolio")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSymbol provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) DeleteSymbol(_a0 *gin.Context, _a1 *dto.DeleteSymbolReq) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSymbol")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.DeleteSymbolReq) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTransaction provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) DeleteTransaction(_a0 *gin.Context, _a1 *dto.GetTransactionReq) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetTransactionReq) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeliverAlert provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) DeliverAlert(_a0 *gin.Context, _a1 *dto.JobRes) (map[string]interface{}, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverAlert")
+	}
+
+	var r0 map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.JobRes) (map[string]interface{}, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.JobRes) map[string]interface{}); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.JobRes) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnqueueBackfill provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) EnqueueBackfill(_a0 *gin.Context, _a1 *dto.BackfillReq) (*dto.JobRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueBackfill")
+	}
+
+	var r0 *dto.JobRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BackfillReq) (*dto.JobRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BackfillReq) *dto.JobRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.JobRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.BackfillReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnqueueCollect provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) EnqueueCollect(_a0 *gin.Context, _a1 *dto.CollectSymbolReq) (*dto.JobRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueCollect")
+	}
+
+	var r0 *dto.JobRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CollectSymbolReq) (*dto.JobRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CollectSymbolReq) *dto.JobRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.JobRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.CollectSymbolReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FXRates provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) FXRates(_a0 *gin.Context, _a1 *dto.FXRatesReq) ([]dto.FXRateRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FXRates")
+	}
+
+	var r0 []dto.FXRateRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FXRatesReq) ([]dto.FXRateRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FXRatesReq) []dto.FXRateRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.FXRateRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.FXRatesReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchAlphaDaily provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) FetchAlphaDaily(_a0 *gin.Context, _a1 string) (*dto.AlphaStockDataRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchAlphaDaily")
+	}
+
+	var r0 *dto.AlphaStockDataRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) (*dto.AlphaStockDataRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, string) *dto.AlphaStockDataRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AlphaStockDataRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Fundamentals provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Fundamentals(_a0 *gin.Context, _a1 *dto.FundamentalsReq) (*dto.FundamentalsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fundamentals")
+	}
+
+	var r0 *dto.FundamentalsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FundamentalsReq) (*dto.FundamentalsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FundamentalsReq) *dto.FundamentalsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FundamentalsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.FundamentalsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GapsSummary provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) GapsSummary(_a0 *gin.Context, _a1 *dto.GapsReq) ([]*dto.SymbolGapsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GapsSummary")
+	}
+
+	var r0 []*dto.SymbolGapsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GapsReq) ([]*dto.SymbolGapsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GapsReq) []*dto.SymbolGapsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.SymbolGapsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GapsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAlertRule provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) GetAlertRule(_a0 *gin.Context, _a1 *dto.GetAlertReq) (*dto.AlertRuleRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlertRule")
+	}
+
+	var r0 *dto.AlertRuleRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) (*dto.AlertRuleRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetAlertReq) *dto.AlertRuleRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AlertRuleRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetAlertReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBacktest provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) GetBacktest(_a0 *gin.Context, _a1 *dto.GetBacktestReq) (*dto.BacktestRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBacktest")
+	}
+
+	var r0 *dto.BacktestRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetBacktestReq) (*dto.BacktestRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetBacktestReq) *dto.BacktestRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BacktestRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetBacktestReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJob provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) GetJob(_a0 *gin.Context, _a1 *dto.GetJobReq) (*dto.JobRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJob")
+	}
+
+	var r0 *dto.JobRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetJobReq) (*dto.JobRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetJobReq) *dto.JobRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.JobRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetJobReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPortfolio provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) GetPortfolio(_a0 *gin.Context, _a1 *dto.GetPortfolioReq) (*dto.PortfolioRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPortfolio")
+	}
+
+	var r0 *dto.PortfolioRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) (*dto.PortfolioRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) *dto.PortfolioRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PortfolioRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetPortfolioReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSymbols provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) GetSymbols(_a0 *gin.Context, _a1 *dto.GetSymbolsReq) (*dto.AlphaSymbolsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSymbols")
+	}
+
+	var r0 *dto.AlphaSymbolsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetSymbolsReq) (*dto.AlphaSymbolsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetSymbolsReq) *dto.AlphaSymbolsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AlphaSymbolsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetSymbolsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnexpectedInfo provides a mock function with given fields: _a0
+func (_m *UsecaseItf) GetUnexpectedInfo(_a0 []byte) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnexpectedInfo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Heatmap provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Heatmap(_a0 *gin.Context, _a1 *dto.SeasonalityReq) (*dto.HeatmapRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Heatmap")
+	}
+
+	var r0 *dto.HeatmapRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.SeasonalityReq) (*dto.HeatmapRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.SeasonalityReq) *dto.HeatmapRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.HeatmapRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.SeasonalityReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HeikinAshi provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) HeikinAshi(_a0 *gin.Context, _a1 *dto.HeikinAshiReq) (*dto.HeikinAshiRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HeikinAshi")
+	}
+
+	var r0 *dto.HeikinAshiRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.HeikinAshiReq) (*dto.HeikinAshiRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.HeikinAshiReq) *dto.HeikinAshiRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.HeikinAshiRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.HeikinAshiReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Indicators provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Indicators(_a0 *gin.Context, _a1 *dto.IndicatorsReq) (*dto.IndicatorsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Indicators")
+	}
+
+	var r0 *dto.IndicatorsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.IndicatorsReq) (*dto.IndicatorsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.IndicatorsReq) *dto.IndicatorsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.IndicatorsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.IndicatorsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Lots provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Lots(_a0 *gin.Context, _a1 *dto.LotsReq) (*dto.LotsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lots")
+	}
+
+	var r0 *dto.LotsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.LotsReq) (*dto.LotsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.LotsReq) *dto.LotsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LotsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.LotsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NextWeek provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) NextWeek(_a0 calendar.CalendarItf, _a1 dto.DateOnly) *dto.WeekRes {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextWeek")
+	}
+
+	var r0 *dto.WeekRes
+	if rf, ok := ret.Get(0).(func(calendar.CalendarItf, dto.DateOnly) *dto.WeekRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.WeekRes)
+		}
+	}
+
+	return r0
+}
+
+// ParseOHLCV provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) ParseOHLCV(_a0 *gin.Context, _a1 *map[string]string) (*dto.DailyOHLCVRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseOHLCV")
+	}
+
+	var r0 *dto.DailyOHLCVRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *map[string]string) (*dto.DailyOHLCVRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *map[string]string) *dto.DailyOHLCVRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DailyOHLCVRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *map[string]string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ParseTimeSeries provides a mock function with given fields: _a0, _a1, _a2
+func (_m *UsecaseItf) ParseTimeSeries(_a0 *gin.Context, _a1 *dto.AlphaStockDataRes, _a2 dto.DateOnly) ([]dto.DailyOHLCVRes, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseTimeSeries")
+	}
+
+	var r0 []dto.DailyOHLCVRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlphaStockDataRes, dto.DateOnly) ([]dto.DailyOHLCVRes, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.AlphaStockDataRes, dto.DateOnly) []dto.DailyOHLCVRes); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.DailyOHLCVRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.AlphaStockDataRes, dto.DateOnly) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Patterns provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Patterns(_a0 *gin.Context, _a1 *dto.PatternsReq) (*dto.PatternsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patterns")
+	}
+
+	var r0 *dto.PatternsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PatternsReq) (*dto.PatternsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PatternsReq) *dto.PatternsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PatternsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.PatternsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PointFigure provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) PointFigure(_a0 *gin.Context, _a1 *dto.BoxChartReq) (*dto.PointFigureRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PointFigure")
+	}
+
+	var r0 *dto.PointFigureRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BoxChartReq) (*dto.PointFigureRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BoxChartReq) *dto.PointFigureRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PointFigureRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.BoxChartReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Portfolios provides a mock function with given fields: _a0
+func (_m *UsecaseItf) Portfolios(_a0 *gin.Context) ([]*dto.PortfolioRes, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Portfolios")
+	}
+
+	var r0 []*dto.PortfolioRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) ([]*dto.PortfolioRes, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) []*dto.PortfolioRes); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.PortfolioRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrevWeekend provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) PrevWeekend(_a0 calendar.CalendarItf, _a1 dto.DateOnly) dto.DateOnly {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrevWeekend")
+	}
+
+	var r0 dto.DateOnly
+	if rf, ok := ret.Get(0).(func(calendar.CalendarItf, dto.DateOnly) dto.DateOnly); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.DateOnly)
+	}
+
+	return r0
+}
+
+// QuarantinedBars provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) QuarantinedBars(_a0 *gin.Context, _a1 *dto.QuarantineReq) ([]*dto.QuarantinedBarRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuarantinedBars")
+	}
+
+	var r0 []*dto.QuarantinedBarRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.QuarantineReq) ([]*dto.QuarantinedBarRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.QuarantineReq) []*dto.QuarantinedBarRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.QuarantinedBarRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.QuarantineReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Realized provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Realized(_a0 *gin.Context, _a1 *dto.LotsReq) (*dto.RealizedRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Realized")
+	}
+
+	var r0 *dto.RealizedRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.LotsReq) (*dto.RealizedRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.LotsReq) *dto.RealizedRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RealizedRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.LotsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshSymbol provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) RefreshSymbol(_a0 *gin.Context, _a1 *dto.RefreshSymbolReq) (*dto.RefreshSymbolRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSymbol")
+	}
+
+	var r0 *dto.RefreshSymbolRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.RefreshSymbolReq) (*dto.RefreshSymbolRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.RefreshSymbolReq) *dto.RefreshSymbolRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RefreshSymbolRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.RefreshSymbolReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshTracked provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) RefreshTracked(_a0 *gin.Context, _a1 *dto.RefreshTrackedReq) (*dto.SchedulerRunRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTracked")
+	}
+
+	var r0 *dto.SchedulerRunRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.RefreshTrackedReq) (*dto.SchedulerRunRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.RefreshTrackedReq) *dto.SchedulerRunRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SchedulerRunRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.RefreshTrackedReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Renko provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Renko(_a0 *gin.Context, _a1 *dto.BoxChartReq) (*dto.RenkoRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Renko")
+	}
+
+	var r0 *dto.RenkoRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BoxChartReq) (*dto.RenkoRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BoxChartReq) *dto.RenkoRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RenkoRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.BoxChartReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequeueJobs provides a mock function with given fields: _a0
+func (_m *UsecaseItf) RequeueJobs(_a0 *gin.Context) (int64, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueJobs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewQuarantined provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) ReviewQuarantined(_a0 *gin.Context, _a1 *dto.ReviewQuarantineReq) (*dto.QuarantinedBarRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReviewQuarantined")
+	}
+
+	var r0 *dto.QuarantinedBarRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.ReviewQuarantineReq) (*dto.QuarantinedBarRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.ReviewQuarantineReq) *dto.QuarantinedBarRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.QuarantinedBarRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.ReviewQuarantineReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunBacktest provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) RunBacktest(_a0 *gin.Context, _a1 *dto.BacktestReq) (*dto.BacktestRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunBacktest")
+	}
+
+	var r0 *dto.BacktestRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BacktestReq) (*dto.BacktestRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.BacktestReq) *dto.BacktestRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.BacktestRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.BacktestReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunJob provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) RunJob(_a0 *gin.Context, _a1 *dto.JobRes) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.JobRes) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchedulerRuns provides a mock function with given fields: _a0
+func (_m *UsecaseItf) SchedulerRuns(_a0 *gin.Context) ([]*dto.SchedulerRunRes, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SchedulerRuns")
+	}
+
+	var r0 []*dto.SchedulerRunRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) ([]*dto.SchedulerRunRes, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) []*dto.SchedulerRunRes); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.SchedulerRunRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Screen provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Screen(_a0 *gin.Context, _a1 *dto.ScreenReq) (*dto.ScreenRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Screen")
+	}
+
+	var r0 *dto.ScreenRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.ScreenReq) (*dto.ScreenRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.ScreenReq) *dto.ScreenRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ScreenRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.ScreenReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Seasonality provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Seasonality(_a0 *gin.Context, _a1 *dto.SeasonalityReq) (*dto.SeasonalityRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Seasonality")
+	}
+
+	var r0 *dto.SeasonalityRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.SeasonalityReq) (*dto.SeasonalityRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.SeasonalityReq) *dto.SeasonalityRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SeasonalityRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.SeasonalityReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Stats provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Stats(_a0 *gin.Context, _a1 *dto.StatsReq) (*dto.StatsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 *dto.StatsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.StatsReq) (*dto.StatsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.StatsReq) *dto.StatsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.StatsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.StatsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoredData provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) StoredData(_a0 *gin.Context, _a1 *dto.StoredDataReq) ([]*dto.StockDataRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoredData")
+	}
+
+	var r0 []*dto.StockDataRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.StoredDataReq) ([]*dto.StockDataRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.StoredDataReq) []*dto.StockDataRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dto.StockDataRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.StoredDataReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SymbolGaps provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) SymbolGaps(_a0 *gin.Context, _a1 *dto.GapsReq) (*dto.SymbolGapsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SymbolGaps")
+	}
+
+	var r0 *dto.SymbolGapsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GapsReq) (*dto.SymbolGapsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GapsReq) *dto.SymbolGapsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SymbolGapsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GapsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SyncCorporateActions provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) SyncCorporateActions(_a0 *gin.Context, _a1 *dto.CorporateActionsReq) ([]dto.CorporateActionRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncCorporateActions")
+	}
+
+	var r0 []dto.CorporateActionRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CorporateActionsReq) ([]dto.CorporateActionRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.CorporateActionsReq) []dto.CorporateActionRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.CorporateActionRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.CorporateActionsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SyncFXRates provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) SyncFXRates(_a0 *gin.Context, _a1 *dto.FXRatesReq) (*dto.FXSyncRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncFXRates")
+	}
+
+	var r0 *dto.FXSyncRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FXRatesReq) (*dto.FXSyncRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FXRatesReq) *dto.FXSyncRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FXSyncRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.FXRatesReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SyncFundamentals provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) SyncFundamentals(_a0 *gin.Context, _a1 *dto.FundamentalsReq) (*dto.FundamentalsRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncFundamentals")
+	}
+
+	var r0 *dto.FundamentalsRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FundamentalsReq) (*dto.FundamentalsRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.FundamentalsReq) *dto.FundamentalsRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.FundamentalsRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.FundamentalsReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Transactions provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) Transactions(_a0 *gin.Context, _a1 *dto.GetPortfolioReq) ([]dto.TransactionRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transactions")
+	}
+
+	var r0 []dto.TransactionRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) ([]dto.TransactionRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.GetPortfolioReq) []dto.TransactionRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TransactionRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.GetPortfolioReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePortfolio provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) UpdatePortfolio(_a0 *gin.Context, _a1 *dto.PortfolioReq) (*dto.PortfolioRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePortfolio")
+	}
+
+	var r0 *dto.PortfolioRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PortfolioReq) (*dto.PortfolioRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.PortfolioReq) *dto.PortfolioRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PortfolioRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.PortfolioReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValuePortfolio provides a mock function with given fields: _a0, _a1
+func (_m *UsecaseItf) ValuePortfolio(_a0 *gin.Context, _a1 *dto.ValuationReq) (*dto.ValuationRes, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValuePortfolio")
+	}
+
+	var r0 *dto.ValuationRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.ValuationReq) (*dto.ValuationRes, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.ValuationReq) *dto.ValuationRes); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ValuationRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, *dto.ValuationReq) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewUsecaseItf creates a new instance of UsecaseItf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUsecaseItf(t interface {
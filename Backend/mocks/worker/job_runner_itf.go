@@ -0,0 +1,105 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "Backend/dto"
+
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobRunnerItf is an autogenerated mock type for the JobRunnerItf type
+type JobRunnerItf struct {
+	mock.Mock
+}
+
+// ClaimJob provides a mock function with given fields: _a0
+func (_m *JobRunnerItf) ClaimJob(_a0 *gin.Context) (*dto.JobRes, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimJob")
+	}
+
+	var r0 *dto.JobRes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.JobRes, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.JobRes); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.JobRes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequeueJobs provides a mock function with given fields: _a0
+func (_m *JobRunnerItf) RequeueJobs(_a0 *gin.Context) (int64, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueJobs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunJob provides a mock function with given fields: _a0, _a1
+func (_m *JobRunnerItf) RunJob(_a0 *gin.Context, _a1 *dto.JobRes) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, *dto.JobRes) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewJobRunnerItf creates a new instance of JobRunnerItf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobRunnerItf(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobRunnerItf {
+	mock := &JobRunnerItf{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
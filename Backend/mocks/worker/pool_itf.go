@@ -0,0 +1,34 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// PoolItf is an autogenerated mock type for the PoolItf type
+type PoolItf struct {
+	mock.Mock
+}
+
+// Start provides a mock function with no fields
+func (_m *PoolItf) Start() {
+	_m.Called()
+}
+
+// Stop provides a mock function with no fields
+func (_m *PoolItf) Stop() {
+	_m.Called()
+}
+
+// NewPoolItf creates a new instance of PoolItf. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPoolItf(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PoolItf {
+	mock := &PoolItf{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
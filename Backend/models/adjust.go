@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CorporateAction struct {
+	Id     primitive.ObjectID   `bson:"_id,omitempty"`
+	Ticker string               `bson:"ticker"`
+	Date   time.Time            `bson:"date"`
+	Type   string               `bson:"type"`
+	Value  primitive.Decimal128 `bson:"value"`
+}
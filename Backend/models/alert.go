@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AlertRule struct {
+	Id          primitive.ObjectID   `bson:"_id,omitempty"`
+	Ticker      string               `bson:"ticker"`
+	Type        string               `bson:"type"`
+	Level       primitive.Decimal128 `bson:"level"`
+	Threshold   primitive.Decimal128 `bson:"threshold"`
+	Days        int                  `bson:"days"`
+	Period      int                  `bson:"period"`
+	WebhookURL  string               `bson:"webhook_url"`
+	LastChecked *time.Time           `bson:"last_checked"`
+	CreatedAt   time.Time            `bson:"created_at"`
+}
+
+type AlertTrigger struct {
+	Id          primitive.ObjectID   `bson:"_id,omitempty"`
+	RuleId      primitive.ObjectID   `bson:"rule_id"`
+	Ticker      string               `bson:"ticker"`
+	Type        string               `bson:"type"`
+	Date        time.Time            `bson:"date"`
+	Close       primitive.Decimal128 `bson:"close"`
+	Value       primitive.Decimal128 `bson:"value"`
+	Message     string               `bson:"message"`
+	WebhookURL  string               `bson:"webhook_url"`
+	Status      string               `bson:"status"`
+	Attempts    int                  `bson:"attempts"`
+	LastError   string               `bson:"last_error,omitempty"`
+	CreatedAt   time.Time            `bson:"created_at"`
+	DeliveredAt *time.Time           `bson:"delivered_at"`
+}
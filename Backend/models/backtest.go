@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type BacktestTrade struct {
+	EntryDate  time.Time             `bson:"entry_date"`
+	EntryPrice primitive.Decimal128  `bson:"entry_price"`
+	Quantity   primitive.Decimal128  `bson:"quantity"`
+	ExitDate   *time.Time            `bson:"exit_date"`
+	ExitPrice  *primitive.Decimal128 `bson:"exit_price"`
+	Commission primitive.Decimal128  `bson:"commission"`
+	PnL        primitive.Decimal128  `bson:"pnl"`
+	Return     primitive.Decimal128  `bson:"return"`
+	Open       bool                  `bson:"open"`
+}
+
+type EquityPoint struct {
+	Date     time.Time            `bson:"date"`
+	Cash     primitive.Decimal128 `bson:"cash"`
+	Position primitive.Decimal128 `bson:"position"`
+	Equity   primitive.Decimal128 `bson:"equity"`
+}
+
+type BacktestSummary struct {
+	FinalEquity      primitive.Decimal128  `bson:"final_equity"`
+	TotalReturn      primitive.Decimal128  `bson:"total_return"`
+	AnnualizedReturn primitive.Decimal128  `bson:"annualized_return"`
+	MaxDrawdown      primitive.Decimal128  `bson:"max_drawdown"`
+	DrawdownPeak     time.Time             `bson:"drawdown_peak"`
+	DrawdownTrough   time.Time             `bson:"drawdown_trough"`
+	Sharpe           *primitive.Decimal128 `bson:"sharpe"`
+	Trades           int                   `bson:"trades"`
+	WinRate          *primitive.Decimal128 `bson:"win_rate"`
+	Exposure         primitive.Decimal128  `bson:"exposure"`
+	Commission       primitive.Decimal128  `bson:"commission"`
+	BuyAndHoldReturn primitive.Decimal128  `bson:"buy_and_hold_return"`
+}
+
+type Backtest struct {
+	Id             primitive.ObjectID   `bson:"_id,omitempty"`
+	Ticker         string               `bson:"ticker"`
+	Strategy       string               `bson:"strategy"`
+	Adjust         string               `bson:"adjust"`
+	From           time.Time            `bson:"from"`
+	To             time.Time            `bson:"to"`
+	InitialCapital primitive.Decimal128 `bson:"initial_capital"`
+	Commission     primitive.Decimal128 `bson:"commission"`
+	Slippage       primitive.Decimal128 `bson:"slippage"`
+	Fast           int                  `bson:"fast"`
+	Slow           int                  `bson:"slow"`
+	Days           int                  `bson:"days"`
+	ExitDays       int                  `bson:"exit_days"`
+	Summary        BacktestSummary      `bson:"summary"`
+	Trades         []BacktestTrade      `bson:"trades"`
+	Equity         []EquityPoint        `bson:"equity"`
+	CreatedAt      time.Time            `bson:"created_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Fundamentals struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"`
+	Ticker    string             `bson:"ticker"`
+	Name      string             `bson:"name"`
+	Exchange  string             `bson:"exchange"`
+	Sector    string             `bson:"sector"`
+	Industry  string             `bson:"industry"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
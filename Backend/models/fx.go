@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type FXRate struct {
+	Id    primitive.ObjectID   `bson:"_id,omitempty"`
+	Base  string               `bson:"base"`
+	Quote string               `bson:"quote"`
+	Date  time.Time            `bson:"date"`
+	Rate  primitive.Decimal128 `bson:"rate"`
+}
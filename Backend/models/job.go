@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type JobError struct {
+	StatusCode int    `bson:"status_code"`
+	Message    string `bson:"message"`
+}
+
+type JobProgress struct {
+	Cursor          *time.Time `bson:"cursor"`
+	Inserted        int        `bson:"inserted"`
+	SkippedExisting int        `bson:"skipped_existing"`
+	Quarantined     int        `bson:"quarantined"`
+	FilledFrom      *time.Time `bson:"filled_from"`
+	FilledTo        *time.Time `bson:"filled_to"`
+}
+
+type Job struct {
+	Id         primitive.ObjectID `bson:"_id,omitempty"`
+	Type       string             `bson:"type"`
+	Symbol     string             `bson:"symbol"`
+	Provider   string             `bson:"provider,omitempty"`
+	Ref        string             `bson:"ref,omitempty"`
+	From       *time.Time         `bson:"from,omitempty"`
+	To         *time.Time         `bson:"to,omitempty"`
+	Status     string             `bson:"status"`
+	Attempts   int                `bson:"attempts"`
+	Error      *JobError          `bson:"error"`
+	Progress   *JobProgress       `bson:"progress,omitempty"`
+	Result     map[string]any     `bson:"result"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	StartedAt  *time.Time         `bson:"started_at"`
+	ResumeAt   *time.Time         `bson:"resume_at,omitempty"`
+	FinishedAt *time.Time         `bson:"finished_at"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Transaction struct {
+	Id          primitive.ObjectID   `bson:"_id,omitempty"`
+	PortfolioId primitive.ObjectID   `bson:"portfolio_id"`
+	Ticker      string               `bson:"ticker"`
+	Type        string               `bson:"type"`
+	Date        time.Time            `bson:"date"`
+	Quantity    primitive.Decimal128 `bson:"quantity"`
+	Price       primitive.Decimal128 `bson:"price"`
+	Amount      primitive.Decimal128 `bson:"amount"`
+	Ratio       primitive.Decimal128 `bson:"ratio"`
+	CreatedAt   time.Time            `bson:"created_at"`
+}
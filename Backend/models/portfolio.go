@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Holding struct {
+	Ticker   string               `bson:"ticker"`
+	Quantity primitive.Decimal128 `bson:"quantity"`
+}
+
+type Portfolio struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"`
+	Name      string             `bson:"name"`
+	Holdings  []Holding          `bson:"holdings"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
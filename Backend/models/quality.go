@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type QuarantinedBar struct {
+	Id         primitive.ObjectID `bson:"_id,omitempty"`
+	Bar        DailyOHLCV         `bson:"bar"`
+	Reasons    []string           `bson:"reasons"`
+	Status     string             `bson:"status"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	ReviewedAt *time.Time         `bson:"reviewed_at"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RefreshFailure struct {
+	Symbol  string `bson:"symbol"`
+	Message string `bson:"message"`
+}
+
+// Requests made to a provider on one UTC day
+type APICallCount struct {
+	Id       primitive.ObjectID `bson:"_id,omitempty"`
+	Date     time.Time          `bson:"date"`
+	Provider string             `bson:"provider"`
+	Calls    int                `bson:"calls"`
+}
+
+type SchedulerRun struct {
+	Id         primitive.ObjectID `bson:"_id,omitempty"`
+	Trigger    string             `bson:"trigger"`
+	StartedAt  time.Time          `bson:"started_at"`
+	FinishedAt time.Time          `bson:"finished_at"`
+	Budget     int                `bson:"budget"`
+	APICalls   int                `bson:"api_calls"`
+	Refreshed  []string           `bson:"refreshed"`
+	UpToDate   []string           `bson:"up_to_date"`
+	Skipped    []string           `bson:"skipped"`
+	Failures   []RefreshFailure   `bson:"failures"`
+}
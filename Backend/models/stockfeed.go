@@ -9,6 +9,8 @@ import (
 type Symbol struct {
 	Id            primitive.ObjectID `bson:"_id,omitempty"`
 	Name          string             `bson:"name"`
+	TimeZone      string             `bson:"time_zone,omitempty"`
+	Currency      string             `bson:"currency,omitempty"`
 	LastRefreshed time.Time          `bson:"last_refreshed"`
 }
 
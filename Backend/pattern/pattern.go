@@ -0,0 +1,206 @@
+package pattern
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	two                = decimal.NewFromInt(2)
+	dojiBodyRatio      = decimal.RequireFromString(constant.DojiBodyRatio)
+	longBodyRatio      = decimal.RequireFromString(constant.LongBodyRatio)
+	hammerShadowRatio  = decimal.NewFromInt(constant.HammerShadowRatio)
+	starBodyRatio      = decimal.RequireFromString(constant.StarBodyRatio)
+	soldierShadowRatio = decimal.RequireFromString(constant.SoldierShadowRatio)
+)
+
+type candle struct {
+	open, high, low, close decimal.Decimal
+}
+
+func candleOf(bar dto.DailyOHLCVRes) candle {
+	return candle{
+		open:  bar.OHLC["open"],
+		high:  bar.OHLC["high"],
+		low:   bar.OHLC["low"],
+		close: bar.OHLC["close"],
+	}
+}
+
+func (c candle) bullish() bool {
+	return c.close.GreaterThan(c.open)
+}
+
+func (c candle) bearish() bool {
+	return c.close.LessThan(c.open)
+}
+
+func (c candle) body() decimal.Decimal {
+	return c.close.Sub(c.open).Abs()
+}
+
+func (c candle) span() decimal.Decimal {
+	return c.high.Sub(c.low)
+}
+
+func (c candle) top() decimal.Decimal {
+	return decimal.Max(c.open, c.close)
+}
+
+func (c candle) bottom() decimal.Decimal {
+	return decimal.Min(c.open, c.close)
+}
+
+func (c candle) upperShadow() decimal.Decimal {
+	return c.high.Sub(c.top())
+}
+
+func (c candle) lowerShadow() decimal.Decimal {
+	return c.bottom().Sub(c.low)
+}
+
+func (c candle) long() bool {
+	return c.span().IsPositive() &&
+		c.body().GreaterThanOrEqual(c.span().Mul(longBodyRatio))
+}
+
+func (c candle) midpoint() decimal.Decimal {
+	return c.open.Add(c.close).Div(two)
+}
+
+// Patterns ending on each bar, aligned with bars and nil where none
+// formed; only the named patterns are looked for, all when names is
+// empty. Earlier bars only serve as the candles before a pattern.
+func Detect(bars []dto.DailyOHLCVRes, names []string) [][]dto.PatternRes {
+	wanted := func(name string) bool {
+		return len(names) == 0 || slices.Contains(names, name)
+	}
+	candles := make([]candle, len(bars))
+	for i, bar := range bars {
+		candles[i] = candleOf(bar)
+	}
+
+	out := make([][]dto.PatternRes, len(bars))
+	add := func(i int, name, direction string, size int) {
+		out[i] = append(out[i], dto.PatternRes{Name: name, Direction: direction, Bars: size})
+	}
+	for i, c := range candles {
+		if wanted(constant.PatternDoji) && doji(c) {
+			add(i, constant.PatternDoji, constant.DirectionNeutral, 1)
+		}
+		if wanted(constant.PatternHammer) && hammer(candles, i) {
+			add(i, constant.PatternHammer, constant.DirectionBullish, 1)
+		}
+		if i < 1 {
+			continue
+		}
+		if wanted(constant.PatternEngulfing) {
+			if direction := engulfing(candles[i-1], c); direction != "" {
+				add(i, constant.PatternEngulfing, direction, 2)
+			}
+		}
+		if wanted(constant.PatternHarami) {
+			if direction := harami(candles[i-1], c); direction != "" {
+				add(i, constant.PatternHarami, direction, 2)
+			}
+		}
+		if i < 2 {
+			continue
+		}
+		first, second := candles[i-2], candles[i-1]
+		if wanted(constant.PatternMorningStar) && morningStar(first, second, c) {
+			add(i, constant.PatternMorningStar, constant.DirectionBullish, 3)
+		}
+		if wanted(constant.PatternEveningStar) && eveningStar(first, second, c) {
+			add(i, constant.PatternEveningStar, constant.DirectionBearish, 3)
+		}
+		if wanted(constant.PatternThreeWhiteSoldiers) && threeWhiteSoldiers(first, second, c) {
+			add(i, constant.PatternThreeWhiteSoldiers, constant.DirectionBullish, 3)
+		}
+	}
+	return out
+}
+
+// Opening and closing at about the same price
+func doji(c candle) bool {
+	return c.span().IsPositive() &&
+		c.body().LessThanOrEqual(c.span().Mul(dojiBodyRatio))
+}
+
+// A small body at the top of a long lower shadow, after a decline
+func hammer(candles []candle, i int) bool {
+	c := candles[i]
+	if i <= constant.PatternTrendBars || !c.body().IsPositive() {
+		return false
+	}
+	declined := candles[i-1].close.LessThan(candles[i-1-constant.PatternTrendBars].close)
+	return declined &&
+		c.lowerShadow().GreaterThanOrEqual(c.body().Mul(hammerShadowRatio)) &&
+		c.upperShadow().LessThanOrEqual(c.body())
+}
+
+// A body wholly covering the opposite-coloured body before it
+func engulfing(prev, c candle) string {
+	if !c.body().GreaterThan(prev.body()) ||
+		c.top().LessThan(prev.top()) || c.bottom().GreaterThan(prev.bottom()) {
+		return ""
+	}
+	switch {
+	case prev.bearish() && c.bullish():
+		return constant.DirectionBullish
+	case prev.bullish() && c.bearish():
+		return constant.DirectionBearish
+	}
+	return ""
+}
+
+// A smaller opposite-coloured body inside a long body before it
+func harami(prev, c candle) string {
+	if !prev.long() || !c.body().LessThan(prev.body()) ||
+		c.top().GreaterThan(prev.top()) || c.bottom().LessThan(prev.bottom()) {
+		return ""
+	}
+	switch {
+	case prev.bearish() && c.bullish():
+		return constant.DirectionBullish
+	case prev.bullish() && c.bearish():
+		return constant.DirectionBearish
+	}
+	return ""
+}
+
+// A long fall, a small body below it, then a rise past the middle of
+// the fall
+func morningStar(first, second, c candle) bool {
+	return first.bearish() && first.long() &&
+		second.body().LessThanOrEqual(first.body().Mul(starBodyRatio)) &&
+		second.top().LessThan(first.close) &&
+		c.bullish() && c.close.GreaterThan(first.midpoint())
+}
+
+// A long rise, a small body above it, then a fall past the middle of
+// the rise
+func eveningStar(first, second, c candle) bool {
+	return first.bullish() && first.long() &&
+		second.body().LessThanOrEqual(first.body().Mul(starBodyRatio)) &&
+		second.bottom().GreaterThan(first.close) &&
+		c.bearish() && c.close.LessThan(first.midpoint())
+}
+
+// Three long rises in a row, each opening within the body before it
+// and closing near its high
+func threeWhiteSoldiers(first, second, c candle) bool {
+	soldier := func(c candle) bool {
+		return c.bullish() && c.long() &&
+			c.upperShadow().LessThanOrEqual(c.body().Mul(soldierShadowRatio))
+	}
+	follows := func(prev, c candle) bool {
+		return !c.open.LessThan(prev.open) && !c.open.GreaterThan(prev.close) &&
+			c.close.GreaterThan(prev.close)
+	}
+	return soldier(first) && soldier(second) && soldier(c) &&
+		follows(first, second) && follows(second, c)
+}
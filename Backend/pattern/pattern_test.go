@@ -0,0 +1,145 @@
+package pattern
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"fmt"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+// Bars on consecutive days from 2025-06-02, each given as
+// open, high, low and close
+func bars(candles ...[4]float64) []dto.DailyOHLCVRes {
+	start := util.Date("2025-06-02")
+	out := make([]dto.DailyOHLCVRes, len(candles))
+	for i, c := range candles {
+		out[i] = dto.DailyOHLCVRes{
+			Day: start.AddDate(0, 0, i),
+			OHLC: map[string]decimal.Decimal{
+				"open":  decimal.NewFromFloat(c[0]),
+				"high":  decimal.NewFromFloat(c[1]),
+				"low":   decimal.NewFromFloat(c[2]),
+				"close": decimal.NewFromFloat(c[3]),
+			},
+		}
+	}
+	return out
+}
+
+func TestUnitPatternDetect(t *testing.T) {
+	testCases := []struct {
+		name     string
+		bars     []dto.DailyOHLCVRes
+		names    []string
+		expected []string
+	}{
+		{
+			name:     "doji",
+			bars:     bars([4]float64{10, 11, 9, 10.05}),
+			expected: []string{"0 doji neutral 1"},
+		},
+		{
+			name: "hammer after a decline",
+			bars: bars(
+				[4]float64{21, 21.2, 19.9, 20},
+				[4]float64{20, 20.1, 18.9, 19},
+				[4]float64{19, 19.1, 17.9, 18},
+				[4]float64{18, 18.1, 16.9, 17},
+				[4]float64{16.7, 17, 15, 17},
+			),
+			expected: []string{"4 hammer bullish 1"},
+		},
+		{
+			name: "hammer shape without a decline",
+			bars: bars(
+				[4]float64{15, 15.2, 13.9, 14},
+				[4]float64{14, 14.1, 12.9, 13},
+				[4]float64{13, 13.1, 11.9, 12},
+				[4]float64{18, 18.1, 16.9, 17},
+				[4]float64{16.7, 17, 15, 17},
+			),
+			expected: []string{},
+		},
+		{
+			name: "bullish engulfing",
+			bars: bars(
+				[4]float64{10, 10.2, 9.4, 9.5},
+				[4]float64{9.4, 10.3, 9.3, 10.2},
+			),
+			expected: []string{"1 engulfing bullish 2"},
+		},
+		{
+			name: "bearish engulfing",
+			bars: bars(
+				[4]float64{9.5, 10.1, 9.4, 10},
+				[4]float64{10.1, 10.2, 9.3, 9.4},
+			),
+			expected: []string{"1 engulfing bearish 2"},
+		},
+		{
+			name: "bullish harami",
+			bars: bars(
+				[4]float64{11, 11.1, 9.9, 10},
+				[4]float64{10.2, 10.6, 10.1, 10.5},
+			),
+			expected: []string{"1 harami bullish 2"},
+		},
+		{
+			name: "morning star",
+			bars: bars(
+				[4]float64{12, 12.1, 10.9, 11},
+				[4]float64{10.8, 10.9, 10.6, 10.7},
+				[4]float64{10.8, 11.8, 10.7, 11.7},
+			),
+			expected: []string{"2 morning_star bullish 3"},
+		},
+		{
+			name: "evening star",
+			bars: bars(
+				[4]float64{11, 12.1, 10.9, 12},
+				[4]float64{12.2, 12.4, 12.1, 12.3},
+				[4]float64{12.2, 12.3, 11.2, 11.3},
+			),
+			expected: []string{"2 evening_star bearish 3"},
+		},
+		{
+			name: "three white soldiers",
+			bars: bars(
+				[4]float64{10, 11.05, 9.95, 11},
+				[4]float64{10.5, 12.05, 10.45, 12},
+				[4]float64{11.5, 13.05, 11.45, 13},
+			),
+			expected: []string{"2 three_white_soldiers bullish 3"},
+		},
+		{
+			name: "only the named patterns",
+			bars: bars(
+				[4]float64{10, 10.2, 9.4, 9.5},
+				[4]float64{9.4, 10.3, 9.3, 10.2},
+			),
+			names:    []string{constant.PatternHarami},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			output := Detect(tt.bars, tt.names)
+
+			//then
+			assert.Equal(t, len(output), len(tt.bars))
+			found := make([]string, 0)
+			for i, patterns := range output {
+				for _, p := range patterns {
+					found = append(found, fmt.Sprintf("%d %s %s %d", i, p.Name, p.Direction, p.Bars))
+				}
+			}
+			assert.Equal(t, found, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Compact output only covers the latest 100 trading days. Those reach
+// back at least 137 calendar days (20 five-day weeks) from the latest
+// session, which can be a few days old over a weekend, so only starts
+// within 130 days are sure to be in it.
+var alphaCompactDays = 130
+
+// CorporateActions requests splits and dividends separately
+var AlphaActionRequests = 2
+
+type AlphaVantage struct {
+	hc util.HttpClientItf
+}
+
+func NewAlphaVantage(hc util.HttpClientItf) *AlphaVantage {
+	return &AlphaVantage{
+		hc: hc,
+	}
+}
+
+func (av *AlphaVantage) Name() string {
+	return AlphaVantageName
+}
+
+func (av *AlphaVantage) MaxRangeDays() int {
+	return 0
+}
+
+func (av *AlphaVantage) DailyBars(symbol string, from, to dto.DateOnly) ([]dto.DailyOHLCVRes, error) {
+	outputSize := "compact"
+	if from.Before(dto.DateOnly(time.Now().UTC()).AddDate(0, 0, -alphaCompactDays)) {
+		outputSize = "full"
+	}
+
+	url := fmt.Sprintf("https://www.alphavantage.co/"+
+		"query?function=TIME_SERIES_DAILY"+
+		"&symbol=%s&outputsize=%s&apikey=%s",
+		symbol,
+		outputSize,
+		os.Getenv("ALPHA_VANTAGE_API_KEY"),
+	)
+
+	var alphaData dto.AlphaStockDataRes
+	err := av.fetch(url, &alphaData)
+	if err != nil {
+		return nil, err
+	}
+
+	bars := make([]dto.DailyOHLCVRes, 0)
+	for key, value := range alphaData.TimeSeries {
+		day, err := ParseAlphaDate(key, alphaData.MetaData.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		if !inRange(day, from, to) {
+			continue
+		}
+
+		ohlcv, err := ParseAlphaOHLCV(value)
+		if err != nil {
+			return nil, err
+		}
+		ohlcv.Day = day
+		bars = append(bars, *ohlcv)
+	}
+
+	sort.SliceStable(bars, func(i, j int) bool {
+		return bars[i].Day.Before(bars[j].Day)
+	})
+	return bars, nil
+}
+
+// Splits and dividends of the symbol, sorted by date
+func (av *AlphaVantage) CorporateActions(symbol string) ([]dto.CorporateActionRes, error) {
+	actions := make([]dto.CorporateActionRes, 0)
+
+	var splits dto.AlphaSplitsRes
+	err := av.fetch(fmt.Sprintf("https://www.alphavantage.co/"+
+		"query?function=SPLITS&symbol=%s&apikey=%s",
+		symbol,
+		os.Getenv("ALPHA_VANTAGE_API_KEY"),
+	), &splits)
+	if err != nil {
+		return nil, err
+	}
+	for _, split := range splits.Data {
+		action, err := alphaAction(symbol, constant.ActionSplit,
+			split.EffectiveDate, split.SplitFactor)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+
+	var dividends dto.AlphaDividendsRes
+	err = av.fetch(fmt.Sprintf("https://www.alphavantage.co/"+
+		"query?function=DIVIDENDS&symbol=%s&apikey=%s",
+		symbol,
+		os.Getenv("ALPHA_VANTAGE_API_KEY"),
+	), &dividends)
+	if err != nil {
+		return nil, err
+	}
+	for _, dividend := range dividends.Data {
+		action, err := alphaAction(symbol, constant.ActionDividend,
+			dividend.ExDividendDate, dividend.Amount)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+
+	sort.SliceStable(actions, func(i, j int) bool {
+		return actions[i].Date.Before(actions[j].Date)
+	})
+	return actions, nil
+}
+
+func alphaAction(symbol, actionType, date, value string) (dto.CorporateActionRes, error) {
+	day, err := ParseAlphaDate(date, "")
+	if err != nil {
+		return dto.CorporateActionRes{}, err
+	}
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return dto.CorporateActionRes{}, constant.ErrAlphaParseBody(err.Error())
+	}
+	return dto.CorporateActionRes{
+		Symbol: symbol,
+		Date:   day,
+		Type:   actionType,
+		Value:  amount,
+	}, nil
+}
+
+// Company overview of the symbol; Alpha Vantage answers unknown
+// symbols with an empty object
+func (av *AlphaVantage) Fundamentals(symbol string) (*dto.FundamentalsRes, error) {
+	var overview dto.AlphaOverviewRes
+	err := av.fetch(fmt.Sprintf("https://www.alphavantage.co/"+
+		"query?function=OVERVIEW&symbol=%s&apikey=%s",
+		symbol,
+		os.Getenv("ALPHA_VANTAGE_API_KEY"),
+	), &overview)
+	if err != nil {
+		return nil, err
+	}
+	if overview.Symbol == "" {
+		return nil, constant.ErrFundamentalsNotFound
+	}
+	return &dto.FundamentalsRes{
+		Symbol:   symbol,
+		Name:     overview.Name,
+		Exchange: overview.Exchange,
+		Sector:   overview.Sector,
+		Industry: overview.Industry,
+	}, nil
+}
+
+// Full history of daily closing rates of the pair; Alpha Vantage
+// answers unknown pairs with an error message instead of a series
+func (av *AlphaVantage) FXDaily(base, quote string) ([]dto.FXRateRes, error) {
+	var alphaData dto.AlphaFXDailyRes
+	err := av.fetch(fmt.Sprintf("https://www.alphavantage.co/"+
+		"query?function=FX_DAILY&from_symbol=%s&to_symbol=%s"+
+		"&outputsize=full&apikey=%s",
+		base,
+		quote,
+		os.Getenv("ALPHA_VANTAGE_API_KEY"),
+	), &alphaData)
+	if err != nil {
+		return nil, err
+	}
+	if len(alphaData.TimeSeries) == 0 {
+		return nil, constant.ErrUnknownFXPair
+	}
+
+	rates := make([]dto.FXRateRes, 0, len(alphaData.TimeSeries))
+	for key, value := range alphaData.TimeSeries {
+		day, err := ParseAlphaDate(key, "")
+		if err != nil {
+			return nil, err
+		}
+		text, ok := value["4. close"]
+		if !ok {
+			return nil, constant.ErrAlphaParseBody("can't find close rate as usual")
+		}
+		rate, err := decimal.NewFromString(text)
+		if err != nil {
+			return nil, constant.ErrAlphaParseBody(err.Error())
+		}
+		rates = append(rates, dto.FXRateRes{
+			Base:  base,
+			Quote: quote,
+			Date:  day,
+			Rate:  rate,
+		})
+	}
+
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].Date.Before(rates[j].Date)
+	})
+	return rates, nil
+}
+
+// Get an Alpha Vantage endpoint and unmarshal its body into out
+func (av *AlphaVantage) fetch(url string, out any) error {
+	response, err := av.hc.Get(url)
+	if err != nil {
+		return constant.ErrAlphaGet(err)
+	}
+	defer response.Body.Close()
+
+	body, err := av.hc.ReadAll(response.Body)
+	if err != nil {
+		return constant.ErrAlphaReadAll(err)
+	}
+
+	// Check for e.g. API rate limit is exceeded
+	err = AlphaUnexpectedInfo(body)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, out)
+	if err != nil {
+		return constant.ErrAlphaUnmarshal(err)
+	}
+	return nil
+}
+
+// Turn an Alpha Vantage "Information" body into an error;
+// nil if the body is something else
+func AlphaUnexpectedInfo(body []byte) error {
+	var info dto.AlphaInfo
+	err := json.Unmarshal(body, &info)
+	if err != nil {
+		return constant.ErrAlphaUnmarshal(err)
+	}
+
+	// Indicate if this is not an information-JSON body
+	if info.Info == "" {
+		return nil
+	}
+
+	// Erase any trace of my API key
+	info.Info = strings.ReplaceAll(info.Info,
+		os.Getenv("ALPHA_VANTAGE_API_KEY"), "[REDACTED]")
+
+	// Simplify exceed-API-limit message
+	if info.Info == constant.APIExceedLimit {
+		return constant.ErrAPIExceed
+	}
+	// Full output and such need a paid key
+	if strings.Contains(info.Info, constant.APIPremiumFeature) {
+		return constant.ErrAPIPremium
+	}
+
+	// For any unexpected error I have never seen before
+	return constant.NewCError(http.StatusBadGateway, info.Info)
+}
+
+// Alpha Vantage dates are local to the time zone reported in the metadata;
+// timestamps, e.g. "2025-06-13 16:00:00", are reduced to that local date
+func ParseAlphaDate(text, timezone string) (dto.DateOnly, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	layout := constant.LayoutISO
+	if len(text) > len(layout) {
+		layout = constant.LayoutISO + " 15:04:05"
+	}
+	t, err := time.ParseInLocation(layout, text, location)
+	if err != nil {
+		return dto.DateOnly{}, constant.ErrAlphaParseBody(err.Error())
+	}
+	return dto.NewDateOnly(t), nil
+}
+
+// Parse one day of an Alpha Vantage time series
+func ParseAlphaOHLCV(timeSeries map[string]string) (*dto.DailyOHLCVRes, error) {
+	var ohlcv dto.DailyOHLCVRes
+	ohlcv.OHLC = make(map[string]decimal.Decimal)
+
+	// - OHLC
+	for _, value := range []string{"1. open", "2. high",
+		"3. low", "4. close"} {
+
+		parts := strings.Split(value, " ")
+		text, ok := timeSeries[value]
+		if !ok {
+			return nil, constant.ErrAlphaParseBody(
+				fmt.Sprintf("can't find %s price as usual", parts[1]),
+			)
+		}
+
+		dec, err := decimal.NewFromString(text)
+		if err != nil {
+			return nil, constant.ErrAlphaParseBody(err.Error())
+		}
+
+		ohlcv.OHLC[parts[1]] = dec
+	}
+
+	// - Volume
+	text, ok := timeSeries["5. volume"]
+	if !ok {
+		return nil, constant.ErrAlphaParseBody(
+			"can't find volume as usual")
+	}
+	vol, err := strconv.Atoi(text)
+	if err != nil {
+		return nil, constant.ErrAlphaParseBody(err.Error())
+	}
+	ohlcv.Volume = vol
+
+	return &ohlcv, nil
+}
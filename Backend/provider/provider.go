@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"Backend/dto"
+	"Backend/util"
+)
+
+// Source of daily bars
+type ProviderItf interface {
+	Name() string
+	// Longest date range fetched with one request, in days;
+	// 0 means the whole range is always fetched at once
+	MaxRangeDays() int
+	// Daily bars dated within [from, to], sorted by date
+	DailyBars(symbol string, from, to dto.DateOnly) ([]dto.DailyOHLCVRes, error)
+}
+
+// Source of splits and dividends
+type ActionSourceItf interface {
+	// Corporate actions of the symbol, sorted by date
+	CorporateActions(symbol string) ([]dto.CorporateActionRes, error)
+}
+
+// Source of company fundamentals
+type FundamentalSourceItf interface {
+	Fundamentals(symbol string) (*dto.FundamentalsRes, error)
+}
+
+// Source of daily exchange rates
+type FXSourceItf interface {
+	// Closing rates of base in quote currency, sorted by date
+	FXDaily(base, quote string) ([]dto.FXRateRes, error)
+}
+
+var (
+	AlphaVantageName = "alphavantage"
+	StooqName        = "stooq"
+	DefaultName      = AlphaVantageName
+)
+
+// All supported providers by name
+func NewProviders(hc util.HttpClientItf) map[string]ProviderItf {
+	return map[string]ProviderItf{
+		AlphaVantageName: NewAlphaVantage(hc),
+		StooqName:        NewStooq(hc),
+	}
+}
+
+func inRange(day, from, to dto.DateOnly) bool {
+	return !day.Before(from) && !day.After(to)
+}
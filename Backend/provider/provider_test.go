@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	mocks "Backend/mocks/util"
+	"Backend/util"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func httpReturning(body string) util.HttpClientItf {
+	resp := &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+	mocked := new(mocks.HttpClientItf)
+	mocked.On("Get", mock.AnythingOfType("string")).Return(resp, nil)
+	mocked.On("ReadAll", mock.Anything).Return([]byte(body), nil)
+	return mocked
+}
+
+func TestUnitProviderStooqDailyBars(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		expectedDays   []string
+		expectedVolume int
+		expectedErr    func(error)
+	}{
+		{
+			name: "bars within range, sorted",
+			body: "Date,Open,High,Low,Close,Volume\n" +
+				"2025-06-03,10,12,9,11,1500\n" +
+				"2025-06-02,10,12,9,11,1000\n" +
+				"2025-05-30,10,12,9,11,900\n",
+			expectedDays:   []string{"2025-06-02", "2025-06-03"},
+			expectedVolume: 1000,
+			expectedErr: func(err error) {
+				assert.Equal(t, err, nil)
+			},
+		},
+		{
+			name:         "no data",
+			body:         "No data",
+			expectedDays: []string{},
+			expectedErr: func(err error) {
+				assert.Equal(t, err, nil)
+			},
+		},
+		{
+			name: "exceeded daily hits",
+			body: "Exceeded the daily hits limit",
+			expectedErr: func(err error) {
+				assert.Equal(t, errors.Is(err, constant.ErrAPIExceed), true)
+			},
+		},
+		{
+			name: "missing column",
+			body: "Date,Open,High,Low\n2025-06-02,10,12,9\n",
+			expectedErr: func(err error) {
+				expected := constant.ErrProviderParseBody(StooqName,
+					"can't find close column as usual")
+				assert.Equal(t, errors.Is(err, expected), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			st := NewStooq(httpReturning(tt.body))
+
+			//when
+			bars, err := st.DailyBars("AAPL", util.Date("2025-06-01"), util.Date("2025-06-05"))
+
+			//then
+			tt.expectedErr(err)
+			if tt.expectedDays != nil {
+				days := make([]string, len(bars))
+				for i, bar := range bars {
+					days[i] = bar.Day.String()
+				}
+				assert.Equal(t, days, tt.expectedDays)
+			}
+			if len(bars) > 0 {
+				assert.Equal(t, bars[0].Volume, tt.expectedVolume)
+				assert.Equal(t, bars[0].OHLC["close"].String(), "11")
+			}
+		})
+	}
+}
+
+func TestUnitProviderAlphaVantageDailyBars(t *testing.T) {
+	t.Setenv("ALPHA_VANTAGE_API_KEY", "_________________________")
+
+	bar := `{"1. open": "10", "2. high": "12", "3. low": "9",` +
+		`"4. close": "11", "5. volume": "1000"}`
+
+	testCases := []struct {
+		name         string
+		body         string
+		expectedDays []string
+		expectedErr  func(error)
+	}{
+		{
+			name: "bars within range, sorted",
+			body: `{"Meta Data": {"2. Symbol": "IBM", "3. Last Refreshed": "2025-06-06"},` +
+				`"Time Series (Daily)": {` +
+				`"2025-06-06": ` + bar + `,` +
+				`"2025-06-03": ` + bar + `,` +
+				`"2025-06-02": ` + bar + `}}`,
+			expectedDays: []string{"2025-06-02", "2025-06-03"},
+			expectedErr: func(err error) {
+				assert.Equal(t, err, nil)
+			},
+		},
+		{
+			name: "rate limit information",
+			body: `{"Information": "` + constant.APIExceedLimit + `"}`,
+			expectedErr: func(err error) {
+				assert.Equal(t, errors.Is(err, constant.ErrAPIExceed), true)
+			},
+		},
+		{
+			name: "full output on a free key",
+			body: `{"Information": "Thank you for using Alpha Vantage! ` +
+				`The **outputsize=full** parameter value is a premium feature ` +
+				`for the TIME_SERIES_DAILY endpoint."}`,
+			expectedErr: func(err error) {
+				assert.Equal(t, errors.Is(err, constant.ErrAPIPremium), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			av := NewAlphaVantage(httpReturning(tt.body))
+
+			//when
+			bars, err := av.DailyBars("IBM", util.Date("2025-06-01"), util.Date("2025-06-05"))
+
+			//then
+			tt.expectedErr(err)
+			if tt.expectedDays != nil {
+				days := make([]string, len(bars))
+				for i, bar := range bars {
+					days[i] = bar.Day.String()
+				}
+				assert.Equal(t, days, tt.expectedDays)
+			}
+		})
+	}
+}
+
+func TestUnitProviderStooqSymbol(t *testing.T) {
+	assert.Equal(t, stooqSymbol("AAPL"), "aapl.us")
+	assert.Equal(t, stooqSymbol("BA.LON"), "ba.lon")
+}
+
+func TestUnitProviderParseAlphaDate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		text          string
+		timezone      string
+		expectedDate  string
+		expectedError error
+	}{
+		{
+			name:         "date in exchange time zone",
+			text:         "2025-06-13",
+			timezone:     "Asia/Tokyo",
+			expectedDate: "2025-06-13",
+		},
+		{
+			name:         "timestamp keeps the local date",
+			text:         "2025-06-13 23:30:00",
+			timezone:     "US/Eastern",
+			expectedDate: "2025-06-13",
+		},
+		{
+			name:         "unknown time zone",
+			text:         "2025-06-13",
+			timezone:     "Mars/Olympus",
+			expectedDate: "2025-06-13",
+		},
+		{
+			name:          "bad date",
+			text:          "13/06/2025",
+			timezone:      "US/Eastern",
+			expectedError: constant.ErrAlphaParseBody(""),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			day, err := ParseAlphaDate(tt.text, tt.timezone)
+
+			//then
+			if tt.expectedError != nil {
+				assert.NotEqual(t, err, nil)
+				return
+			}
+			assert.Equal(t, err, nil)
+			assert.Equal(t, day.String(), tt.expectedDate)
+			assert.Equal(t, time.Time(day).Location(), time.UTC)
+		})
+	}
+}
+
+func TestUnitProviderAlphaVantageCorporateActions(t *testing.T) {
+	t.Setenv("ALPHA_VANTAGE_API_KEY", "_________________________")
+
+	testCases := []struct {
+		name            string
+		splitsBody      string
+		dividendsBody   string
+		expectedActions []string
+		expectedErr     func(error)
+	}{
+		{
+			name: "splits and dividends, sorted",
+			splitsBody: `{"symbol": "AAPL", "data": [` +
+				`{"effective_date": "2020-08-31", "split_factor": "4.0000"}]}`,
+			dividendsBody: `{"symbol": "AAPL", "data": [` +
+				`{"ex_dividend_date": "2020-11-06", "amount": "0.205"},` +
+				`{"ex_dividend_date": "2020-08-07", "amount": "0.82"}]}`,
+			expectedActions: []string{
+				"2020-08-07 dividend 0.82",
+				"2020-08-31 split 4",
+				"2020-11-06 dividend 0.205",
+			},
+			expectedErr: func(err error) {
+				assert.Equal(t, err, nil)
+			},
+		},
+		{
+			name:       "rate limit information",
+			splitsBody: `{"Information": "` + constant.APIExceedLimit + `"}`,
+			expectedErr: func(err error) {
+				assert.Equal(t, errors.Is(err, constant.ErrAPIExceed), true)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			mocked := new(mocks.HttpClientItf)
+			for function, body := range map[string]string{
+				"SPLITS":    tt.splitsBody,
+				"DIVIDENDS": tt.dividendsBody,
+			} {
+				mocked.On("Get", mock.MatchedBy(func(url string) bool {
+					return strings.Contains(url, "function="+function+"&")
+				})).Return(&http.Response{
+					StatusCode: 200,
+					Body:       io.NopCloser(strings.NewReader(body)),
+				}, nil)
+			}
+			mocked.On("ReadAll", mock.Anything).Return(io.ReadAll)
+			av := NewAlphaVantage(mocked)
+
+			//when
+			actions, err := av.CorporateActions("AAPL")
+
+			//then
+			tt.expectedErr(err)
+			if tt.expectedActions != nil {
+				got := make([]string, len(actions))
+				for i, action := range actions {
+					got[i] = action.Date.String() + " " + action.Type + " " + action.Value.String()
+				}
+				assert.Equal(t, got, tt.expectedActions)
+			}
+		})
+	}
+}
+
+func TestUnitProviderAlphaVantageFundamentals(t *testing.T) {
+	t.Setenv("ALPHA_VANTAGE_API_KEY", "_________________________")
+
+	testCases := []struct {
+		name           string
+		body           string
+		expectedSector string
+		expectedErr    error
+	}{
+		{
+			name: "company overview",
+			body: `{"Symbol": "IBM", "Name": "International Business Machines", ` +
+				`"Exchange": "NYSE", "Sector": "TECHNOLOGY", "Industry": "COMPUTER & OFFICE EQUIPMENT"}`,
+			expectedSector: "TECHNOLOGY",
+		},
+		{
+			name:        "unknown symbol",
+			body:        `{}`,
+			expectedErr: constant.ErrFundamentalsNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			av := NewAlphaVantage(httpReturning(tt.body))
+
+			//when
+			fundamentals, err := av.Fundamentals("IBM")
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, fundamentals.Symbol, "IBM")
+				assert.Equal(t, fundamentals.Sector, tt.expectedSector)
+			}
+		})
+	}
+}
+
+func TestUnitProviderAlphaVantageFXDaily(t *testing.T) {
+	t.Setenv("ALPHA_VANTAGE_API_KEY", "_________________________")
+
+	testCases := []struct {
+		name          string
+		body          string
+		expectedDates []dto.DateOnly
+		expectedRates []string
+		expectedErr   error
+	}{
+		{
+			name: "daily rates sorted by date",
+			body: `{"Meta Data": {"2. From Symbol": "GBP", "3. To Symbol": "USD"}, ` +
+				`"Time Series FX (Daily)": {` +
+				`"2024-01-03": {"1. open": "1.2700", "2. high": "1.2710", "3. low": "1.2620", "4. close": "1.2630"}, ` +
+				`"2024-01-02": {"1. open": "1.2730", "2. high": "1.2750", "3. low": "1.2690", "4. close": "1.2700"}}}`,
+			expectedDates: []dto.DateOnly{util.Date("2024-01-02"), util.Date("2024-01-03")},
+			expectedRates: []string{"1.27", "1.263"},
+		},
+		{
+			name:        "unknown pair",
+			body:        `{"Error Message": "Invalid API call."}`,
+			expectedErr: constant.ErrUnknownFXPair,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			av := NewAlphaVantage(httpReturning(tt.body))
+
+			//when
+			rates, err := av.FXDaily("GBP", "USD")
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			assert.Equal(t, len(rates), len(tt.expectedRates))
+			for i, rate := range rates {
+				assert.Equal(t, rate.Base, "GBP")
+				assert.Equal(t, rate.Quote, "USD")
+				assert.Equal(t, rate.Date, tt.expectedDates[i])
+				assert.Equal(t, rate.Rate.String(), tt.expectedRates[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Stooq serves daily bars as CSV without an API key,
+// but with its own daily hit limit
+var stooqExceedLimit = "Exceeded the daily hits limit"
+
+type Stooq struct {
+	hc util.HttpClientItf
+}
+
+func NewStooq(hc util.HttpClientItf) *Stooq {
+	return &Stooq{
+		hc: hc,
+	}
+}
+
+func (st *Stooq) Name() string {
+	return StooqName
+}
+
+func (st *Stooq) MaxRangeDays() int {
+	return 365
+}
+
+// Stooq wants lowercase tickers, with US listings suffixed by ".us"
+func stooqSymbol(symbol string) string {
+	symbol = strings.ToLower(symbol)
+	if !strings.Contains(symbol, ".") {
+		symbol += ".us"
+	}
+	return symbol
+}
+
+func (st *Stooq) DailyBars(symbol string, from, to dto.DateOnly) ([]dto.DailyOHLCVRes, error) {
+	url := fmt.Sprintf("https://stooq.com/q/d/l/"+
+		"?s=%s&d1=%s&d2=%s&i=d",
+		stooqSymbol(symbol),
+		time.Time(from).Format("20060102"),
+		time.Time(to).Format("20060102"),
+	)
+
+	response, err := st.hc.Get(url)
+	if err != nil {
+		return nil, constant.ErrProviderGet(StooqName, err)
+	}
+	defer response.Body.Close()
+
+	body, err := st.hc.ReadAll(response.Body)
+	if err != nil {
+		return nil, constant.ErrProviderReadAll(StooqName, err)
+	}
+
+	text := strings.TrimSpace(string(body))
+	if strings.Contains(text, stooqExceedLimit) {
+		return nil, constant.ErrAPIExceed
+	}
+	// An unknown symbol or an empty range
+	if text == "No data" {
+		return make([]dto.DailyOHLCVRes, 0), nil
+	}
+
+	reader := csv.NewReader(bytes.NewReader(body))
+	header, err := reader.Read()
+	if err != nil {
+		return nil, constant.ErrProviderParseBody(StooqName, err.Error())
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"date", "open", "high", "low", "close"} {
+		if _, ok := columns[name]; !ok {
+			return nil, constant.ErrProviderParseBody(StooqName,
+				fmt.Sprintf("can't find %s column as usual", name))
+		}
+	}
+
+	bars := make([]dto.DailyOHLCVRes, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, constant.ErrProviderParseBody(StooqName, err.Error())
+		}
+
+		date, err := time.Parse(constant.LayoutISO, record[columns["date"]])
+		if err != nil {
+			return nil, constant.ErrProviderParseBody(StooqName, err.Error())
+		}
+		day := dto.DateOnly(date)
+		if !inRange(day, from, to) {
+			continue
+		}
+
+		ohlcv := dto.DailyOHLCVRes{
+			Day:  day,
+			OHLC: make(map[string]decimal.Decimal),
+		}
+		for _, name := range []string{"open", "high", "low", "close"} {
+			dec, err := decimal.NewFromString(record[columns[name]])
+			if err != nil {
+				return nil, constant.ErrProviderParseBody(StooqName, err.Error())
+			}
+			ohlcv.OHLC[name] = dec
+		}
+
+		// Indices come without volume
+		if i, ok := columns["volume"]; ok && record[i] != "" {
+			vol, err := strconv.ParseFloat(record[i], 64)
+			if err != nil {
+				return nil, constant.ErrProviderParseBody(StooqName, err.Error())
+			}
+			ohlcv.Volume = int(vol)
+		}
+
+		bars = append(bars, ohlcv)
+	}
+
+	sort.SliceStable(bars, func(i, j int) bool {
+		return bars[i].Day.Before(bars[j].Day)
+	})
+	return bars, nil
+}
@@ -0,0 +1,74 @@
+package quality
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+
+	"github.com/shopspring/decimal"
+)
+
+type FlaggedBar struct {
+	Bar     dto.DailyOHLCVRes
+	Reasons []string
+}
+
+// Reasons a bar looks wrong, compared against the previous good bar
+// (nil when there is none); a zero maxJump turns off the jump check.
+// Splits among actions effective after the previous bar, up to the
+// bar's day, scale the previous close first, so split days don't jump.
+func Check(bar dto.DailyOHLCVRes, prev *dto.DailyOHLCVRes, maxJump decimal.Decimal, actions []dto.CorporateActionRes) []string {
+	reasons := make([]string, 0)
+	open, high := bar.OHLC["open"], bar.OHLC["high"]
+	low, close := bar.OHLC["low"], bar.OHLC["close"]
+
+	if high.LessThan(low) {
+		reasons = append(reasons, constant.ReasonHighBelowLow)
+	}
+	if open.LessThan(low) || open.GreaterThan(high) {
+		reasons = append(reasons, constant.ReasonOpenOutOfRange)
+	}
+	if close.LessThan(low) || close.GreaterThan(high) {
+		reasons = append(reasons, constant.ReasonCloseOutOfRange)
+	}
+	for _, price := range []decimal.Decimal{open, high, low, close} {
+		if !price.IsPositive() {
+			reasons = append(reasons, constant.ReasonBadPrice)
+			break
+		}
+	}
+	if bar.Volume <= 0 {
+		reasons = append(reasons, constant.ReasonBadVolume)
+	}
+
+	if prev != nil && maxJump.IsPositive() {
+		prevClose := prev.OHLC["close"]
+		for _, action := range actions {
+			if action.Type == constant.ActionSplit && action.Value.IsPositive() &&
+				action.Date.After(prev.Day) && !action.Date.After(bar.Day) {
+				prevClose = prevClose.Div(action.Value)
+			}
+		}
+		if prevClose.IsPositive() &&
+			close.Sub(prevClose).Abs().Div(prevClose).GreaterThan(maxJump) {
+			reasons = append(reasons, constant.ReasonPriceJump)
+		}
+	}
+	return reasons
+}
+
+// Split date-sorted bars into good ones and flagged ones;
+// each bar is compared with the last good bar before it
+func Split(bars []dto.DailyOHLCVRes, prev *dto.DailyOHLCVRes, maxJump decimal.Decimal, actions []dto.CorporateActionRes) ([]dto.DailyOHLCVRes, []FlaggedBar) {
+	good := make([]dto.DailyOHLCVRes, 0, len(bars))
+	flagged := make([]FlaggedBar, 0)
+	for _, bar := range bars {
+		reasons := Check(bar, prev, maxJump, actions)
+		if len(reasons) > 0 {
+			flagged = append(flagged, FlaggedBar{Bar: bar, Reasons: reasons})
+			continue
+		}
+		good = append(good, bar)
+		prev = &good[len(good)-1]
+	}
+	return good, flagged
+}
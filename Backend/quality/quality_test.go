@@ -0,0 +1,140 @@
+package quality
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+func bar(open, high, low, close int64, volume int) dto.DailyOHLCVRes {
+	return dto.DailyOHLCVRes{
+		OHLC: map[string]decimal.Decimal{
+			"open":  decimal.NewFromInt(open),
+			"high":  decimal.NewFromInt(high),
+			"low":   decimal.NewFromInt(low),
+			"close": decimal.NewFromInt(close),
+		},
+		Volume: volume,
+	}
+}
+
+func TestUnitQualityCheck(t *testing.T) {
+	half := decimal.RequireFromString("0.5")
+	prev := bar(100, 100, 100, 100, 1)
+	prev.Day = util.Date("2025-06-02")
+	splitDay := bar(50, 50, 50, 50, 1000)
+	splitDay.Day = util.Date("2025-06-03")
+	split := func(day string) []dto.CorporateActionRes {
+		return []dto.CorporateActionRes{
+			{Date: util.Date(day), Type: constant.ActionSplit, Value: decimal.NewFromInt(2)},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		bar             dto.DailyOHLCVRes
+		prev            *dto.DailyOHLCVRes
+		maxJump         decimal.Decimal
+		actions         []dto.CorporateActionRes
+		expectedReasons []string
+	}{
+		{
+			name:            "good bar",
+			bar:             bar(100, 110, 90, 105, 1000),
+			prev:            &prev,
+			maxJump:         half,
+			expectedReasons: []string{},
+		},
+		{
+			name:    "high below low",
+			bar:     bar(100, 90, 110, 100, 1000),
+			maxJump: half,
+			expectedReasons: []string{
+				constant.ReasonHighBelowLow,
+				constant.ReasonOpenOutOfRange,
+				constant.ReasonCloseOutOfRange,
+			},
+		},
+		{
+			name:            "open outside range",
+			bar:             bar(120, 110, 90, 100, 1000),
+			maxJump:         half,
+			expectedReasons: []string{constant.ReasonOpenOutOfRange},
+		},
+		{
+			name:            "close outside range",
+			bar:             bar(100, 110, 90, 80, 1000),
+			maxJump:         half,
+			expectedReasons: []string{constant.ReasonCloseOutOfRange},
+		},
+		{
+			name:            "zero price and volume",
+			bar:             bar(0, 0, 0, 0, 0),
+			maxJump:         half,
+			expectedReasons: []string{constant.ReasonBadPrice, constant.ReasonBadVolume},
+		},
+		{
+			name:            "jump beyond threshold",
+			bar:             bar(160, 160, 160, 160, 1000),
+			prev:            &prev,
+			maxJump:         half,
+			expectedReasons: []string{constant.ReasonPriceJump},
+		},
+		{
+			name:            "jump check turned off",
+			bar:             bar(160, 160, 160, 160, 1000),
+			prev:            &prev,
+			maxJump:         decimal.Zero,
+			expectedReasons: []string{},
+		},
+		{
+			name:            "split on the day explains the jump",
+			bar:             splitDay,
+			prev:            &prev,
+			maxJump:         decimal.RequireFromString("0.2"),
+			actions:         split("2025-06-03"),
+			expectedReasons: []string{},
+		},
+		{
+			name:            "split on another day",
+			bar:             splitDay,
+			prev:            &prev,
+			maxJump:         decimal.RequireFromString("0.2"),
+			actions:         split("2025-06-04"),
+			expectedReasons: []string{constant.ReasonPriceJump},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			reasons := Check(tt.bar, tt.prev, tt.maxJump, tt.actions)
+
+			//then
+			assert.Equal(t, reasons, tt.expectedReasons)
+		})
+	}
+}
+
+func TestUnitQualitySplit(t *testing.T) {
+	//given
+	bars := []dto.DailyOHLCVRes{
+		bar(100, 100, 100, 100, 1),
+		bar(300, 300, 300, 300, 1),
+		bar(110, 110, 110, 110, 1),
+	}
+
+	//when
+	good, flagged := Split(bars, nil, decimal.RequireFromString("0.5"), nil)
+
+	//then
+	// the spike is flagged, and the day after is compared with the last good bar
+	assert.Equal(t, len(good), 2)
+	assert.Equal(t, len(flagged), 1)
+	assert.Equal(t, flagged[0].Reasons, []string{constant.ReasonPriceJump})
+	assert.Equal(t, good[1].OHLC["close"].String(), "110")
+}
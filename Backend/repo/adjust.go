@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"Backend/dto"
+	"Backend/models"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Insert or update corporate actions, one per symbol, date and type
+func (rp *Repo) UpsertCorporateActions(ctx *gin.Context, actions []dto.CorporateActionRes) error {
+	c := ctx.Request.Context()
+
+	// BulkWrite refuses an empty batch
+	if len(actions) == 0 {
+		return nil
+	}
+
+	writes := make([]mongo.WriteModel, len(actions))
+	for i, action := range actions {
+		value, err := primitive.ParseDecimal128(action.Value.String())
+		if err != nil {
+			return err
+		}
+		writes[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{
+				"ticker": action.Symbol,
+				"date":   time.Time(action.Date),
+				"type":   action.Type,
+			}).
+			SetUpdate(bson.M{"$set": bson.M{"value": value}}).
+			SetUpsert(true)
+	}
+
+	_, err := rp.actionCollection.BulkWrite(c, writes)
+	return err
+}
+
+func (rp *Repo) CorporateActions(ctx *gin.Context, symbol string) ([]dto.CorporateActionRes, error) {
+	c := ctx.Request.Context()
+
+	results, err := rp.actionCollection.Find(c, bson.M{"ticker": symbol},
+		options.Find().SetSort(bson.D{{Key: "date", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]dto.CorporateActionRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var action models.CorporateAction
+		if err = results.Decode(&action); err != nil {
+			return nil, err
+		}
+		value, err := decimal.NewFromString(action.Value.String())
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, dto.CorporateActionRes{
+			Symbol: action.Ticker,
+			Date:   dto.DateOnly(action.Date),
+			Type:   action.Type,
+			Value:  value,
+		})
+	}
+	return actions, results.Err()
+}
@@ -0,0 +1,301 @@
+package repo
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/models"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func alertRuleRes(found *models.AlertRule) (*dto.AlertRuleRes, error) {
+	level, err := decimal.NewFromString(found.Level.String())
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := decimal.NewFromString(found.Threshold.String())
+	if err != nil {
+		return nil, err
+	}
+	return &dto.AlertRuleRes{
+		Id:          found.Id.Hex(),
+		Symbol:      found.Ticker,
+		Type:        found.Type,
+		Level:       level,
+		Threshold:   threshold,
+		Days:        found.Days,
+		Period:      found.Period,
+		WebhookURL:  found.WebhookURL,
+		LastChecked: toDate(found.LastChecked),
+		CreatedAt:   found.CreatedAt,
+	}, nil
+}
+
+func alertTriggerRes(found *models.AlertTrigger) (*dto.AlertTriggerRes, error) {
+	close, err := decimal.NewFromString(found.Close.String())
+	if err != nil {
+		return nil, err
+	}
+	value, err := decimal.NewFromString(found.Value.String())
+	if err != nil {
+		return nil, err
+	}
+	return &dto.AlertTriggerRes{
+		Id:          found.Id.Hex(),
+		RuleId:      found.RuleId.Hex(),
+		Symbol:      found.Ticker,
+		Type:        found.Type,
+		Date:        dto.DateOnly(found.Date),
+		Close:       close,
+		Value:       value,
+		Message:     found.Message,
+		WebhookURL:  found.WebhookURL,
+		Status:      found.Status,
+		Attempts:    found.Attempts,
+		LastError:   found.LastError,
+		CreatedAt:   found.CreatedAt,
+		DeliveredAt: found.DeliveredAt,
+	}, nil
+}
+
+func alertId(id string) (primitive.ObjectID, error) {
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return objectId, constant.ErrInvalidAlertId
+	}
+	return objectId, nil
+}
+
+func (rp *Repo) InsertAlertRule(ctx *gin.Context, req *dto.AlertRuleReq) (*dto.AlertRuleRes, error) {
+	c := ctx.Request.Context()
+
+	level, err := primitive.ParseDecimal128(req.Level.String())
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := primitive.ParseDecimal128(req.Threshold.String())
+	if err != nil {
+		return nil, err
+	}
+	rule := models.AlertRule{
+		Id:         primitive.NewObjectID(),
+		Ticker:     req.Symbol,
+		Type:       req.Type,
+		Level:      level,
+		Threshold:  threshold,
+		Days:       req.Days,
+		Period:     req.Period,
+		WebhookURL: req.WebhookURL,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	_, err = rp.alertCollection.InsertOne(c, rule)
+	if err != nil {
+		return nil, err
+	}
+	return alertRuleRes(&rule)
+}
+
+func (rp *Repo) AlertRules(ctx *gin.Context, req *dto.AlertRulesReq) ([]*dto.AlertRuleRes, error) {
+	c := ctx.Request.Context()
+
+	filter := bson.M{}
+	if req.Symbol != "" {
+		filter["ticker"] = req.Symbol
+	}
+	results, err := rp.alertCollection.Find(c, filter, options.Find().SetSort(
+		bson.D{{Key: "ticker", Value: 1}, {Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*dto.AlertRuleRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var found models.AlertRule
+		if err = results.Decode(&found); err != nil {
+			return nil, err
+		}
+		res, err := alertRuleRes(&found)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, res)
+	}
+	return rules, results.Err()
+}
+
+func (rp *Repo) GetAlertRule(ctx *gin.Context, req *dto.GetAlertReq) (*dto.AlertRuleRes, error) {
+	c := ctx.Request.Context()
+
+	objectId, err := alertId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var found models.AlertRule
+	err = rp.alertCollection.FindOne(c, bson.M{"_id": objectId}).Decode(&found)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, constant.ErrAlertNotFound
+		}
+		return nil, err
+	}
+	return alertRuleRes(&found)
+}
+
+// Delete a rule along with its trigger history
+func (rp *Repo) DeleteAlertRule(ctx *gin.Context, req *dto.GetAlertReq) error {
+	c := ctx.Request.Context()
+
+	objectId, err := alertId(req.Id)
+	if err != nil {
+		return err
+	}
+
+	result, err := rp.alertCollection.DeleteOne(c, bson.M{"_id": objectId})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return constant.ErrAlertNotFound
+	}
+	_, err = rp.triggerCollection.DeleteMany(c, bson.M{"rule_id": objectId})
+	return err
+}
+
+// Record the latest bar a rule was evaluated on; false when another
+// evaluation got to this bar first
+func (rp *Repo) MarkAlertChecked(ctx *gin.Context, id string, day dto.DateOnly) (bool, error) {
+	c := ctx.Request.Context()
+
+	objectId, err := alertId(id)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := rp.alertCollection.UpdateOne(c,
+		bson.M{
+			"_id": objectId,
+			"$or": bson.A{
+				bson.M{"last_checked": nil},
+				bson.M{"last_checked": bson.M{"$lt": time.Time(day)}},
+			},
+		},
+		bson.M{"$set": bson.M{"last_checked": time.Time(day)}})
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+func (rp *Repo) InsertAlertTrigger(ctx *gin.Context, req *dto.AlertTriggerRes) (*dto.AlertTriggerRes, error) {
+	c := ctx.Request.Context()
+
+	ruleId, err := alertId(req.RuleId)
+	if err != nil {
+		return nil, err
+	}
+	close, err := primitive.ParseDecimal128(req.Close.String())
+	if err != nil {
+		return nil, err
+	}
+	value, err := primitive.ParseDecimal128(req.Value.String())
+	if err != nil {
+		return nil, err
+	}
+	trigger := models.AlertTrigger{
+		Id:         primitive.NewObjectID(),
+		RuleId:     ruleId,
+		Ticker:     req.Symbol,
+		Type:       req.Type,
+		Date:       time.Time(req.Date),
+		Close:      close,
+		Value:      value,
+		Message:    req.Message,
+		WebhookURL: req.WebhookURL,
+		Status:     constant.DeliveryPending,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	_, err = rp.triggerCollection.InsertOne(c, trigger)
+	if err != nil {
+		return nil, err
+	}
+	return alertTriggerRes(&trigger)
+}
+
+// Trigger history of a rule, newest first
+func (rp *Repo) AlertTriggers(ctx *gin.Context, req *dto.GetAlertReq) ([]*dto.AlertTriggerRes, error) {
+	c := ctx.Request.Context()
+
+	objectId, err := alertId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := rp.triggerCollection.Find(c, bson.M{"rule_id": objectId},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	triggers := make([]*dto.AlertTriggerRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var found models.AlertTrigger
+		if err = results.Decode(&found); err != nil {
+			return nil, err
+		}
+		res, err := alertTriggerRes(&found)
+		if err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, res)
+	}
+	return triggers, results.Err()
+}
+
+func (rp *Repo) GetAlertTrigger(ctx *gin.Context, id string) (*dto.AlertTriggerRes, error) {
+	c := ctx.Request.Context()
+
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, constant.ErrInvalidAlertId
+	}
+
+	var found models.AlertTrigger
+	err = rp.triggerCollection.FindOne(c, bson.M{"_id": objectId}).Decode(&found)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, constant.ErrAlertNotFound
+		}
+		return nil, err
+	}
+	return alertTriggerRes(&found)
+}
+
+// Save the delivery status of a trigger
+func (rp *Repo) UpdateAlertDelivery(ctx *gin.Context, res *dto.AlertTriggerRes) error {
+	c := ctx.Request.Context()
+
+	objectId, err := primitive.ObjectIDFromHex(res.Id)
+	if err != nil {
+		return constant.ErrInvalidAlertId
+	}
+
+	_, err = rp.triggerCollection.UpdateOne(c, bson.M{"_id": objectId},
+		bson.M{"$set": bson.M{
+			"status":       res.Status,
+			"attempts":     res.Attempts,
+			"last_error":   res.LastError,
+			"delivered_at": res.DeliveredAt,
+		}})
+	return err
+}
@@ -0,0 +1,210 @@
+package repo
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/models"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// A backtest stores hundreds of amounts; these keep the conversions short.
+// The first error met sticks, and later conversions return zero values.
+type decimalCodec struct {
+	err error
+}
+
+func (dc *decimalCodec) to(d decimal.Decimal) primitive.Decimal128 {
+	if dc.err != nil {
+		return primitive.Decimal128{}
+	}
+	stored, err := primitive.ParseDecimal128(d.String())
+	dc.err = err
+	return stored
+}
+
+func (dc *decimalCodec) toPtr(d *decimal.Decimal) *primitive.Decimal128 {
+	if d == nil {
+		return nil
+	}
+	stored := dc.to(*d)
+	return &stored
+}
+
+func (dc *decimalCodec) from(stored primitive.Decimal128) decimal.Decimal {
+	if dc.err != nil {
+		return decimal.Zero
+	}
+	d, err := decimal.NewFromString(stored.String())
+	dc.err = err
+	return d
+}
+
+func (dc *decimalCodec) fromPtr(stored *primitive.Decimal128) *decimal.Decimal {
+	if stored == nil {
+		return nil
+	}
+	d := dc.from(*stored)
+	return &d
+}
+
+func backtestModel(res *dto.BacktestRes) (*models.Backtest, error) {
+	var dc decimalCodec
+	summary := res.Summary
+	backtest := &models.Backtest{
+		Id:             primitive.NewObjectID(),
+		Ticker:         res.Symbol,
+		Strategy:       res.Strategy,
+		Adjust:         res.Adjust,
+		From:           time.Time(res.From),
+		To:             time.Time(res.To),
+		InitialCapital: dc.to(res.InitialCapital),
+		Commission:     dc.to(res.Commission),
+		Slippage:       dc.to(res.Slippage),
+		Fast:           res.Fast,
+		Slow:           res.Slow,
+		Days:           res.Days,
+		ExitDays:       res.ExitDays,
+		Summary: models.BacktestSummary{
+			FinalEquity:      dc.to(summary.FinalEquity),
+			TotalReturn:      dc.to(summary.TotalReturn),
+			AnnualizedReturn: dc.to(summary.AnnualizedReturn),
+			MaxDrawdown:      dc.to(summary.MaxDrawdown.Value),
+			DrawdownPeak:     time.Time(summary.MaxDrawdown.Peak),
+			DrawdownTrough:   time.Time(summary.MaxDrawdown.Trough),
+			Sharpe:           dc.toPtr(summary.Sharpe),
+			Trades:           summary.Trades,
+			WinRate:          dc.toPtr(summary.WinRate),
+			Exposure:         dc.to(summary.Exposure),
+			Commission:       dc.to(summary.Commission),
+			BuyAndHoldReturn: dc.to(summary.BuyAndHoldReturn),
+		},
+		Trades:    make([]models.BacktestTrade, len(res.Trades)),
+		Equity:    make([]models.EquityPoint, len(res.Equity)),
+		CreatedAt: time.Now().UTC(),
+	}
+	for i, trade := range res.Trades {
+		backtest.Trades[i] = models.BacktestTrade{
+			EntryDate:  time.Time(trade.EntryDate),
+			EntryPrice: dc.to(trade.EntryPrice),
+			Quantity:   dc.to(trade.Quantity),
+			ExitDate:   toTime(trade.ExitDate),
+			ExitPrice:  dc.toPtr(trade.ExitPrice),
+			Commission: dc.to(trade.Commission),
+			PnL:        dc.to(trade.PnL),
+			Return:     dc.to(trade.Return),
+			Open:       trade.Open,
+		}
+	}
+	for i, point := range res.Equity {
+		backtest.Equity[i] = models.EquityPoint{
+			Date:     time.Time(point.Date),
+			Cash:     dc.to(point.Cash),
+			Position: dc.to(point.Position),
+			Equity:   dc.to(point.Equity),
+		}
+	}
+	return backtest, dc.err
+}
+
+func backtestRes(found *models.Backtest) (*dto.BacktestRes, error) {
+	var dc decimalCodec
+	summary := found.Summary
+	res := &dto.BacktestRes{
+		Id:             found.Id.Hex(),
+		Symbol:         found.Ticker,
+		Strategy:       found.Strategy,
+		Adjust:         found.Adjust,
+		From:           dto.DateOnly(found.From),
+		To:             dto.DateOnly(found.To),
+		InitialCapital: dc.from(found.InitialCapital),
+		Commission:     dc.from(found.Commission),
+		Slippage:       dc.from(found.Slippage),
+		Fast:           found.Fast,
+		Slow:           found.Slow,
+		Days:           found.Days,
+		ExitDays:       found.ExitDays,
+		Summary: dto.BacktestSummaryRes{
+			FinalEquity:      dc.from(summary.FinalEquity),
+			TotalReturn:      dc.from(summary.TotalReturn),
+			AnnualizedReturn: dc.from(summary.AnnualizedReturn),
+			MaxDrawdown: dto.DrawdownRes{
+				Value:  dc.from(summary.MaxDrawdown),
+				Peak:   dto.DateOnly(summary.DrawdownPeak),
+				Trough: dto.DateOnly(summary.DrawdownTrough),
+			},
+			Sharpe:           dc.fromPtr(summary.Sharpe),
+			Trades:           summary.Trades,
+			WinRate:          dc.fromPtr(summary.WinRate),
+			Exposure:         dc.from(summary.Exposure),
+			Commission:       dc.from(summary.Commission),
+			BuyAndHoldReturn: dc.from(summary.BuyAndHoldReturn),
+		},
+		Trades:    make([]dto.BacktestTradeRes, len(found.Trades)),
+		Equity:    make([]dto.EquityPointRes, len(found.Equity)),
+		CreatedAt: found.CreatedAt,
+	}
+	for i, trade := range found.Trades {
+		res.Trades[i] = dto.BacktestTradeRes{
+			EntryDate:  dto.DateOnly(trade.EntryDate),
+			EntryPrice: dc.from(trade.EntryPrice),
+			Quantity:   dc.from(trade.Quantity),
+			ExitDate:   toDate(trade.ExitDate),
+			ExitPrice:  dc.fromPtr(trade.ExitPrice),
+			Commission: dc.from(trade.Commission),
+			PnL:        dc.from(trade.PnL),
+			Return:     dc.from(trade.Return),
+			Open:       trade.Open,
+		}
+	}
+	for i, point := range found.Equity {
+		res.Equity[i] = dto.EquityPointRes{
+			Date:     dto.DateOnly(point.Date),
+			Cash:     dc.from(point.Cash),
+			Position: dc.from(point.Position),
+			Equity:   dc.from(point.Equity),
+		}
+	}
+	if dc.err != nil {
+		return nil, dc.err
+	}
+	return res, nil
+}
+
+func (rp *Repo) InsertBacktest(ctx *gin.Context, res *dto.BacktestRes) (*dto.BacktestRes, error) {
+	c := ctx.Request.Context()
+
+	backtest, err := backtestModel(res)
+	if err != nil {
+		return nil, err
+	}
+	_, err = rp.backtestCollection.InsertOne(c, backtest)
+	if err != nil {
+		return nil, err
+	}
+	return backtestRes(backtest)
+}
+
+func (rp *Repo) GetBacktest(ctx *gin.Context, req *dto.GetBacktestReq) (*dto.BacktestRes, error) {
+	c := ctx.Request.Context()
+
+	objectId, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, constant.ErrInvalidBacktestId
+	}
+
+	var found models.Backtest
+	err = rp.backtestCollection.FindOne(c, bson.M{"_id": objectId}).Decode(&found)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, constant.ErrBacktestNotFound
+		}
+		return nil, err
+	}
+	return backtestRes(&found)
+}
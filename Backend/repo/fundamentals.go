@@ -0,0 +1,76 @@
+package repo
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/models"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func fundamentalsRes(found *models.Fundamentals) dto.FundamentalsRes {
+	return dto.FundamentalsRes{
+		Symbol:    found.Ticker,
+		Name:      found.Name,
+		Exchange:  found.Exchange,
+		Sector:    found.Sector,
+		Industry:  found.Industry,
+		UpdatedAt: found.UpdatedAt,
+	}
+}
+
+// Replace the stored fundamentals of a symbol
+func (rp *Repo) UpsertFundamentals(ctx *gin.Context, res *dto.FundamentalsRes) error {
+	c := ctx.Request.Context()
+
+	_, err := rp.fundamentalsCollection.UpdateOne(c,
+		bson.M{"ticker": res.Symbol},
+		bson.M{"$set": bson.M{
+			"name":       res.Name,
+			"exchange":   res.Exchange,
+			"sector":     res.Sector,
+			"industry":   res.Industry,
+			"updated_at": res.UpdatedAt,
+		}},
+		options.Update().SetUpsert(true))
+	return err
+}
+
+func (rp *Repo) GetFundamentals(ctx *gin.Context, symbol string) (*dto.FundamentalsRes, error) {
+	c := ctx.Request.Context()
+
+	var found models.Fundamentals
+	err := rp.fundamentalsCollection.FindOne(c, bson.M{"ticker": symbol}).Decode(&found)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, constant.ErrFundamentalsNotFound
+		}
+		return nil, err
+	}
+	res := fundamentalsRes(&found)
+	return &res, nil
+}
+
+// Stored fundamentals of all symbols, by symbol
+func (rp *Repo) AllFundamentals(ctx *gin.Context) (map[string]dto.FundamentalsRes, error) {
+	c := ctx.Request.Context()
+
+	results, err := rp.fundamentalsCollection.Find(c, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	fundamentals := make(map[string]dto.FundamentalsRes)
+	defer results.Close(c)
+	for results.Next(c) {
+		var found models.Fundamentals
+		if err = results.Decode(&found); err != nil {
+			return nil, err
+		}
+		fundamentals[found.Ticker] = fundamentalsRes(&found)
+	}
+	return fundamentals, results.Err()
+}
@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"Backend/dto"
+	"Backend/models"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Store daily rates, replacing any already stored for the same day
+func (rp *Repo) UpsertFXRates(ctx *gin.Context, rates []dto.FXRateRes) error {
+	c := ctx.Request.Context()
+
+	// BulkWrite refuses an empty batch
+	if len(rates) == 0 {
+		return nil
+	}
+
+	var codec decimalCodec
+	writes := make([]mongo.WriteModel, len(rates))
+	for i, rate := range rates {
+		writes[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{
+				"base":  rate.Base,
+				"quote": rate.Quote,
+				"date":  time.Time(rate.Date),
+			}).
+			SetUpdate(bson.M{"$set": bson.M{"rate": codec.to(rate.Rate)}}).
+			SetUpsert(true)
+	}
+	if codec.err != nil {
+		return codec.err
+	}
+
+	_, err := rp.fxCollection.BulkWrite(c, writes,
+		options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// Daily rates of the pair within the optional window, sorted by date
+func (rp *Repo) FXRates(ctx *gin.Context, req *dto.FXRatesReq) ([]dto.FXRateRes, error) {
+	c := ctx.Request.Context()
+
+	filter := bson.M{"base": req.Base, "quote": req.Quote}
+	window := bson.M{}
+	if req.From != nil {
+		window["$gte"] = time.Time(*req.From)
+	}
+	if req.To != nil {
+		window["$lte"] = time.Time(*req.To)
+	}
+	if len(window) > 0 {
+		filter["date"] = window
+	}
+	results, err := rp.fxCollection.Find(c, filter,
+		options.Find().SetSort(bson.D{{Key: "date", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var codec decimalCodec
+	rates := make([]dto.FXRateRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var rate models.FXRate
+		if err = results.Decode(&rate); err != nil {
+			return nil, err
+		}
+		rates = append(rates, dto.FXRateRes{
+			Base:  rate.Base,
+			Quote: rate.Quote,
+			Date:  dto.DateOnly(rate.Date),
+			Rate:  codec.from(rate.Rate),
+		})
+	}
+	if codec.err != nil {
+		return nil, codec.err
+	}
+	return rates, results.Err()
+}
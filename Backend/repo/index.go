@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Unique indexes backing the one-symbol, one-bar-per-day guarantees
+// and one call counter per provider and day;
+// duplicates written before the index existed are reported, failing
+// the setup, and creating an index that already exists is a no-op
+func (rp *Repo) EnsureIndexes(ctx *gin.Context) error {
+	c := ctx.Request.Context()
+
+	indexes := []struct {
+		collection *mongo.Collection
+		keys       bson.D
+	}{
+		{rp.symbolCollection, bson.D{{Key: "name", Value: 1}}},
+		{rp.ohlcvCollection, bson.D{{Key: "ticker", Value: 1}, {Key: "date", Value: 1}}},
+		{rp.actionCollection, bson.D{{Key: "ticker", Value: 1}, {Key: "date", Value: 1}, {Key: "type", Value: 1}}},
+		{rp.fundamentalsCollection, bson.D{{Key: "ticker", Value: 1}}},
+		{rp.apiCallCollection, bson.D{{Key: "date", Value: 1}, {Key: "provider", Value: 1}}},
+		{rp.fxCollection, bson.D{{Key: "base", Value: 1}, {Key: "quote", Value: 1}, {Key: "date", Value: 1}}},
+	}
+	for _, index := range indexes {
+		if err := checkDuplicates(c, index.collection, index.keys); err != nil {
+			return err
+		}
+		_, err := index.collection.Indexes().CreateOne(c, mongo.IndexModel{
+			Keys:    index.keys,
+			Options: options.Index().SetUnique(true),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reports documents sharing the given keys, which a unique index can't
+// be built over; they are left for an operator to resolve rather than
+// deleted here
+func checkDuplicates(c context.Context, collection *mongo.Collection, keys bson.D) error {
+	group := bson.D{}
+	for _, key := range keys {
+		group = append(group, bson.E{Key: key.Key, Value: "$" + key.Key})
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: group},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "count", Value: bson.D{{Key: "$gt", Value: 1}}}}}},
+	}
+	cursor, err := collection.Aggregate(c, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(c)
+
+	conflicts := 0
+	examples := make([]string, 0, maxDuplicateExamples)
+	for cursor.Next(c) {
+		var duplicate struct {
+			Keys  bson.M `bson:"_id"`
+			Count int    `bson:"count"`
+		}
+		if err := cursor.Decode(&duplicate); err != nil {
+			return err
+		}
+		conflicts++
+		if len(examples) < maxDuplicateExamples {
+			examples = append(examples, fmt.Sprintf("%v (%d documents)", duplicate.Keys, duplicate.Count))
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	if conflicts > 0 {
+		return fmt.Errorf("%s has %d sets of documents sharing %v, e.g. %s; remove the extra ones",
+			collection.Name(), conflicts, keyNames(keys), strings.Join(examples, ", "))
+	}
+	return nil
+}
+
+// Duplicate sets listed by checkDuplicates before the rest are counted
+const maxDuplicateExamples = 3
+
+func keyNames(keys bson.D) []string {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = key.Key
+	}
+	return names
+}
+
+// Whether every failed write of an unordered insert was a duplicate key
+func onlyDuplicates(err error) bool {
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) {
+		return mongo.IsDuplicateKeyError(err)
+	}
+	if bulkErr.WriteConcernError != nil || len(bulkErr.WriteErrors) == 0 {
+		return false
+	}
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,276 @@
+package repo
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/models"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func toDate(t *time.Time) *dto.DateOnly {
+	if t == nil {
+		return nil
+	}
+	d := dto.DateOnly(*t)
+	return &d
+}
+
+func toTime(d *dto.DateOnly) *time.Time {
+	if d == nil {
+		return nil
+	}
+	t := time.Time(*d)
+	return &t
+}
+
+func jobRes(job *models.Job) *dto.JobRes {
+	res := &dto.JobRes{
+		Id:         job.Id.Hex(),
+		Type:       job.Type,
+		Symbol:     job.Symbol,
+		Provider:   job.Provider,
+		Ref:        job.Ref,
+		From:       toDate(job.From),
+		To:         toDate(job.To),
+		Status:     job.Status,
+		Attempts:   job.Attempts,
+		Result:     job.Result,
+		CreatedAt:  job.CreatedAt,
+		StartedAt:  job.StartedAt,
+		ResumeAt:   job.ResumeAt,
+		FinishedAt: job.FinishedAt,
+	}
+	if job.Error != nil {
+		res.Error = &dto.JobErrorRes{
+			StatusCode: job.Error.StatusCode,
+			Message:    job.Error.Message,
+		}
+	}
+	if job.Progress != nil {
+		res.Progress = &dto.JobProgressRes{
+			Cursor:          toDate(job.Progress.Cursor),
+			Inserted:        job.Progress.Inserted,
+			SkippedExisting: job.Progress.SkippedExisting,
+			Quarantined:     job.Progress.Quarantined,
+			FilledFrom:      toDate(job.Progress.FilledFrom),
+			FilledTo:        toDate(job.Progress.FilledTo),
+		}
+	}
+	return res
+}
+
+func (rp *Repo) InsertJob(ctx *gin.Context, req *dto.JobReq) (*dto.JobRes, error) {
+	c := ctx.Request.Context()
+
+	job := models.Job{
+		Id:        primitive.NewObjectID(),
+		Type:      req.Type,
+		Symbol:    req.Symbol,
+		Provider:  req.Provider,
+		Ref:       req.Ref,
+		From:      toTime(req.From),
+		To:        toTime(req.To),
+		Status:    constant.JobQueued,
+		CreatedAt: time.Now().UTC(),
+	}
+	if _, err := rp.jobCollection.InsertOne(c, job); err != nil {
+		return nil, err
+	}
+	return jobRes(&job), nil
+}
+
+func (rp *Repo) GetJob(ctx *gin.Context, req *dto.GetJobReq) (*dto.JobRes, error) {
+	c := ctx.Request.Context()
+
+	id, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, constant.ErrInvalidJobId
+	}
+
+	var job models.Job
+	err = rp.jobCollection.FindOne(c, bson.M{"_id": id}).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, constant.ErrJobNotFound
+		}
+		return nil, err
+	}
+	return jobRes(&job), nil
+}
+
+// Atomically take the oldest queued job (or waiting job that is due)
+// and mark it running; returns nil when there is nothing to do
+func (rp *Repo) ClaimJob(ctx *gin.Context) (*dto.JobRes, error) {
+	c := ctx.Request.Context()
+	now := time.Now().UTC()
+
+	var job models.Job
+	err := rp.jobCollection.FindOneAndUpdate(c,
+		bson.M{"$or": bson.A{
+			bson.M{"status": constant.JobQueued},
+			bson.M{
+				"status":    constant.JobWaiting,
+				"resume_at": bson.M{"$lte": now},
+			},
+		}},
+		bson.M{
+			"$set": bson.M{
+				"status":     constant.JobRunning,
+				"started_at": now,
+			},
+			"$inc": bson.M{"attempts": 1},
+		},
+		options.FindOneAndUpdate().
+			SetSort(bson.D{{Key: "created_at", Value: 1}}).
+			SetReturnDocument(options.After),
+	).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return jobRes(&job), nil
+}
+
+// Save the status, progress and outcome of a job
+func (rp *Repo) UpdateJob(ctx *gin.Context, res *dto.JobRes) error {
+	c := ctx.Request.Context()
+
+	id, err := primitive.ObjectIDFromHex(res.Id)
+	if err != nil {
+		return constant.ErrInvalidJobId
+	}
+
+	var jobError *models.JobError
+	if res.Error != nil {
+		jobError = &models.JobError{
+			StatusCode: res.Error.StatusCode,
+			Message:    res.Error.Message,
+		}
+	}
+
+	var progress *models.JobProgress
+	if res.Progress != nil {
+		progress = &models.JobProgress{
+			Cursor:          toTime(res.Progress.Cursor),
+			Inserted:        res.Progress.Inserted,
+			SkippedExisting: res.Progress.SkippedExisting,
+			Quarantined:     res.Progress.Quarantined,
+			FilledFrom:      toTime(res.Progress.FilledFrom),
+			FilledTo:        toTime(res.Progress.FilledTo),
+		}
+	}
+
+	_, err = rp.jobCollection.UpdateOne(c,
+		bson.M{"_id": id},
+		bson.M{"$set": bson.M{
+			"provider":    res.Provider,
+			"status":      res.Status,
+			"error":       jobError,
+			"progress":    progress,
+			"result":      res.Result,
+			"resume_at":   res.ResumeAt,
+			"finished_at": res.FinishedAt,
+		}})
+	return err
+}
+
+// Jobs left running by a previous process go back to the queue, unless
+// they have been claimed maxAttempts times already (each claim counts
+// as an attempt), which fails them with the given error so a job that
+// brings the process down isn't run forever
+func (rp *Repo) RequeueRunningJobs(ctx *gin.Context, maxAttempts int, failure *dto.JobErrorRes) (int64, error) {
+	c := ctx.Request.Context()
+
+	_, err := rp.jobCollection.UpdateMany(c,
+		bson.M{
+			"status":   constant.JobRunning,
+			"attempts": bson.M{"$gte": maxAttempts},
+		},
+		bson.M{"$set": bson.M{
+			"status": constant.JobFailed,
+			"error": models.JobError{
+				StatusCode: failure.StatusCode,
+				Message:    failure.Message,
+			},
+			"finished_at": time.Now().UTC(),
+		}})
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := rp.jobCollection.UpdateMany(c,
+		bson.M{"status": constant.JobRunning},
+		bson.M{"$set": bson.M{"status": constant.JobQueued}})
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// Queued, running or waiting job of the given type for the symbol;
+// nil when there is none
+func (rp *Repo) ActiveJob(ctx *gin.Context, jobType string, symbol string) (*dto.JobRes, error) {
+	c := ctx.Request.Context()
+
+	var job models.Job
+	err := rp.jobCollection.FindOne(c,
+		bson.M{
+			"type":   jobType,
+			"symbol": symbol,
+			"status": bson.M{"$in": bson.A{
+				constant.JobQueued,
+				constant.JobRunning,
+				constant.JobWaiting,
+			}},
+		},
+		options.FindOne().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return jobRes(&job), nil
+}
+
+// All queued, running or waiting jobs of the given type for the symbol,
+// oldest first
+func (rp *Repo) ActiveJobs(ctx *gin.Context, jobType string, symbol string) ([]*dto.JobRes, error) {
+	c := ctx.Request.Context()
+
+	results, err := rp.jobCollection.Find(c,
+		bson.M{
+			"type":   jobType,
+			"symbol": symbol,
+			"status": bson.M{"$in": bson.A{
+				constant.JobQueued,
+				constant.JobRunning,
+				constant.JobWaiting,
+			}},
+		},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*dto.JobRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var job models.Job
+		if err = results.Decode(&job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, jobRes(&job))
+	}
+	return jobs, results.Err()
+}
@@ -0,0 +1,125 @@
+package repo
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/models"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func transactionRes(found *models.Transaction) (*dto.TransactionRes, error) {
+	amounts := make([]decimal.Decimal, 4)
+	for i, stored := range []primitive.Decimal128{found.Quantity, found.Price, found.Amount, found.Ratio} {
+		amount, err := decimal.NewFromString(stored.String())
+		if err != nil {
+			return nil, err
+		}
+		amounts[i] = amount
+	}
+	return &dto.TransactionRes{
+		Id:          found.Id.Hex(),
+		PortfolioId: found.PortfolioId.Hex(),
+		Symbol:      found.Ticker,
+		Type:        found.Type,
+		Date:        dto.DateOnly(found.Date),
+		Quantity:    amounts[0],
+		Price:       amounts[1],
+		Amount:      amounts[2],
+		Ratio:       amounts[3],
+		CreatedAt:   found.CreatedAt,
+	}, nil
+}
+
+func (rp *Repo) InsertTransaction(ctx *gin.Context, req *dto.TransactionReq) (*dto.TransactionRes, error) {
+	c := ctx.Request.Context()
+
+	portfolio, err := portfolioId(req.PortfolioId)
+	if err != nil {
+		return nil, err
+	}
+	amounts := make([]primitive.Decimal128, 4)
+	for i, amount := range []decimal.Decimal{req.Quantity, req.Price, req.Amount, req.Ratio} {
+		amounts[i], err = primitive.ParseDecimal128(amount.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	transaction := models.Transaction{
+		Id:          primitive.NewObjectID(),
+		PortfolioId: portfolio,
+		Ticker:      req.Symbol,
+		Type:        req.Type,
+		Date:        time.Time(req.Date),
+		Quantity:    amounts[0],
+		Price:       amounts[1],
+		Amount:      amounts[2],
+		Ratio:       amounts[3],
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	_, err = rp.transactionCollection.InsertOne(c, transaction)
+	if err != nil {
+		return nil, err
+	}
+	return transactionRes(&transaction)
+}
+
+// Transactions of a portfolio by date, same-day ones in recorded order
+func (rp *Repo) Transactions(ctx *gin.Context, req *dto.GetPortfolioReq) ([]dto.TransactionRes, error) {
+	c := ctx.Request.Context()
+
+	portfolio, err := portfolioId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := rp.transactionCollection.Find(c, bson.M{"portfolio_id": portfolio},
+		options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]dto.TransactionRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var found models.Transaction
+		if err = results.Decode(&found); err != nil {
+			return nil, err
+		}
+		res, err := transactionRes(&found)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, *res)
+	}
+	return transactions, results.Err()
+}
+
+func (rp *Repo) DeleteTransaction(ctx *gin.Context, req *dto.GetTransactionReq) error {
+	c := ctx.Request.Context()
+
+	portfolio, err := portfolioId(req.PortfolioId)
+	if err != nil {
+		return err
+	}
+	objectId, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return constant.ErrInvalidTransactionId
+	}
+
+	result, err := rp.transactionCollection.DeleteOne(c,
+		bson.M{"_id": objectId, "portfolio_id": portfolio})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return constant.ErrTransactionNotFound
+	}
+	return nil
+}
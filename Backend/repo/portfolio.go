@@ -0,0 +1,171 @@
+package repo
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/models"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func holdingModels(holdings []dto.HoldingRes) ([]models.Holding, error) {
+	out := make([]models.Holding, len(holdings))
+	for i, holding := range holdings {
+		quantity, err := primitive.ParseDecimal128(holding.Quantity.String())
+		if err != nil {
+			return nil, err
+		}
+		out[i] = models.Holding{Ticker: holding.Symbol, Quantity: quantity}
+	}
+	return out, nil
+}
+
+func portfolioRes(found *models.Portfolio) (*dto.PortfolioRes, error) {
+	holdings := make([]dto.HoldingRes, len(found.Holdings))
+	for i, holding := range found.Holdings {
+		quantity, err := decimal.NewFromString(holding.Quantity.String())
+		if err != nil {
+			return nil, err
+		}
+		holdings[i] = dto.HoldingRes{Symbol: holding.Ticker, Quantity: quantity}
+	}
+	return &dto.PortfolioRes{
+		Id:        found.Id.Hex(),
+		Name:      found.Name,
+		Holdings:  holdings,
+		CreatedAt: found.CreatedAt,
+		UpdatedAt: found.UpdatedAt,
+	}, nil
+}
+
+func portfolioId(id string) (primitive.ObjectID, error) {
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return objectId, constant.ErrInvalidPortfolioId
+	}
+	return objectId, nil
+}
+
+func (rp *Repo) InsertPortfolio(ctx *gin.Context, req *dto.PortfolioReq) (*dto.PortfolioRes, error) {
+	c := ctx.Request.Context()
+
+	holdings, err := holdingModels(req.Holdings)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	portfolio := models.Portfolio{
+		Id:        primitive.NewObjectID(),
+		Name:      req.Name,
+		Holdings:  holdings,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	_, err = rp.portfolioCollection.InsertOne(c, portfolio)
+	if err != nil {
+		return nil, err
+	}
+	return portfolioRes(&portfolio)
+}
+
+func (rp *Repo) Portfolios(ctx *gin.Context) ([]*dto.PortfolioRes, error) {
+	c := ctx.Request.Context()
+
+	results, err := rp.portfolioCollection.Find(c, bson.M{},
+		options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	portfolios := make([]*dto.PortfolioRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var found models.Portfolio
+		if err = results.Decode(&found); err != nil {
+			return nil, err
+		}
+		res, err := portfolioRes(&found)
+		if err != nil {
+			return nil, err
+		}
+		portfolios = append(portfolios, res)
+	}
+	return portfolios, results.Err()
+}
+
+func (rp *Repo) GetPortfolio(ctx *gin.Context, req *dto.GetPortfolioReq) (*dto.PortfolioRes, error) {
+	c := ctx.Request.Context()
+
+	objectId, err := portfolioId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var found models.Portfolio
+	err = rp.portfolioCollection.FindOne(c, bson.M{"_id": objectId}).Decode(&found)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, constant.ErrPortfolioNotFound
+		}
+		return nil, err
+	}
+	return portfolioRes(&found)
+}
+
+// Replace the name and holdings of a portfolio
+func (rp *Repo) UpdatePortfolio(ctx *gin.Context, req *dto.PortfolioReq) (*dto.PortfolioRes, error) {
+	c := ctx.Request.Context()
+
+	objectId, err := portfolioId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	holdings, err := holdingModels(req.Holdings)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated models.Portfolio
+	err = rp.portfolioCollection.FindOneAndUpdate(c,
+		bson.M{"_id": objectId},
+		bson.M{"$set": bson.M{
+			"name":       req.Name,
+			"holdings":   holdings,
+			"updated_at": time.Now().UTC(),
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, constant.ErrPortfolioNotFound
+		}
+		return nil, err
+	}
+	return portfolioRes(&updated)
+}
+
+func (rp *Repo) DeletePortfolio(ctx *gin.Context, req *dto.GetPortfolioReq) error {
+	c := ctx.Request.Context()
+
+	objectId, err := portfolioId(req.Id)
+	if err != nil {
+		return err
+	}
+
+	result, err := rp.portfolioCollection.DeleteOne(c, bson.M{"_id": objectId})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return constant.ErrPortfolioNotFound
+	}
+	_, err = rp.transactionCollection.DeleteMany(c, bson.M{"portfolio_id": objectId})
+	return err
+}
@@ -0,0 +1,158 @@
+package repo
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/models"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func quarantinedRes(found *models.QuarantinedBar) (*dto.QuarantinedBarRes, error) {
+	bar, err := ohlcvRes(&found.Bar)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.QuarantinedBarRes{
+		Id:         found.Id.Hex(),
+		Symbol:     found.Bar.Ticker,
+		Bar:        bar,
+		Reasons:    found.Reasons,
+		Status:     found.Status,
+		CreatedAt:  found.CreatedAt,
+		ReviewedAt: found.ReviewedAt,
+	}, nil
+}
+
+// Latest stored bar of the symbol dated before the given day;
+// nil when there is none
+func (rp *Repo) LatestBarBefore(ctx *gin.Context, symbol string, day dto.DateOnly) (*dto.DailyOHLCVRes, error) {
+	c := ctx.Request.Context()
+
+	var found models.DailyOHLCV
+	err := rp.ohlcvCollection.FindOne(c,
+		bson.M{
+			"ticker": symbol,
+			"date":   bson.M{"$lt": time.Time(day)},
+		},
+		options.FindOne().SetSort(bson.D{{Key: "date", Value: -1}}),
+	).Decode(&found)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	bar, err := ohlcvRes(&found)
+	if err != nil {
+		return nil, err
+	}
+	return &bar, nil
+}
+
+func (rp *Repo) InsertQuarantine(ctx *gin.Context, bars []dto.QuarantinedBarRes) error {
+	c := ctx.Request.Context()
+
+	// InsertMany refuses an empty batch
+	if len(bars) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	docs := make([]any, len(bars))
+	for i, bar := range bars {
+		model, err := ohlcvModel(bar.Symbol, bar.Bar)
+		if err != nil {
+			return err
+		}
+		docs[i] = models.QuarantinedBar{
+			Id:        primitive.NewObjectID(),
+			Bar:       model,
+			Reasons:   bar.Reasons,
+			Status:    constant.QuarantinePending,
+			CreatedAt: now,
+		}
+	}
+
+	_, err := rp.quarantineCollection.InsertMany(c, docs)
+	return err
+}
+
+func (rp *Repo) QuarantinedBars(ctx *gin.Context, req *dto.QuarantineReq) ([]*dto.QuarantinedBarRes, error) {
+	c := ctx.Request.Context()
+
+	filter := bson.M{"status": req.Status}
+	if req.Symbol != "" {
+		filter["bar.ticker"] = req.Symbol
+	}
+	results, err := rp.quarantineCollection.Find(c, filter, options.Find().SetSort(
+		bson.D{{Key: "bar.ticker", Value: 1}, {Key: "bar.date", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	bars := make([]*dto.QuarantinedBarRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var found models.QuarantinedBar
+		if err = results.Decode(&found); err != nil {
+			return nil, err
+		}
+		res, err := quarantinedRes(&found)
+		if err != nil {
+			return nil, err
+		}
+		bars = append(bars, res)
+	}
+	return bars, results.Err()
+}
+
+func (rp *Repo) GetQuarantined(ctx *gin.Context, id string) (*dto.QuarantinedBarRes, error) {
+	c := ctx.Request.Context()
+
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, constant.ErrInvalidQuarantineId
+	}
+
+	var found models.QuarantinedBar
+	err = rp.quarantineCollection.FindOne(c, bson.M{"_id": objectId}).Decode(&found)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, constant.ErrQuarantineNotFound
+		}
+		return nil, err
+	}
+	return quarantinedRes(&found)
+}
+
+// Move a pending bar to the given review status;
+// only one reviewer can win when two review it at once
+func (rp *Repo) ReviewQuarantined(ctx *gin.Context, id string, status string) error {
+	c := ctx.Request.Context()
+
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return constant.ErrInvalidQuarantineId
+	}
+
+	result, err := rp.quarantineCollection.UpdateOne(c,
+		bson.M{"_id": objectId, "status": constant.QuarantinePending},
+		bson.M{"$set": bson.M{
+			"status":      status,
+			"reviewed_at": time.Now().UTC(),
+		}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return constant.ErrQuarantineReviewed
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"Backend/configs"
+	"Backend/constant"
 	"Backend/dto"
 	"Backend/models"
 	"time"
@@ -19,20 +20,173 @@ type RepoItf interface {
 	InsertNewSymbolData(*gin.Context, *dto.DataPerSymbol) error
 	DeleteSymbol(*gin.Context, *dto.DeleteSymbolReq) error
 	StoredData(*gin.Context) ([]dto.DataPerSymbol, error)
+
+	// Refreshing tracked symbols
+	TrackedSymbols(*gin.Context) ([]dto.SymbolDataMeta, error)
+	GetSymbol(*gin.Context, string) (*dto.SymbolDataMeta, error)
+	InsertBars(*gin.Context, *dto.DataPerSymbol) error
+	UpdateLastRefreshed(*gin.Context, *dto.SymbolDataMeta) error
+	BarDates(*gin.Context, string) ([]dto.DateOnly, error)
+	SymbolBars(*gin.Context, string) ([]dto.DailyOHLCVRes, error)
+
+	// Scheduler run history
+	InsertSchedulerRun(*gin.Context, *dto.SchedulerRunRes) error
+	SchedulerRuns(*gin.Context, int64) ([]*dto.SchedulerRunRes, error)
+	AddAPICalls(*gin.Context, string, int) error
+	APICallsSince(*gin.Context, string, time.Time) (int, error)
+
+	// Collection and backfill jobs
+	InsertJob(*gin.Context, *dto.JobReq) (*dto.JobRes, error)
+	GetJob(*gin.Context, *dto.GetJobReq) (*dto.JobRes, error)
+	ClaimJob(*gin.Context) (*dto.JobRes, error)
+	UpdateJob(*gin.Context, *dto.JobRes) error
+	RequeueRunningJobs(*gin.Context, int, *dto.JobErrorRes) (int64, error)
+	ActiveJob(*gin.Context, string, string) (*dto.JobRes, error)
+	ActiveJobs(*gin.Context, string, string) ([]*dto.JobRes, error)
+
+	// Data-quality quarantine
+	LatestBarBefore(*gin.Context, string, dto.DateOnly) (*dto.DailyOHLCVRes, error)
+	InsertQuarantine(*gin.Context, []dto.QuarantinedBarRes) error
+	QuarantinedBars(*gin.Context, *dto.QuarantineReq) ([]*dto.QuarantinedBarRes, error)
+	GetQuarantined(*gin.Context, string) (*dto.QuarantinedBarRes, error)
+	ReviewQuarantined(*gin.Context, string, string) error
+
+	// Corporate actions
+	UpsertCorporateActions(*gin.Context, []dto.CorporateActionRes) error
+	CorporateActions(*gin.Context, string) ([]dto.CorporateActionRes, error)
+
+	// Portfolios
+	InsertPortfolio(*gin.Context, *dto.PortfolioReq) (*dto.PortfolioRes, error)
+	Portfolios(*gin.Context) ([]*dto.PortfolioRes, error)
+	GetPortfolio(*gin.Context, *dto.GetPortfolioReq) (*dto.PortfolioRes, error)
+	UpdatePortfolio(*gin.Context, *dto.PortfolioReq) (*dto.PortfolioRes, error)
+	DeletePortfolio(*gin.Context, *dto.GetPortfolioReq) error
+
+	// Portfolio transaction ledger
+	InsertTransaction(*gin.Context, *dto.TransactionReq) (*dto.TransactionRes, error)
+	Transactions(*gin.Context, *dto.GetPortfolioReq) ([]dto.TransactionRes, error)
+	DeleteTransaction(*gin.Context, *dto.GetTransactionReq) error
+
+	// Alert rules and their triggers
+	InsertAlertRule(*gin.Context, *dto.AlertRuleReq) (*dto.AlertRuleRes, error)
+	AlertRules(*gin.Context, *dto.AlertRulesReq) ([]*dto.AlertRuleRes, error)
+	GetAlertRule(*gin.Context, *dto.GetAlertReq) (*dto.AlertRuleRes, error)
+	DeleteAlertRule(*gin.Context, *dto.GetAlertReq) error
+	MarkAlertChecked(*gin.Context, string, dto.DateOnly) (bool, error)
+	InsertAlertTrigger(*gin.Context, *dto.AlertTriggerRes) (*dto.AlertTriggerRes, error)
+	AlertTriggers(*gin.Context, *dto.GetAlertReq) ([]*dto.AlertTriggerRes, error)
+	GetAlertTrigger(*gin.Context, string) (*dto.AlertTriggerRes, error)
+	UpdateAlertDelivery(*gin.Context, *dto.AlertTriggerRes) error
+
+	// Backtests
+	InsertBacktest(*gin.Context, *dto.BacktestRes) (*dto.BacktestRes, error)
+	GetBacktest(*gin.Context, *dto.GetBacktestReq) (*dto.BacktestRes, error)
+
+	// Fundamentals
+	UpsertFundamentals(*gin.Context, *dto.FundamentalsRes) error
+	GetFundamentals(*gin.Context, string) (*dto.FundamentalsRes, error)
+	AllFundamentals(*gin.Context) (map[string]dto.FundamentalsRes, error)
+
+	// FX daily rates
+	UpsertFXRates(*gin.Context, []dto.FXRateRes) error
+	FXRates(*gin.Context, *dto.FXRatesReq) ([]dto.FXRateRes, error)
 }
 
 type Repo struct {
-	symbolCollection *mongo.Collection
-	ohlcvCollection  *mongo.Collection
+	symbolCollection       *mongo.Collection
+	ohlcvCollection        *mongo.Collection
+	runCollection          *mongo.Collection
+	apiCallCollection      *mongo.Collection
+	jobCollection          *mongo.Collection
+	quarantineCollection   *mongo.Collection
+	actionCollection       *mongo.Collection
+	portfolioCollection    *mongo.Collection
+	transactionCollection  *mongo.Collection
+	alertCollection        *mongo.Collection
+	triggerCollection      *mongo.Collection
+	backtestCollection     *mongo.Collection
+	fundamentalsCollection *mongo.Collection
+	fxCollection           *mongo.Collection
 }
 
 func NewRepo() *Repo {
 	return &Repo{
-		symbolCollection: configs.GetCollection(configs.DB, "symbols"),
-		ohlcvCollection:  configs.GetCollection(configs.DB, "daily_ohlcv"),
+		symbolCollection:       configs.GetCollection(configs.DB, "symbols"),
+		ohlcvCollection:        configs.GetCollection(configs.DB, "daily_ohlcv"),
+		runCollection:          configs.GetCollection(configs.DB, "scheduler_runs"),
+		apiCallCollection:      configs.GetCollection(configs.DB, "api_calls"),
+		jobCollection:          configs.GetCollection(configs.DB, "jobs"),
+		quarantineCollection:   configs.GetCollection(configs.DB, "quarantine"),
+		actionCollection:       configs.GetCollection(configs.DB, "corporate_actions"),
+		portfolioCollection:    configs.GetCollection(configs.DB, "portfolios"),
+		transactionCollection:  configs.GetCollection(configs.DB, "transactions"),
+		alertCollection:        configs.GetCollection(configs.DB, "alert_rules"),
+		triggerCollection:      configs.GetCollection(configs.DB, "alert_triggers"),
+		backtestCollection:     configs.GetCollection(configs.DB, "backtests"),
+		fundamentalsCollection: configs.GetCollection(configs.DB, "fundamentals"),
+		fxCollection:           configs.GetCollection(configs.DB, "fx_rates"),
 	}
 }
 
+// Stored form of a bar, with prices as Decimal128
+func ohlcvModel(symbol string, ohlcv dto.DailyOHLCVRes) (models.DailyOHLCV, error) {
+	openPrice, err := primitive.ParseDecimal128(ohlcv.OHLC["open"].String())
+	if err != nil {
+		return models.DailyOHLCV{}, err
+	}
+	highPrice, err := primitive.ParseDecimal128(ohlcv.OHLC["high"].String())
+	if err != nil {
+		return models.DailyOHLCV{}, err
+	}
+	lowPrice, err := primitive.ParseDecimal128(ohlcv.OHLC["low"].String())
+	if err != nil {
+		return models.DailyOHLCV{}, err
+	}
+	closePrice, err := primitive.ParseDecimal128(ohlcv.OHLC["close"].String())
+	if err != nil {
+		return models.DailyOHLCV{}, err
+	}
+	return models.DailyOHLCV{
+		Date:       time.Time(ohlcv.Day),
+		Ticker:     symbol,
+		OpenPrice:  openPrice,
+		HighPrice:  highPrice,
+		LowPrice:   lowPrice,
+		ClosePrice: closePrice,
+		Volume:     int64(ohlcv.Volume),
+	}, nil
+}
+
+func ohlcvRes(ohlcv *models.DailyOHLCV) (dto.DailyOHLCVRes, error) {
+	open, err := decimal.NewFromString(ohlcv.OpenPrice.String())
+	if err != nil {
+		return dto.DailyOHLCVRes{}, err
+	}
+	high, err := decimal.NewFromString(ohlcv.HighPrice.String())
+	if err != nil {
+		return dto.DailyOHLCVRes{}, err
+	}
+	low, err := decimal.NewFromString(ohlcv.LowPrice.String())
+	if err != nil {
+		return dto.DailyOHLCVRes{}, err
+	}
+	close, err := decimal.NewFromString(ohlcv.ClosePrice.String())
+	if err != nil {
+		return dto.DailyOHLCVRes{}, err
+	}
+
+	return dto.DailyOHLCVRes{
+		Day: dto.DateOnly(ohlcv.Date),
+		OHLC: map[string]decimal.Decimal{
+			"open":  open,
+			"high":  high,
+			"low":   low,
+			"close": close,
+		},
+		Volume: int(ohlcv.Volume),
+	}, nil
+}
+
 func (rp *Repo) CheckSymbolExists(ctx *gin.Context, req *dto.CollectSymbolReq) (bool, error) {
 	c := ctx.Request.Context()
 
@@ -54,43 +208,45 @@ func (rp *Repo) InsertNewSymbolData(ctx *gin.Context, data *dto.DataPerSymbol) e
 	if _, err := rp.symbolCollection.InsertOne(c, models.Symbol{
 		Id:            primitive.NewObjectID(),
 		Name:          data.MetaData.Symbol,
+		TimeZone:      data.MetaData.TimeZone,
+		Currency:      data.MetaData.Currency,
 		LastRefreshed: time.Time(data.MetaData.LastRefreshed),
 	}); err != nil {
+		// Another collection of the symbol got there first
+		if mongo.IsDuplicateKeyError(err) {
+			return constant.ErrStockAlready
+		}
 		return err
 	}
 
 	// Insert time-series data
+	return rp.InsertBars(ctx, data)
+}
+
+func (rp *Repo) InsertBars(ctx *gin.Context, data *dto.DataPerSymbol) error {
+	c := ctx.Request.Context()
+
+	// InsertMany refuses an empty batch
+	if len(data.TimeSeries) == 0 {
+		return nil
+	}
+
 	timeSeries := make([]any, len(data.TimeSeries))
 	for i, ohlcv := range data.TimeSeries {
-		openPrice, err := primitive.ParseDecimal128(ohlcv.OHLC["open"].String())
-		if err != nil {
-			return err
-		}
-		highPrice, err := primitive.ParseDecimal128(ohlcv.OHLC["high"].String())
-		if err != nil {
-			return err
-		}
-		lowPrice, err := primitive.ParseDecimal128(ohlcv.OHLC["low"].String())
-		if err != nil {
-			return err
-		}
-		closePrice, err := primitive.ParseDecimal128(ohlcv.OHLC["close"].String())
+		model, err := ohlcvModel(data.MetaData.Symbol, ohlcv)
 		if err != nil {
 			return err
 		}
-		timeSeries[i] = models.DailyOHLCV{
-			Date:       time.Time(ohlcv.Day),
-			Ticker:     data.MetaData.Symbol,
-			OpenPrice:  openPrice,
-			HighPrice:  highPrice,
-			LowPrice:   lowPrice,
-			ClosePrice: closePrice,
-			Volume:     int64(ohlcv.Volume),
-		}
+		timeSeries[i] = model
 	}
 
-	_, err := rp.ohlcvCollection.InsertMany(ctx, timeSeries)
-	return err
+	// Bars stored in the meantime are skipped, not failed on
+	_, err := rp.ohlcvCollection.InsertMany(c, timeSeries,
+		options.InsertMany().SetOrdered(false))
+	if err != nil && !onlyDuplicates(err) {
+		return err
+	}
+	return nil
 }
 
 func (rp *Repo) DeleteSymbol(ctx *gin.Context, req *dto.DeleteSymbolReq) error {
@@ -98,7 +254,22 @@ func (rp *Repo) DeleteSymbol(ctx *gin.Context, req *dto.DeleteSymbolReq) error {
 	if _, err := rp.symbolCollection.DeleteOne(c, bson.M{"name": bson.M{"$eq": req.Symbol}}); err != nil {
 		return err
 	}
-	_, err := rp.ohlcvCollection.DeleteMany(c, bson.M{"ticker": bson.M{"$eq": req.Symbol}})
+	if _, err := rp.ohlcvCollection.DeleteMany(c, bson.M{"ticker": bson.M{"$eq": req.Symbol}}); err != nil {
+		return err
+	}
+	if _, err := rp.quarantineCollection.DeleteMany(c, bson.M{"bar.ticker": bson.M{"$eq": req.Symbol}}); err != nil {
+		return err
+	}
+	if _, err := rp.actionCollection.DeleteMany(c, bson.M{"ticker": bson.M{"$eq": req.Symbol}}); err != nil {
+		return err
+	}
+	if _, err := rp.alertCollection.DeleteMany(c, bson.M{"ticker": bson.M{"$eq": req.Symbol}}); err != nil {
+		return err
+	}
+	if _, err := rp.triggerCollection.DeleteMany(c, bson.M{"ticker": bson.M{"$eq": req.Symbol}}); err != nil {
+		return err
+	}
+	_, err := rp.fundamentalsCollection.DeleteMany(c, bson.M{"ticker": bson.M{"$eq": req.Symbol}})
 	return err
 }
 
@@ -112,15 +283,21 @@ func (rp *Repo) StoredData(ctx *gin.Context) ([]dto.DataPerSymbol, error) {
 	}
 
 	data := make([]dto.DataPerSymbol, 0)
+	// Index of each symbol in data; a symbol may have no bars at all,
+	// e.g. when every one of them is quarantined
+	indexes := make(map[string]int)
 	defer results.Close(c)
 	for results.Next(c) {
 		var symbol models.Symbol
 		if err = results.Decode(&symbol); err != nil {
 			return nil, err
 		}
+		indexes[symbol.Name] = len(data)
 		data = append(data, dto.DataPerSymbol{
 			MetaData: &dto.SymbolDataMeta{
 				Symbol:        symbol.Name,
+				TimeZone:      symbol.TimeZone,
+				Currency:      symbol.Currency,
 				LastRefreshed: dto.DateOnly(symbol.LastRefreshed)},
 		})
 	}
@@ -131,7 +308,6 @@ func (rp *Repo) StoredData(ctx *gin.Context) ([]dto.DataPerSymbol, error) {
 		return nil, err
 	}
 
-	ix := 0
 	defer results.Close(c)
 	for results.Next(c) {
 		var ohlcv models.DailyOHLCV
@@ -139,45 +315,240 @@ func (rp *Repo) StoredData(ctx *gin.Context) ([]dto.DataPerSymbol, error) {
 			return nil, err
 		}
 
-		open, err := decimal.NewFromString(ohlcv.OpenPrice.String())
+		res, err := ohlcvRes(&ohlcv)
 		if err != nil {
 			return nil, err
 		}
-		high, err := decimal.NewFromString(ohlcv.HighPrice.String())
-		if err != nil {
-			return nil, err
+
+		// Bars left behind by a symbol no longer recorded are skipped
+		ix, ok := indexes[ohlcv.Ticker]
+		if !ok {
+			continue
 		}
-		low, err := decimal.NewFromString(ohlcv.LowPrice.String())
-		if err != nil {
+		data[ix].TimeSeries = append(data[ix].TimeSeries, res)
+	}
+
+	// Remember to figure out number of data for each stock
+	for _, datum := range data {
+		datum.MetaData.Size = len(datum.TimeSeries)
+	}
+
+	return data, nil
+}
+
+func (rp *Repo) TrackedSymbols(ctx *gin.Context) ([]dto.SymbolDataMeta, error) {
+	c := ctx.Request.Context()
+
+	// Stalest symbols first
+	results, err := rp.symbolCollection.Find(c, bson.D{}, options.Find().SetSort(
+		bson.D{{Key: "last_refreshed", Value: 1}, {Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make([]dto.SymbolDataMeta, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var symbol models.Symbol
+		if err = results.Decode(&symbol); err != nil {
 			return nil, err
 		}
-		close, err := decimal.NewFromString(ohlcv.ClosePrice.String())
-		if err != nil {
+		symbols = append(symbols, dto.SymbolDataMeta{
+			Symbol:        symbol.Name,
+			TimeZone:      symbol.TimeZone,
+			Currency:      symbol.Currency,
+			LastRefreshed: dto.DateOnly(symbol.LastRefreshed),
+		})
+	}
+	return symbols, results.Err()
+}
+
+func (rp *Repo) UpdateLastRefreshed(ctx *gin.Context, meta *dto.SymbolDataMeta) error {
+	c := ctx.Request.Context()
+	_, err := rp.symbolCollection.UpdateOne(c,
+		bson.M{"name": bson.M{"$eq": meta.Symbol}},
+		bson.M{"$set": bson.M{"last_refreshed": time.Time(meta.LastRefreshed)}})
+	return err
+}
+
+func (rp *Repo) InsertSchedulerRun(ctx *gin.Context, run *dto.SchedulerRunRes) error {
+	c := ctx.Request.Context()
+
+	failures := make([]models.RefreshFailure, len(run.Failures))
+	for i, failure := range run.Failures {
+		failures[i] = models.RefreshFailure{
+			Symbol:  failure.Symbol,
+			Message: failure.Message,
+		}
+	}
+
+	_, err := rp.runCollection.InsertOne(c, models.SchedulerRun{
+		Id:         primitive.NewObjectID(),
+		Trigger:    run.Trigger,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+		Budget:     run.Budget,
+		APICalls:   run.APICalls,
+		Refreshed:  run.Refreshed,
+		UpToDate:   run.UpToDate,
+		Skipped:    run.Skipped,
+		Failures:   failures,
+	})
+	return err
+}
+
+func (rp *Repo) SchedulerRuns(ctx *gin.Context, limit int64) ([]*dto.SchedulerRunRes, error) {
+	c := ctx.Request.Context()
+
+	// Most recent runs first
+	results, err := rp.runCollection.Find(c, bson.D{}, options.Find().SetSort(
+		bson.D{{Key: "started_at", Value: -1}}).SetLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*dto.SchedulerRunRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var run models.SchedulerRun
+		if err = results.Decode(&run); err != nil {
 			return nil, err
 		}
 
-		res := dto.DailyOHLCVRes{
-			Day: dto.DateOnly(ohlcv.Date),
-			OHLC: map[string]decimal.Decimal{
-				"open":  open,
-				"high":  high,
-				"low":   low,
-				"close": close,
-			},
-			Volume: int(ohlcv.Volume),
+		failures := make([]dto.RefreshFailure, len(run.Failures))
+		for i, failure := range run.Failures {
+			failures[i] = dto.RefreshFailure{
+				Symbol:  failure.Symbol,
+				Message: failure.Message,
+			}
 		}
 
-		if data[ix].MetaData.Symbol != ohlcv.Ticker {
-			ix++
+		runs = append(runs, &dto.SchedulerRunRes{
+			Trigger:    run.Trigger,
+			StartedAt:  run.StartedAt,
+			FinishedAt: run.FinishedAt,
+			Budget:     run.Budget,
+			APICalls:   run.APICalls,
+			Refreshed:  run.Refreshed,
+			UpToDate:   run.UpToDate,
+			Skipped:    run.Skipped,
+			Failures:   failures,
+		})
+	}
+	return runs, results.Err()
+}
+
+// Count requests made to the provider today (UTC)
+func (rp *Repo) AddAPICalls(ctx *gin.Context, provider string, calls int) error {
+	c := ctx.Request.Context()
+	_, err := rp.apiCallCollection.UpdateOne(c,
+		bson.M{
+			"date":     time.Now().UTC().Truncate(24 * time.Hour),
+			"provider": provider,
+		},
+		bson.M{"$inc": bson.M{"calls": calls}},
+		options.Update().SetUpsert(true))
+	return err
+}
+
+// Requests made to the provider on the days from since on,
+// by any endpoint, job or scheduler run
+func (rp *Repo) APICallsSince(ctx *gin.Context, provider string, since time.Time) (int, error) {
+	c := ctx.Request.Context()
+
+	results, err := rp.apiCallCollection.Aggregate(c, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"provider": provider,
+			"date":     bson.M{"$gte": since},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   nil,
+			"total": bson.M{"$sum": "$calls"},
+		}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total struct {
+		Total int `bson:"total"`
+	}
+	defer results.Close(c)
+	if results.Next(c) {
+		if err = results.Decode(&total); err != nil {
+			return 0, err
 		}
+	}
+	return total.Total, results.Err()
+}
 
-		data[ix].TimeSeries = append(data[ix].TimeSeries, res)
+// Dates of all stored bars of a symbol, oldest first
+func (rp *Repo) BarDates(ctx *gin.Context, symbol string) ([]dto.DateOnly, error) {
+	c := ctx.Request.Context()
+
+	results, err := rp.ohlcvCollection.Find(c,
+		bson.M{"ticker": bson.M{"$eq": symbol}},
+		options.Find().
+			SetSort(bson.D{{Key: "date", Value: 1}}).
+			SetProjection(bson.M{"date": 1}))
+	if err != nil {
+		return nil, err
 	}
 
-	// Remember to figure out number of data for each stock
-	for _, datum := range data {
-		datum.MetaData.Size = len(datum.TimeSeries)
+	dates := make([]dto.DateOnly, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var ohlcv models.DailyOHLCV
+		if err = results.Decode(&ohlcv); err != nil {
+			return nil, err
+		}
+		dates = append(dates, dto.DateOnly(ohlcv.Date))
 	}
+	return dates, results.Err()
+}
 
-	return data, nil
+// All stored bars of the symbol, sorted by date
+func (rp *Repo) SymbolBars(ctx *gin.Context, symbol string) ([]dto.DailyOHLCVRes, error) {
+	c := ctx.Request.Context()
+
+	results, err := rp.ohlcvCollection.Find(c,
+		bson.M{"ticker": bson.M{"$eq": symbol}},
+		options.Find().SetSort(bson.D{{Key: "date", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	bars := make([]dto.DailyOHLCVRes, 0)
+	defer results.Close(c)
+	for results.Next(c) {
+		var ohlcv models.DailyOHLCV
+		if err = results.Decode(&ohlcv); err != nil {
+			return nil, err
+		}
+		bar, err := ohlcvRes(&ohlcv)
+		if err != nil {
+			return nil, err
+		}
+		bars = append(bars, bar)
+	}
+	return bars, results.Err()
+}
+
+func (rp *Repo) GetSymbol(ctx *gin.Context, symbol string) (*dto.SymbolDataMeta, error) {
+	c := ctx.Request.Context()
+
+	var found models.Symbol
+	err := rp.symbolCollection.FindOne(c, bson.M{"name": symbol}).Decode(&found)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, constant.ErrSymbolNotTracked
+		}
+		return nil, err
+	}
+	return &dto.SymbolDataMeta{
+		Symbol:        found.Name,
+		TimeZone:      found.TimeZone,
+		Currency:      found.Currency,
+		LastRefreshed: dto.DateOnly(found.LastRefreshed),
+	}, nil
 }
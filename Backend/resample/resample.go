@@ -0,0 +1,74 @@
+package resample
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func ValidInterval(interval string) bool {
+	_, ok := constant.PeriodsPerYear[interval]
+	return ok
+}
+
+// First calendar day of the period holding the date; weeks begin on
+// the exchange's first weekday
+func PeriodStart(day dto.DateOnly, interval string, weekStart time.Weekday) dto.DateOnly {
+	t := time.Time(day)
+	switch interval {
+	case constant.IntervalWeek:
+		back := (int(t.Weekday()) - int(weekStart) + 7) % 7
+		return day.AddDate(0, 0, -back)
+	case constant.IntervalMonth:
+		return dto.DateOnly(time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()))
+	case constant.IntervalQuarter:
+		month := time.Month((int(t.Month())-1)/3*3 + 1)
+		return dto.DateOnly(time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location()))
+	case constant.IntervalYear:
+		return dto.DateOnly(time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location()))
+	}
+	return day
+}
+
+// Aggregate date-sorted daily bars into one bar per period: first
+// open, highest high, lowest low, last close and summed volume. Prices
+// a daily bar lacks are skipped rather than taken as zero.
+func Bars(bars []dto.DailyOHLCVRes, interval string, weekStart time.Weekday) []dto.BarRes {
+	out := make([]dto.BarRes, 0)
+	for _, bar := range bars {
+		period := PeriodStart(bar.Day, interval, weekStart)
+		if len(out) == 0 || period.After(out[len(out)-1].Period) {
+			out = append(out, dto.BarRes{
+				Period: period,
+				Start:  bar.Day,
+				OHLC:   make(map[string]decimal.Decimal, len(bar.OHLC)),
+			})
+		}
+		agg := &out[len(out)-1]
+		agg.End = bar.Day
+		agg.Sessions++
+		agg.Volume += bar.Volume
+
+		if open, ok := bar.OHLC["open"]; ok {
+			if _, seen := agg.OHLC["open"]; !seen {
+				agg.OHLC["open"] = open
+			}
+		}
+		if high, ok := bar.OHLC["high"]; ok {
+			if seen, ok := agg.OHLC["high"]; !ok || high.GreaterThan(seen) {
+				agg.OHLC["high"] = high
+			}
+		}
+		if low, ok := bar.OHLC["low"]; ok {
+			if seen, ok := agg.OHLC["low"]; !ok || low.LessThan(seen) {
+				agg.OHLC["low"] = low
+			}
+		}
+		if close, ok := bar.OHLC["close"]; ok {
+			agg.OHLC["close"] = close
+		}
+	}
+	return out
+}
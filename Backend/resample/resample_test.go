@@ -0,0 +1,121 @@
+package resample
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+func bar(day string, open, high, low, close int64, volume int) dto.DailyOHLCVRes {
+	return dto.DailyOHLCVRes{
+		Day: util.Date(day),
+		OHLC: map[string]decimal.Decimal{
+			"open":  decimal.NewFromInt(open),
+			"high":  decimal.NewFromInt(high),
+			"low":   decimal.NewFromInt(low),
+			"close": decimal.NewFromInt(close),
+		},
+		Volume: volume,
+	}
+}
+
+// Bar as "period start..end sessions open/high/low/close volume"
+func text(b dto.BarRes) string {
+	return b.Period.String() + " " + b.Start.String() + ".." + b.End.String() + " " +
+		decimal.NewFromInt(int64(b.Sessions)).String() + " " +
+		b.OHLC["open"].String() + "/" + b.OHLC["high"].String() + "/" +
+		b.OHLC["low"].String() + "/" + b.OHLC["close"].String() + " " +
+		decimal.NewFromInt(int64(b.Volume)).String()
+}
+
+func TestUnitResampleBars(t *testing.T) {
+	bars := []dto.DailyOHLCVRes{
+		bar("2025-03-28", 10, 12, 9, 11, 100),
+		bar("2025-03-31", 11, 15, 10, 14, 200),
+		bar("2025-04-01", 14, 14, 8, 9, 300),
+		bar("2025-04-04", 9, 10, 7, 8, 400),
+	}
+
+	testCases := []struct {
+		name      string
+		interval  string
+		weekStart time.Weekday
+		expected  []string
+	}{
+		{
+			name:      "week",
+			interval:  constant.IntervalWeek,
+			weekStart: time.Monday,
+			expected: []string{
+				"2025-03-24 2025-03-28..2025-03-28 1 10/12/9/11 100",
+				"2025-03-31 2025-03-31..2025-04-04 3 11/15/7/8 900",
+			},
+		},
+		{
+			name:      "week starting on sunday",
+			interval:  constant.IntervalWeek,
+			weekStart: time.Sunday,
+			expected: []string{
+				"2025-03-23 2025-03-28..2025-03-28 1 10/12/9/11 100",
+				"2025-03-30 2025-03-31..2025-04-04 3 11/15/7/8 900",
+			},
+		},
+		{
+			name:     "month",
+			interval: constant.IntervalMonth,
+			expected: []string{
+				"2025-03-01 2025-03-28..2025-03-31 2 10/15/9/14 300",
+				"2025-04-01 2025-04-01..2025-04-04 2 14/14/7/8 700",
+			},
+		},
+		{
+			name:     "quarter",
+			interval: constant.IntervalQuarter,
+			expected: []string{
+				"2025-01-01 2025-03-28..2025-03-31 2 10/15/9/14 300",
+				"2025-04-01 2025-04-01..2025-04-04 2 14/14/7/8 700",
+			},
+		},
+		{
+			name:     "year",
+			interval: constant.IntervalYear,
+			expected: []string{
+				"2025-01-01 2025-03-28..2025-04-04 4 10/15/7/8 1000",
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			output := Bars(bars, tt.interval, tt.weekStart)
+
+			//then
+			got := make([]string, len(output))
+			for i, b := range output {
+				got[i] = text(b)
+			}
+			assert.Equal(t, got, tt.expected)
+		})
+	}
+}
+
+func TestUnitResampleBarsMissingPrices(t *testing.T) {
+	//given
+	bars := []dto.DailyOHLCVRes{
+		{Day: util.Date("2025-06-02"), OHLC: map[string]decimal.Decimal{"close": decimal.NewFromInt(5)}},
+		bar("2025-06-03", 6, 8, 4, 7, 10),
+	}
+
+	//when
+	output := Bars(bars, constant.IntervalMonth, time.Monday)
+
+	//then
+	assert.Equal(t, text(output[0]), "2025-06-01 2025-06-02..2025-06-03 2 6/8/4/7 10")
+	assert.Equal(t, ValidInterval("fortnight"), false)
+}
@@ -0,0 +1,153 @@
+package scheduler
+
+import (
+	"Backend/constant"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron-like schedule with the usual five fields:
+// minute hour day-of-month month day-of-week
+// Each field accepts `*`, single values, ranges (`1-5`), lists (`1,3,5`)
+// and steps (`*/15`, `0-30/10`)
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day-of-month", 1, 31},
+	{"month", 1, 12},
+	{"day-of-week", 0, 6},
+}
+
+func ParseSchedule(spec string) (*Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return nil, constant.ErrInvalidSchedule(
+			fmt.Sprintf("expected %d fields, got %d", len(fields), len(parts)),
+		)
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, part := range parts {
+		b, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, err
+		}
+		bits[i] = b
+	}
+
+	return &Schedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: parts[2] == "*",
+		dowStar: parts[4] == "*",
+	}, nil
+}
+
+func parseField(text string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(text, ",") {
+		// - step
+		step := 1
+		if rangeText, stepText, ok := strings.Cut(item, "/"); ok {
+			s, err := strconv.Atoi(stepText)
+			if err != nil || s <= 0 {
+				return 0, constant.ErrInvalidSchedule(
+					fmt.Sprintf("bad step %q in %s", stepText, f.name),
+				)
+			}
+			step = s
+			item = rangeText
+		}
+
+		// - range
+		lo, hi := f.min, f.max
+		if item != "*" {
+			loText, hiText, isRange := strings.Cut(item, "-")
+			var err error
+			lo, err = strconv.Atoi(loText)
+			if err != nil {
+				return 0, constant.ErrInvalidSchedule(
+					fmt.Sprintf("bad value %q in %s", loText, f.name),
+				)
+			}
+			hi = lo
+			if isRange {
+				hi, err = strconv.Atoi(hiText)
+				if err != nil {
+					return 0, constant.ErrInvalidSchedule(
+						fmt.Sprintf("bad value %q in %s", hiText, f.name),
+					)
+				}
+			}
+		}
+		if lo < f.min || hi > f.max || lo > hi {
+			return 0, constant.ErrInvalidSchedule(
+				fmt.Sprintf("%q out of range for %s", item, f.name),
+			)
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
+
+// Day matching follows cron: when both day fields are restricted,
+// a day matching either of them is enough
+func (s *Schedule) matchesDay(t time.Time) bool {
+	domOk := has(s.dom, t.Day())
+	dowOk := has(s.dow, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return domOk && dowOk
+	}
+	return domOk || dowOk
+}
+
+// First time strictly after t that matches the schedule,
+// in t's location
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// Give up after five years, e.g. for 30 February
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
@@ -0,0 +1,175 @@
+package scheduler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// What the scheduler needs from the usecase layer
+type RefresherItf interface {
+	RefreshTracked(*gin.Context, *dto.RefreshTrackedReq) (*dto.SchedulerRunRes, error)
+}
+
+type SchedulerItf interface {
+	Start()
+	Stop()
+	Pause()
+	Resume()
+	Trigger() error
+	Status() *dto.SchedulerStatusRes
+}
+
+type Scheduler struct {
+	rf       RefresherItf
+	spec     string
+	schedule *Schedule
+	loc      *time.Location
+	disabled bool
+
+	mu      sync.Mutex
+	paused  bool
+	running bool
+	nextRun time.Time
+	stop    chan struct{}
+}
+
+func NewScheduler(rf RefresherItf, spec, timezone string, disabled bool) (*Scheduler, error) {
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, constant.ErrInvalidSchedule(err.Error())
+	}
+
+	return &Scheduler{
+		rf:       rf,
+		spec:     spec,
+		schedule: schedule,
+		loc:      loc,
+		disabled: disabled,
+		stop:     make(chan struct{}),
+	}, nil
+}
+
+// Run refreshes on schedule in the background until Stop is called;
+// a disabled scheduler only runs when triggered
+func (s *Scheduler) Start() {
+	if s.disabled {
+		return
+	}
+	go s.loop()
+}
+
+func (s *Scheduler) Stop() {
+	close(s.stop)
+}
+
+func (s *Scheduler) Pause() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paused = true
+}
+
+func (s *Scheduler) Resume() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paused = false
+}
+
+// Start a refresh run now, without waiting for it to finish
+func (s *Scheduler) Trigger() error {
+	if !s.begin() {
+		return constant.ErrSchedulerBusy
+	}
+	go s.execute(constant.TriggerManual)
+	return nil
+}
+
+func (s *Scheduler) Status() *dto.SchedulerStatusRes {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := &dto.SchedulerStatusRes{
+		Schedule: s.spec,
+		Timezone: s.loc.String(),
+		Disabled: s.disabled,
+		Paused:   s.paused,
+		Running:  s.running,
+	}
+	if !s.disabled && !s.nextRun.IsZero() {
+		nextRun := s.nextRun
+		status.NextRun = &nextRun
+	}
+	return status
+}
+
+func (s *Scheduler) loop() {
+	for {
+		next := s.schedule.Next(time.Now().In(s.loc))
+		if next.IsZero() {
+			log.Printf("scheduler: schedule %q never fires", s.spec)
+			return
+		}
+
+		s.mu.Lock()
+		s.nextRun = next
+		s.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-timer.C:
+			s.mu.Lock()
+			paused := s.paused
+			s.mu.Unlock()
+
+			// A manual run may still be going; skip this slot then
+			if !paused && s.begin() {
+				s.execute(constant.TriggerSchedule)
+			}
+		case <-s.stop:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// Claim the single running slot
+func (s *Scheduler) begin() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		return false
+	}
+	s.running = true
+	return true
+}
+
+func (s *Scheduler) execute(trigger string) {
+	defer func() {
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
+	}()
+
+	c, cancel := context.WithTimeout(context.Background(), constant.RefreshRunTimeout)
+	defer cancel()
+
+	run, err := s.rf.RefreshTracked(util.NewBackgroundContext(c),
+		&dto.RefreshTrackedReq{Trigger: trigger})
+	if err != nil {
+		log.Printf("scheduler: %s refresh run failed: %s", trigger, err)
+		return
+	}
+	log.Printf("scheduler: %s refresh run refreshed %d, skipped %d, failed %d",
+		trigger, len(run.Refreshed), len(run.Skipped), len(run.Failures))
+}
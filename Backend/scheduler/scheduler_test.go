@@ -0,0 +1,152 @@
+package scheduler
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	mocks "Backend/mocks/scheduler"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUnitSchedulerParseSchedule(t *testing.T) {
+	testCases := []struct {
+		name          string
+		spec          string
+		expectedError bool
+	}{
+		{name: "default schedule", spec: "30 17 * * 1-5"},
+		{name: "lists and steps", spec: "*/15 9-16 1,15 * *"},
+		{name: "too few fields", spec: "30 17 * *", expectedError: true},
+		{name: "minute out of range", spec: "60 17 * * *", expectedError: true},
+		{name: "backwards range", spec: "0 17 * * 5-1", expectedError: true},
+		{name: "bad step", spec: "*/0 17 * * *", expectedError: true},
+		{name: "not a number", spec: "0 five * * *", expectedError: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			_, err := ParseSchedule(tt.spec)
+
+			//then
+			if tt.expectedError {
+				var ce constant.CustomError
+				assert.Equal(t, errors.As(err, &ce), true)
+			} else {
+				assert.Equal(t, err, nil)
+			}
+		})
+	}
+}
+
+func TestUnitSchedulerNext(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+
+	testCases := []struct {
+		name     string
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "later the same weekday",
+			spec:     "30 17 * * 1-5",
+			from:     time.Date(2025, 6, 2, 9, 0, 0, 0, ny),
+			expected: time.Date(2025, 6, 2, 17, 30, 0, 0, ny),
+		},
+		{
+			name:     "exactly on schedule moves to the next slot",
+			spec:     "30 17 * * 1-5",
+			from:     time.Date(2025, 6, 2, 17, 30, 0, 0, ny),
+			expected: time.Date(2025, 6, 3, 17, 30, 0, 0, ny),
+		},
+		{
+			name:     "friday evening skips the weekend",
+			spec:     "30 17 * * 1-5",
+			from:     time.Date(2025, 6, 6, 18, 0, 0, 0, ny),
+			expected: time.Date(2025, 6, 9, 17, 30, 0, 0, ny),
+		},
+		{
+			name:     "steps",
+			spec:     "*/20 * * * *",
+			from:     time.Date(2025, 6, 2, 9, 41, 10, 0, ny),
+			expected: time.Date(2025, 6, 2, 10, 0, 0, 0, ny),
+		},
+		{
+			name:     "either day field may match",
+			spec:     "0 0 15 * 0",
+			from:     time.Date(2025, 6, 2, 0, 0, 0, 0, ny),
+			expected: time.Date(2025, 6, 8, 0, 0, 0, 0, ny),
+		},
+		{
+			name:     "month rollover",
+			spec:     "0 12 1 * *",
+			from:     time.Date(2025, 12, 15, 0, 0, 0, 0, ny),
+			expected: time.Date(2026, 1, 1, 12, 0, 0, 0, ny),
+		},
+		{
+			name:     "never fires",
+			spec:     "0 0 30 2 *",
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, ny),
+			expected: time.Time{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			schedule, err := ParseSchedule(tt.spec)
+			assert.Equal(t, err, nil)
+
+			//when
+			next := schedule.Next(tt.from)
+
+			//then
+			assert.Equal(t, next.Equal(tt.expected), true)
+		})
+	}
+}
+
+func TestUnitSchedulerTrigger(t *testing.T) {
+	//given
+	release := make(chan struct{})
+	done := make(chan struct{})
+
+	refresher := new(mocks.RefresherItf)
+	refresher.On(
+		"RefreshTracked",
+		mock.Anything,
+		&dto.RefreshTrackedReq{Trigger: constant.TriggerManual},
+	).Run(func(mock.Arguments) {
+		<-release
+	}).Return(&dto.SchedulerRunRes{}, nil).Once()
+
+	sc, err := NewScheduler(refresher, "30 17 * * 1-5", "America/New_York", true)
+	assert.Equal(t, err, nil)
+
+	//when
+	firstErr := sc.Trigger()
+	secondErr := sc.Trigger()
+	running := sc.Status().Running
+
+	close(release)
+	go func() {
+		for sc.Status().Running {
+			time.Sleep(time.Millisecond)
+		}
+		close(done)
+	}()
+	<-done
+
+	//then
+	assert.Equal(t, firstErr, nil)
+	assert.Equal(t, errors.Is(secondErr, constant.ErrSchedulerBusy), true)
+	assert.Equal(t, running, true)
+	assert.Equal(t, sc.Status().Disabled, true)
+	assert.Equal(t, sc.Status().NextRun == nil, true)
+	refresher.AssertExpectations(t)
+}
@@ -0,0 +1,178 @@
+package screen
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+func validOp(op string) bool {
+	switch op {
+	case constant.ScreenGT, constant.ScreenGTE, constant.ScreenLT,
+		constant.ScreenLTE, constant.ScreenEQ, constant.ScreenNE:
+		return true
+	}
+	return false
+}
+
+// Check a condition, filling in the default lookback of what leaves it out
+func Normalize(cond *dto.ScreenConditionReq) error {
+	switch cond.Field {
+	case constant.ScreenClose:
+		if !validOp(cond.Op) {
+			return constant.ErrInvalidScreenCondition
+		}
+	case constant.ScreenChange, constant.ScreenVolumeRatio, constant.ScreenPriceVsSMA:
+		if cond.Days == 0 {
+			cond.Days = constant.DefaultScreenDays[cond.Field]
+		}
+		if !validOp(cond.Op) || cond.Days < 1 {
+			return constant.ErrInvalidScreenCondition
+		}
+	case constant.ScreenNewHigh, constant.ScreenNewLow:
+	case constant.ScreenSector:
+		if cond.Op == "" {
+			cond.Op = constant.ScreenEQ
+		}
+		if cond.Sector == "" || (cond.Op != constant.ScreenEQ && cond.Op != constant.ScreenNE) {
+			return constant.ErrInvalidScreenCondition
+		}
+	default:
+		return constant.ErrInvalidScreenField
+	}
+	return nil
+}
+
+// Name of the column holding a condition's value; empty for sector,
+// which has a column of its own
+func Column(cond *dto.ScreenConditionReq) string {
+	switch cond.Field {
+	case constant.ScreenChange, constant.ScreenVolumeRatio, constant.ScreenPriceVsSMA:
+		return fmt.Sprintf("%s_%dd", cond.Field, cond.Days)
+	case constant.ScreenNewHigh:
+		return "high_52w"
+	case constant.ScreenNewLow:
+		return "low_52w"
+	case constant.ScreenSector:
+		return ""
+	}
+	return cond.Field
+}
+
+// The value of a normalized condition at the latest of the date-sorted
+// bars, nil when there aren't enough of them, and whether it holds
+func Evaluate(cond *dto.ScreenConditionReq, bars []dto.DailyOHLCVRes, sector string) (*decimal.Decimal, bool) {
+	if cond.Field == constant.ScreenSector {
+		same := strings.EqualFold(sector, cond.Sector)
+		return nil, same == (cond.Op == constant.ScreenEQ)
+	}
+	if len(bars) == 0 {
+		return nil, false
+	}
+	last := len(bars) - 1
+	latest := bars[last]
+
+	var value *decimal.Decimal
+	switch cond.Field {
+	case constant.ScreenClose:
+		close := latest.OHLC["close"]
+		value = &close
+
+	case constant.ScreenChange:
+		if last < cond.Days {
+			return nil, false
+		}
+		base := bars[last-cond.Days].OHLC["close"]
+		if base.IsZero() {
+			return nil, false
+		}
+		change := latest.OHLC["close"].Div(base).Sub(decimal.NewFromInt(1)).Mul(hundred)
+		value = &change
+
+	case constant.ScreenVolumeRatio:
+		if last < cond.Days {
+			return nil, false
+		}
+		sum := 0
+		for _, bar := range bars[last-cond.Days : last] {
+			sum += bar.Volume
+		}
+		if sum == 0 {
+			return nil, false
+		}
+		ratio := decimal.NewFromInt(int64(latest.Volume * cond.Days)).
+			Div(decimal.NewFromInt(int64(sum)))
+		value = &ratio
+
+	case constant.ScreenPriceVsSMA:
+		closes := make([]decimal.Decimal, len(bars))
+		for i, bar := range bars {
+			closes[i] = bar.OHLC["close"]
+		}
+		sma := analytics.SMA(closes, cond.Days)[last]
+		if sma == nil || sma.IsZero() {
+			return nil, false
+		}
+		distance := latest.OHLC["close"].Div(*sma).Sub(decimal.NewFromInt(1)).Mul(hundred)
+		value = &distance
+
+	case constant.ScreenNewHigh, constant.ScreenNewLow:
+		return extreme(cond.Field == constant.ScreenNewHigh, bars)
+	}
+
+	rounded := value.Round(constant.AnalyticsPlaces)
+	return &rounded, compare(rounded, cond.Op, cond.Value)
+}
+
+// The 52-week high or low through the latest bar, and whether the latest
+// bar set it; nil without any earlier bar in those weeks
+func extreme(high bool, bars []dto.DailyOHLCVRes) (*decimal.Decimal, bool) {
+	last := len(bars) - 1
+	key := "low"
+	if high {
+		key = "high"
+	}
+	since := bars[last].Day.AddDate(0, 0, -7*52)
+
+	var before *decimal.Decimal
+	for i := last - 1; i >= 0 && bars[i].Day.After(since); i-- {
+		price := bars[i].OHLC[key]
+		if before == nil || (high && price.GreaterThan(*before)) || (!high && price.LessThan(*before)) {
+			before = &price
+		}
+	}
+	if before == nil {
+		return nil, false
+	}
+
+	latest := bars[last].OHLC[key]
+	set := (high && latest.GreaterThan(*before)) || (!high && latest.LessThan(*before))
+	if set {
+		return &latest, true
+	}
+	return before, false
+}
+
+func compare(value decimal.Decimal, op string, target decimal.Decimal) bool {
+	switch op {
+	case constant.ScreenGT:
+		return value.GreaterThan(target)
+	case constant.ScreenGTE:
+		return value.GreaterThanOrEqual(target)
+	case constant.ScreenLT:
+		return value.LessThan(target)
+	case constant.ScreenLTE:
+		return value.LessThanOrEqual(target)
+	case constant.ScreenEQ:
+		return value.Equal(target)
+	case constant.ScreenNE:
+		return !value.Equal(target)
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package screen
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+// Bars on consecutive days from 2025-06-02 with the closes as highs and
+// lows, and the volumes if given
+func bars(closes []int64, volumes []int) []dto.DailyOHLCVRes {
+	start := util.Date("2025-06-02")
+	out := make([]dto.DailyOHLCVRes, len(closes))
+	for i, close := range closes {
+		price := decimal.NewFromInt(close)
+		out[i] = dto.DailyOHLCVRes{
+			Day:    start.AddDate(0, 0, i),
+			OHLC:   map[string]decimal.Decimal{"open": price, "high": price, "low": price, "close": price},
+			Volume: 100,
+		}
+		if volumes != nil {
+			out[i].Volume = volumes[i]
+		}
+	}
+	return out
+}
+
+func TestUnitScreenEvaluate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		cond           dto.ScreenConditionReq
+		bars           []dto.DailyOHLCVRes
+		sector         string
+		expectedColumn string
+		expectedValue  string
+		expectedHolds  bool
+	}{
+		{
+			name:           "last close above",
+			cond:           dto.ScreenConditionReq{Field: constant.ScreenClose, Op: constant.ScreenGT, Value: decimal.NewFromInt(100)},
+			bars:           bars([]int64{90, 101}, nil),
+			expectedColumn: "close",
+			expectedValue:  "101",
+			expectedHolds:  true,
+		},
+		{
+			name:           "percent change over days",
+			cond:           dto.ScreenConditionReq{Field: constant.ScreenChange, Op: constant.ScreenGTE, Value: decimal.NewFromInt(10), Days: 2},
+			bars:           bars([]int64{100, 95, 110}, nil),
+			expectedColumn: "change_2d",
+			expectedValue:  "10",
+			expectedHolds:  true,
+		},
+		{
+			name:           "not enough bars for the change",
+			cond:           dto.ScreenConditionReq{Field: constant.ScreenChange, Op: constant.ScreenLT, Days: 5},
+			bars:           bars([]int64{100, 95, 110}, nil),
+			expectedColumn: "change_5d",
+			expectedValue:  "",
+		},
+		{
+			name:           "volume against its average",
+			cond:           dto.ScreenConditionReq{Field: constant.ScreenVolumeRatio, Op: constant.ScreenGT, Value: decimal.NewFromInt(2), Days: 2},
+			bars:           bars([]int64{10, 10, 10}, []int{100, 200, 450}),
+			expectedColumn: "volume_ratio_2d",
+			expectedValue:  "3",
+			expectedHolds:  true,
+		},
+		{
+			name:           "close below its moving average",
+			cond:           dto.ScreenConditionReq{Field: constant.ScreenPriceVsSMA, Op: constant.ScreenGT, Days: 3},
+			bars:           bars([]int64{12, 12, 9}, nil),
+			expectedColumn: "price_vs_sma_3d",
+			expectedValue:  "-18.1818",
+		},
+		{
+			name:           "new 52-week high",
+			cond:           dto.ScreenConditionReq{Field: constant.ScreenNewHigh},
+			bars:           bars([]int64{10, 12, 11, 13}, nil),
+			expectedColumn: "high_52w",
+			expectedValue:  "13",
+			expectedHolds:  true,
+		},
+		{
+			name:           "below the 52-week high",
+			cond:           dto.ScreenConditionReq{Field: constant.ScreenNewHigh},
+			bars:           bars([]int64{10, 12, 11}, nil),
+			expectedColumn: "high_52w",
+			expectedValue:  "12",
+		},
+		{
+			name:           "new 52-week low",
+			cond:           dto.ScreenConditionReq{Field: constant.ScreenNewLow},
+			bars:           bars([]int64{10, 12, 9}, nil),
+			expectedColumn: "low_52w",
+			expectedValue:  "9",
+			expectedHolds:  true,
+		},
+		{
+			name:          "sector, ignoring case",
+			cond:          dto.ScreenConditionReq{Field: constant.ScreenSector, Sector: "technology"},
+			sector:        "TECHNOLOGY",
+			expectedHolds: true,
+		},
+		{
+			name:   "excluded sector",
+			cond:   dto.ScreenConditionReq{Field: constant.ScreenSector, Op: constant.ScreenNE, Sector: "Energy"},
+			sector: "ENERGY",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			err := Normalize(&tt.cond)
+			assert.Equal(t, err, nil)
+
+			//when
+			value, holds := Evaluate(&tt.cond, tt.bars, tt.sector)
+
+			//then
+			assert.Equal(t, Column(&tt.cond), tt.expectedColumn)
+			text := ""
+			if value != nil {
+				text = value.String()
+			}
+			assert.Equal(t, text, tt.expectedValue)
+			assert.Equal(t, holds, tt.expectedHolds)
+		})
+	}
+}
+
+func TestUnitScreenNormalize(t *testing.T) {
+	testCases := []struct {
+		name         string
+		cond         dto.ScreenConditionReq
+		expectedErr  error
+		expectedDays int
+	}{
+		{
+			name:         "default lookback",
+			cond:         dto.ScreenConditionReq{Field: constant.ScreenVolumeRatio, Op: constant.ScreenGT},
+			expectedDays: constant.DefaultScreenDays[constant.ScreenVolumeRatio],
+		},
+		{
+			name:        "missing op",
+			cond:        dto.ScreenConditionReq{Field: constant.ScreenClose},
+			expectedErr: constant.ErrInvalidScreenCondition,
+		},
+		{
+			name:        "sector without a sector",
+			cond:        dto.ScreenConditionReq{Field: constant.ScreenSector},
+			expectedErr: constant.ErrInvalidScreenCondition,
+		},
+		{
+			name:        "unknown field",
+			cond:        dto.ScreenConditionReq{Field: "pe_ratio", Op: constant.ScreenLT},
+			expectedErr: constant.ErrInvalidScreenField,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//when
+			err := Normalize(&tt.cond)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			assert.Equal(t, tt.cond.Days, tt.expectedDays)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package season
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var one = decimal.NewFromInt(1)
+
+// Returns compounded over a run of days in one period
+type Period struct {
+	Key    int
+	First  dto.DateOnly
+	Last   dto.DateOnly
+	Return decimal.Decimal
+}
+
+// Monday is 1 and Sunday 7
+func WeekdayKey(day dto.DateOnly) int {
+	return (int(day.Weekday())+6)%7 + 1
+}
+
+// Calendar month, e.g. 202506
+func MonthKey(day dto.DateOnly) int {
+	t := time.Time(day)
+	return t.Year()*100 + int(t.Month())
+}
+
+// ISO week, e.g. 202523
+func WeekKey(day dto.DateOnly) int {
+	year, week := time.Time(day).ISOWeek()
+	return year*100 + week
+}
+
+func YearKey(day dto.DateOnly) int {
+	return time.Time(day).Year()
+}
+
+// Returns of consecutive days with the same key compounded into one,
+// in date order
+func Compound(days []dto.DateOnly, returns []decimal.Decimal, key func(dto.DateOnly) int) []Period {
+	periods := make([]Period, 0)
+	for i, day := range days {
+		k := key(day)
+		if len(periods) == 0 || periods[len(periods)-1].Key != k {
+			periods = append(periods, Period{Key: k, First: day, Return: decimal.Zero})
+		}
+		last := &periods[len(periods)-1]
+		last.Last = day
+		last.Return = last.Return.Add(one).Mul(returns[i].Add(one)).Sub(one)
+	}
+	return periods
+}
+
+// Statistics of the returns by bucket, in bucket order
+func Buckets(buckets []int, returns []decimal.Decimal, label func(int) string) []dto.SeasonBucketRes {
+	grouped := make(map[int][]decimal.Decimal)
+	for i, bucket := range buckets {
+		grouped[bucket] = append(grouped[bucket], returns[i])
+	}
+	keys := make([]int, 0, len(grouped))
+	for key := range grouped {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	out := make([]dto.SeasonBucketRes, len(keys))
+	for i, key := range keys {
+		values := grouped[key]
+		hits := 0
+		for _, value := range values {
+			if value.IsPositive() {
+				hits++
+			}
+		}
+		count := decimal.NewFromInt(int64(len(values)))
+		out[i] = dto.SeasonBucketRes{
+			Key:     key,
+			Label:   label(key),
+			Count:   len(values),
+			Mean:    analytics.Mean(values).Round(constant.AnalyticsPlaces),
+			Median:  analytics.Median(values).Round(constant.AnalyticsPlaces),
+			HitRate: decimal.NewFromInt(int64(hits)).Div(count).Round(constant.AnalyticsPlaces),
+		}
+	}
+	return out
+}
+
+func WeekdayLabel(key int) string {
+	return time.Weekday(key % 7).String()
+}
+
+func MonthLabel(key int) string {
+	return time.Month(key).String()
+}
+
+func WeekLabel(key int) string {
+	return fmt.Sprintf("W%02d", key)
+}
@@ -0,0 +1,66 @@
+package season
+
+import (
+	"Backend/dto"
+	"Backend/util"
+	"testing"
+
+	"github.com/go-playground/assert"
+	"github.com/shopspring/decimal"
+)
+
+func decimals(values ...float64) []decimal.Decimal {
+	out := make([]decimal.Decimal, len(values))
+	for i, value := range values {
+		out[i] = decimal.NewFromFloat(value)
+	}
+	return out
+}
+
+func TestUnitSeasonKeys(t *testing.T) {
+	assert.Equal(t, WeekdayKey(util.Date("2025-06-30")), 1)
+	assert.Equal(t, WeekdayKey(util.Date("2025-06-29")), 7)
+	assert.Equal(t, MonthKey(util.Date("2025-06-30")), 202506)
+	// The ISO week of a year can start in the year before
+	assert.Equal(t, WeekKey(util.Date("2024-12-30")), 202501)
+	assert.Equal(t, WeekKey(util.Date("2025-01-05")), 202501)
+	assert.Equal(t, YearKey(util.Date("2024-12-30")), 2024)
+	assert.Equal(t, WeekdayLabel(7), "Sunday")
+	assert.Equal(t, MonthLabel(6), "June")
+	assert.Equal(t, WeekLabel(1), "W01")
+}
+
+func TestUnitSeasonCompound(t *testing.T) {
+	//given
+	days := []dto.DateOnly{util.Date("2025-06-27"), util.Date("2025-06-30"), util.Date("2025-07-01")}
+	returns := decimals(0.1, 0.1, -0.5)
+
+	//when
+	periods := Compound(days, returns, MonthKey)
+
+	//then
+	assert.Equal(t, len(periods), 2)
+	assert.Equal(t, periods[0].Key, 202506)
+	assert.Equal(t, periods[0].First, util.Date("2025-06-27"))
+	assert.Equal(t, periods[0].Last, util.Date("2025-06-30"))
+	assert.Equal(t, periods[0].Return.String(), "0.21")
+	assert.Equal(t, periods[1].Key, 202507)
+	assert.Equal(t, periods[1].Return.String(), "-0.5")
+}
+
+func TestUnitSeasonBuckets(t *testing.T) {
+	//when
+	buckets := Buckets([]int{2, 1, 1, 1}, decimals(-0.2, 0.1, 0.3, -0.1), WeekdayLabel)
+
+	//then
+	assert.Equal(t, len(buckets), 2)
+	assert.Equal(t, buckets[0].Key, 1)
+	assert.Equal(t, buckets[0].Label, "Monday")
+	assert.Equal(t, buckets[0].Count, 3)
+	assert.Equal(t, buckets[0].Mean.String(), "0.1")
+	assert.Equal(t, buckets[0].Median.String(), "0.1")
+	assert.Equal(t, buckets[0].HitRate.String(), "0.6667")
+	assert.Equal(t, buckets[1].Label, "Tuesday")
+	assert.Equal(t, buckets[1].Count, 1)
+	assert.Equal(t, buckets[1].HitRate.String(), "0")
+}
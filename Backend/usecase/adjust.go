@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"Backend/adjust"
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/provider"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *Usecase) CorporateActions(ctx *gin.Context, req *dto.CorporateActionsReq) ([]dto.CorporateActionRes, error) {
+	_, err := uc.rp.GetSymbol(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// repo
+	return uc.rp.CorporateActions(ctx, req.Symbol)
+}
+
+// Fetch the symbol's splits and dividends and store them
+func (uc *Usecase) SyncCorporateActions(ctx *gin.Context, req *dto.CorporateActionsReq) ([]dto.CorporateActionRes, error) {
+	_, err := uc.rp.GetSymbol(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	source, ok := uc.providers[provider.AlphaVantageName].(provider.ActionSourceItf)
+	if !ok {
+		return nil, constant.ErrUnknownProvider(provider.AlphaVantageName)
+	}
+	err = uc.rp.AddAPICalls(ctx, provider.AlphaVantageName, provider.AlphaActionRequests)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := source.CorporateActions(req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.rp.UpsertCorporateActions(ctx, actions)
+	if err != nil {
+		return nil, err
+	}
+	return uc.rp.CorporateActions(ctx, req.Symbol)
+}
+
+// Bars of the symbol adjusted with its stored corporate actions
+func (uc *Usecase) adjustBars(ctx *gin.Context, symbol string, bars []dto.DailyOHLCVRes, mode string) ([]dto.DailyOHLCVRes, error) {
+	if mode == "" || mode == constant.AdjustNone {
+		return bars, nil
+	}
+	actions, err := uc.rp.CorporateActions(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return adjust.Bars(bars, actions, mode), nil
+}
+
+func adjustMode(mode string) (string, error) {
+	if mode == "" {
+		return constant.AdjustNone, nil
+	}
+	if !adjust.ValidMode(mode) {
+		return "", constant.ErrInvalidAdjust
+	}
+	return mode, nil
+}
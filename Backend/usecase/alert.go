@@ -0,0 +1,242 @@
+package usecase
+
+import (
+	"Backend/alert"
+	"Backend/constant"
+	"Backend/dto"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func webhookSecret() string {
+	return os.Getenv("ALERT_WEBHOOK_SECRET")
+}
+
+func (uc *Usecase) CreateAlertRule(ctx *gin.Context, req *dto.AlertRuleReq) (*dto.AlertRuleRes, error) {
+	err := alert.ValidRule(req)
+	if err != nil {
+		return nil, err
+	}
+	// Unsigned webhooks can't be trusted by receivers, so don't take rules
+	// that could only send them
+	if webhookSecret() == "" {
+		return nil, constant.ErrNoWebhookSecret
+	}
+
+	// repo
+	rule, err := uc.rp.InsertAlertRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := uc.collectUntracked(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) > 0 {
+		rule.Collecting = jobs[0]
+	}
+	return rule, nil
+}
+
+func (uc *Usecase) AlertRules(ctx *gin.Context, req *dto.AlertRulesReq) ([]*dto.AlertRuleRes, error) {
+	// repo
+	return uc.rp.AlertRules(ctx, req)
+}
+
+func (uc *Usecase) GetAlertRule(ctx *gin.Context, req *dto.GetAlertReq) (*dto.AlertRuleRes, error) {
+	// repo
+	return uc.rp.GetAlertRule(ctx, req)
+}
+
+func (uc *Usecase) DeleteAlertRule(ctx *gin.Context, req *dto.GetAlertReq) error {
+	// repo
+	return uc.rp.DeleteAlertRule(ctx, req)
+}
+
+func (uc *Usecase) AlertTriggers(ctx *gin.Context, req *dto.GetAlertReq) ([]*dto.AlertTriggerRes, error) {
+	_, err := uc.rp.GetAlertRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// repo
+	return uc.rp.AlertTriggers(ctx, req)
+}
+
+// Evaluate the symbol's rules against each bar stored since they were
+// last checked, oldest first, queueing a webhook delivery for every
+// time one fires. A rule not checked yet starts at the latest bar. Each
+// bar is evaluated once per rule.
+func (uc *Usecase) evaluateAlerts(ctx *gin.Context, symbol string) error {
+	rules, err := uc.rp.AlertRules(ctx, &dto.AlertRulesReq{Symbol: symbol})
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	bars, err := uc.rp.SymbolBars(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if len(bars) == 0 {
+		return nil
+	}
+
+	for _, rule := range rules {
+		first := len(bars) - 1
+		if rule.LastChecked != nil {
+			first = sort.Search(len(bars), func(i int) bool {
+				return bars[i].Day.After(*rule.LastChecked)
+			})
+		}
+		for i := first; i < len(bars); i++ {
+			bar := bars[i]
+			value, fired := alert.Evaluate(rule, bars[:i+1])
+
+			// Claim the bar, so concurrent refreshes don't fire twice
+			claimed, err := uc.rp.MarkAlertChecked(ctx, rule.Id, bar.Day)
+			if err != nil {
+				return err
+			}
+			if !claimed || !fired {
+				continue
+			}
+
+			trigger, err := uc.rp.InsertAlertTrigger(ctx, &dto.AlertTriggerRes{
+				RuleId:     rule.Id,
+				Symbol:     rule.Symbol,
+				Type:       rule.Type,
+				Date:       bar.Day,
+				Close:      bar.OHLC["close"],
+				Value:      value,
+				Message:    alert.Message(rule, value),
+				WebhookURL: rule.WebhookURL,
+			})
+			if err != nil {
+				return err
+			}
+			_, err = uc.rp.InsertJob(ctx, &dto.JobReq{
+				Type:   constant.JobTypeAlert,
+				Symbol: rule.Symbol,
+				Ref:    trigger.Id,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Alerts are a side effect of storing bars; a failure there must not
+// fail the request that stored them
+func (uc *Usecase) checkAlerts(ctx *gin.Context, symbol string) {
+	err := uc.evaluateAlerts(ctx, symbol)
+	if err != nil {
+		log.Printf("alerts: evaluating %s failed: %s", symbol, err)
+	}
+}
+
+// Send the webhook of a trigger, recording the attempt on it
+func (uc *Usecase) DeliverAlert(ctx *gin.Context, job *dto.JobRes) (map[string]any, error) {
+	trigger, err := uc.rp.GetAlertTrigger(ctx, job.Ref)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]any{
+		"trigger_id": trigger.Id,
+		"rule_id":    trigger.RuleId,
+		"status":     trigger.Status,
+		"attempts":   trigger.Attempts,
+	}
+	if trigger.Status == constant.DeliveryDelivered {
+		return result, nil
+	}
+
+	trigger.Attempts++
+	err = uc.postWebhook(ctx, trigger)
+	// Nothing can be signed without the secret, however often it's retried
+	unsigned := errors.Is(err, constant.ErrNoWebhookSecret)
+	if err != nil {
+		trigger.Status = constant.DeliveryRetrying
+		if unsigned || trigger.Attempts >= constant.WebhookMaxAttempts {
+			trigger.Status = constant.DeliveryFailed
+		}
+		trigger.LastError = err.Error()
+	} else {
+		now := time.Now().UTC()
+		trigger.Status = constant.DeliveryDelivered
+		trigger.LastError = ""
+		trigger.DeliveredAt = &now
+	}
+
+	updateErr := uc.rp.UpdateAlertDelivery(ctx, trigger)
+	if updateErr != nil {
+		return nil, updateErr
+	}
+	if unsigned {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", constant.ErrWebhookFailed, err)
+	}
+	result["status"] = trigger.Status
+	result["attempts"] = trigger.Attempts
+	return result, nil
+}
+
+func (uc *Usecase) postWebhook(ctx *gin.Context, trigger *dto.AlertTriggerRes) error {
+	secret := webhookSecret()
+	if secret == "" {
+		return constant.ErrNoWebhookSecret
+	}
+
+	body, err := json.Marshal(dto.AlertWebhookReq{
+		TriggerId: trigger.Id,
+		RuleId:    trigger.RuleId,
+		Symbol:    trigger.Symbol,
+		Type:      trigger.Type,
+		Date:      trigger.Date,
+		Close:     trigger.Close,
+		Value:     trigger.Value,
+		Message:   trigger.Message,
+	})
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	c, cancel := context.WithTimeout(ctx.Request.Context(), constant.WebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(c, http.MethodPost,
+		trigger.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(constant.WebhookTimestampHeader, timestamp)
+	req.Header.Set(constant.WebhookSignatureHeader, alert.Sign(secret, timestamp, body))
+
+	resp, err := uc.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("receiver responded %s", resp.Status)
+	}
+	return nil
+}
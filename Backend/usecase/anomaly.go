@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"Backend/anomaly"
+	"Backend/constant"
+	"Backend/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *Usecase) Anomalies(ctx *gin.Context, req *dto.AnomaliesReq) (*dto.AnomaliesRes, error) {
+	err := anomaly.Normalize(&req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	bars, start, err := uc.loadSeries(ctx, &req.Series)
+	if err != nil {
+		return nil, err
+	}
+	// Bars before the window fill the windows of the first ones in it
+	flags := anomaly.Detect(bars, req.Params)
+
+	res := &dto.AnomaliesRes{
+		Symbol:    req.Series.Symbol,
+		Adjust:    req.Series.Adjust,
+		Interval:  req.Series.Interval,
+		Currency:  req.Series.Currency,
+		Method:    req.Params.Method,
+		Threshold: *req.Params.Threshold,
+		Window:    req.Params.Window,
+		Counts: map[string]int{
+			constant.AnomalyReturn: 0,
+			constant.AnomalyVolume: 0,
+		},
+		Dates: make([]dto.AnomalyDateRes, 0),
+	}
+	for i, bar := range bars[start:] {
+		flagged := flags[start+i]
+		if len(flagged) == 0 {
+			continue
+		}
+		for _, flag := range flagged {
+			res.Counts[flag.Metric]++
+		}
+		res.Dates = append(res.Dates, dto.AnomalyDateRes{
+			Date:   bar.Day,
+			Close:  bar.OHLC["close"],
+			Volume: bar.Volume,
+			Flags:  flagged,
+		})
+	}
+	return res, nil
+}
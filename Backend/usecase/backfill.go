@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/provider"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *Usecase) EnqueueBackfill(ctx *gin.Context, req *dto.BackfillReq) (*dto.JobRes, error) {
+	if req.Provider == "" {
+		req.Provider = provider.DefaultName
+	}
+	if _, ok := uc.providers[req.Provider]; !ok {
+		return nil, constant.ErrUnknownProvider(req.Provider)
+	}
+
+	meta, err := uc.rp.GetSymbol(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// By default, fill everything older than what is stored
+	if req.To == nil {
+		dates, err := uc.rp.BarDates(ctx, req.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		to := meta.LastRefreshed
+		if len(dates) > 0 {
+			to = dates[0].AddDate(0, 0, -1)
+		}
+		req.To = &to
+	}
+	if req.From.After(*req.To) {
+		return nil, constant.ErrDateRange
+	}
+
+	// repo
+	return uc.rp.InsertJob(ctx, &dto.JobReq{
+		Type:     constant.JobTypeBackfill,
+		Symbol:   req.Symbol,
+		Provider: req.Provider,
+		From:     req.From,
+		To:       req.To,
+	})
+}
+
+// Work backwards from the job's cursor to its from date, one provider
+// request at a time, saving progress after each so an interrupted
+// backfill carries on where it stopped
+func (uc *Usecase) Backfill(ctx *gin.Context, job *dto.JobRes) (map[string]any, error) {
+	p, ok := uc.providers[job.Provider]
+	if !ok {
+		return nil, constant.ErrUnknownProvider(job.Provider)
+	}
+
+	meta, err := uc.rp.GetSymbol(ctx, job.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// Existing bars are never touched
+	dates, err := uc.rp.BarDates(ctx, job.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool, len(dates))
+	for _, date := range dates {
+		existing[date.String()] = true
+	}
+
+	if job.Progress == nil {
+		cursor := *job.To
+		job.Progress = &dto.JobProgressRes{Cursor: &cursor}
+	}
+	progress := job.Progress
+
+	for !progress.Cursor.Before(*job.From) {
+		chunkFrom := *job.From
+		if maxDays := p.MaxRangeDays(); maxDays > 0 {
+			start := progress.Cursor.AddDate(0, 0, -(maxDays - 1))
+			if start.After(chunkFrom) {
+				chunkFrom = start
+			}
+		}
+
+		err = uc.rp.AddAPICalls(ctx, job.Provider, 1)
+		if err != nil {
+			return nil, err
+		}
+		bars, err := p.DailyBars(job.Symbol, chunkFrom, *progress.Cursor)
+		// Free Alpha Vantage keys get no history past compact output;
+		// Stooq serves it without a key, so carry on from there
+		if fallback, ok := uc.providers[provider.StooqName]; ok &&
+			errors.Is(err, constant.ErrAPIPremium) && job.Provider != provider.StooqName {
+			job.Provider, p = provider.StooqName, fallback
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		missing := make([]dto.DailyOHLCVRes, 0)
+		for _, bar := range bars {
+			if !existing[bar.Day.String()] {
+				missing = append(missing, bar)
+			}
+		}
+
+		// Bars failing validation are quarantined instead of stored
+		quarantined := 0
+		if len(missing) > 0 {
+			prev, err := uc.rp.LatestBarBefore(ctx, job.Symbol, missing[0].Day)
+			if err != nil {
+				return nil, err
+			}
+			missing, quarantined, err = uc.screenBars(ctx, job.Symbol, prev, missing)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = uc.rp.InsertBars(ctx, &dto.DataPerSymbol{
+			MetaData:   &dto.SymbolDataMeta{Symbol: job.Symbol},
+			TimeSeries: missing,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bar := range missing {
+			existing[bar.Day.String()] = true
+			if progress.FilledFrom == nil || bar.Day.Before(*progress.FilledFrom) {
+				day := bar.Day
+				progress.FilledFrom = &day
+			}
+			if progress.FilledTo == nil || bar.Day.After(*progress.FilledTo) {
+				day := bar.Day
+				progress.FilledTo = &day
+			}
+		}
+		progress.Inserted += len(missing)
+		progress.Quarantined += quarantined
+		progress.SkippedExisting += len(bars) - len(missing) - quarantined
+
+		cursor := chunkFrom.AddDate(0, 0, -1)
+		progress.Cursor = &cursor
+		err = uc.rp.UpdateJob(ctx, job)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Backfilling past the last refresh moves it forward
+	if progress.FilledTo != nil && progress.FilledTo.After(meta.LastRefreshed) {
+		meta.LastRefreshed = *progress.FilledTo
+		err = uc.rp.UpdateLastRefreshed(ctx, meta)
+		if err != nil {
+			return nil, err
+		}
+		uc.checkAlerts(ctx, job.Symbol)
+	}
+
+	result := map[string]any{
+		"symbol":           job.Symbol,
+		"provider":         job.Provider,
+		"from":             job.From.String(),
+		"to":               job.To.String(),
+		"inserted":         progress.Inserted,
+		"skipped_existing": progress.SkippedExisting,
+		"quarantined":      progress.Quarantined,
+		"filled_from":      nil,
+		"filled_to":        nil,
+	}
+	if progress.FilledFrom != nil {
+		result["filled_from"] = progress.FilledFrom.String()
+		result["filled_to"] = progress.FilledTo.String()
+	}
+	return result, nil
+}
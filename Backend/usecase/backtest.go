@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"Backend/backtest"
+	"Backend/constant"
+	"Backend/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *Usecase) RunBacktest(ctx *gin.Context, req *dto.BacktestReq) (*dto.BacktestRes, error) {
+	err := backtest.Normalize(req)
+	if err != nil {
+		return nil, err
+	}
+
+	series := dto.SeriesReq{
+		Symbol: req.Symbol,
+		From:   req.From,
+		To:     req.To,
+		Adjust: req.Adjust,
+	}
+	bars, start, err := uc.loadSeries(ctx, &series)
+	if err != nil {
+		return nil, err
+	}
+	req.Adjust = series.Adjust
+	// Signals at the last bar can't be filled, so one bar trades nothing
+	if len(bars)-start < 2 {
+		return nil, constant.ErrNotEnoughBars
+	}
+
+	// repo
+	return uc.rp.InsertBacktest(ctx, backtest.Run(req, bars, start, riskFreeRate()))
+}
+
+func (uc *Usecase) GetBacktest(ctx *gin.Context, req *dto.GetBacktestReq) (*dto.BacktestRes, error) {
+	// repo
+	return uc.rp.GetBacktest(ctx, req)
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+	"errors"
+	"os"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func benchmarkSymbol(asked string) string {
+	if asked != "" {
+		return asked
+	}
+	if configured := os.Getenv("BENCHMARK_SYMBOL"); configured != "" {
+		return configured
+	}
+	return constant.DefaultBenchmark
+}
+
+// Queue collection of the benchmark when it isn't tracked yet
+func (uc *Usecase) collectBenchmark(ctx *gin.Context, benchmark string) (*dto.JobRes, error) {
+	jobs, err := uc.collectUntracked(ctx, benchmark)
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+func betaStats(symbol string, dates []dto.DateOnly, asset, benchmark []decimal.Decimal, riskFree decimal.Decimal, periodsPerYear int) *dto.BetaStatsRes {
+	return &dto.BetaStatsRes{
+		Symbol:           symbol,
+		From:             dates[0],
+		To:               dates[len(dates)-1],
+		Periods:          len(asset),
+		Beta:             rounded(analytics.Beta(asset, benchmark)),
+		Alpha:            rounded(analytics.Alpha(asset, benchmark, riskFree, periodsPerYear)),
+		RSquared:         rounded(analytics.RSquared(asset, benchmark)),
+		Correlation:      rounded(analytics.Correlation(asset, benchmark)),
+		TrackingError:    analytics.TrackingError(asset, benchmark, periodsPerYear).Round(constant.AnalyticsPlaces),
+		InformationRatio: rounded(analytics.InformationRatio(asset, benchmark, periodsPerYear)),
+	}
+}
+
+func (uc *Usecase) Beta(ctx *gin.Context, req *dto.BetaReq) (*dto.BetaRes, error) {
+	riskFree := riskFreeRate()
+	if req.RiskFree != nil {
+		riskFree = *req.RiskFree
+	}
+	benchmark := benchmarkSymbol(req.Benchmark)
+	if benchmark == req.Series.Symbol {
+		return nil, constant.ErrBenchmarkIsSymbol
+	}
+	window := req.Window
+	if window == 0 {
+		window = constant.DefaultBetaWindow
+	}
+	if window < constant.MinBetaWindow {
+		return nil, constant.ErrInvalidBetaWindow
+	}
+	currency, err := currencyCode(req.Series.Currency)
+	if err != nil {
+		return nil, err
+	}
+	req.Series.Currency = currency
+
+	_, err = uc.rp.GetSymbol(ctx, req.Series.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	res := &dto.BetaRes{
+		Symbol:    req.Series.Symbol,
+		Benchmark: benchmark,
+	}
+	res.Collecting, err = uc.collectBenchmark(ctx, benchmark)
+	if err != nil || res.Collecting != nil {
+		return res, err
+	}
+
+	dates, returns, conversions, err := uc.commonReturns(ctx,
+		[]string{req.Series.Symbol, benchmark}, &req.Series)
+	if err != nil {
+		return nil, err
+	}
+	res.Adjust, res.Interval = req.Series.Adjust, req.Series.Interval
+	res.Currency = currency
+	res.Conversions = conversions
+	periodsPerYear := constant.PeriodsPerYear[req.Series.Interval]
+	res.Stats = betaStats(req.Series.Symbol, dates, returns[0], returns[1], riskFree, periodsPerYear)
+
+	rolling := analytics.RollingBeta(returns[0], returns[1], window)
+	res.Rolling = &dto.RollingBetaRes{
+		Window: window,
+		WarmUp: min(window-1, len(rolling)),
+		Points: make([]dto.RollingPointRes, len(rolling)),
+	}
+	for i, value := range rolling {
+		res.Rolling.Points[i] = dto.RollingPointRes{Date: dates[i+1], Value: rounded(value)}
+	}
+	return res, nil
+}
+
+// Beta statistics of every tracked symbol against the benchmark, by symbol
+func (uc *Usecase) Betas(ctx *gin.Context, req *dto.BetasReq) (*dto.BetasRes, error) {
+	riskFree := riskFreeRate()
+	if req.RiskFree != nil {
+		riskFree = *req.RiskFree
+	}
+	mode, err := adjustMode(req.Adjust)
+	if err != nil {
+		return nil, err
+	}
+	interval, err := intervalMode(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := currencyCode(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	benchmark := benchmarkSymbol(req.Benchmark)
+	res := &dto.BetasRes{
+		Benchmark: benchmark,
+		Adjust:    mode,
+		Interval:  interval,
+		Currency:  currency,
+		Symbols:   make([]dto.BetaStatsRes, 0),
+		Skipped:   make([]string, 0),
+	}
+	res.Collecting, err = uc.collectBenchmark(ctx, benchmark)
+	if err != nil || res.Collecting != nil {
+		return res, err
+	}
+
+	symbols, err := uc.rp.TrackedSymbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		return symbols[i].Symbol < symbols[j].Symbol
+	})
+
+	for _, symbol := range symbols {
+		if symbol.Symbol == benchmark {
+			continue
+		}
+		series := dto.SeriesReq{
+			From:     req.From,
+			To:       req.To,
+			Adjust:   mode,
+			Interval: interval,
+			Currency: currency,
+		}
+		dates, returns, _, err := uc.commonReturns(ctx,
+			[]string{symbol.Symbol, benchmark}, &series)
+		if errors.Is(err, constant.ErrNotEnoughCommonDates) || errors.Is(err, constant.ErrNoBars) ||
+			errors.Is(err, constant.ErrFXRatesNotFound) || errors.Is(err, constant.ErrMissingFXRate) {
+			res.Skipped = append(res.Skipped, symbol.Symbol)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Symbols = append(res.Symbols, *betaStats(symbol.Symbol, dates, returns[0], returns[1],
+			riskFree, constant.PeriodsPerYear[interval]))
+	}
+	return res, nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"Backend/chart"
+	"Backend/constant"
+	"Backend/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func (uc *Usecase) HeikinAshi(ctx *gin.Context, req *dto.HeikinAshiReq) (*dto.HeikinAshiRes, error) {
+	bars, start, err := uc.loadSeries(ctx, &req.Series)
+	if err != nil {
+		return nil, err
+	}
+	// Candles before the window settle the opens of the first ones in it
+	candles := chart.HeikinAshi(bars)
+
+	return &dto.HeikinAshiRes{
+		Symbol:   req.Series.Symbol,
+		Adjust:   req.Series.Adjust,
+		Interval: req.Series.Interval,
+		Currency: req.Series.Currency,
+		Candles:  candles[start:],
+	}, nil
+}
+
+func (uc *Usecase) Renko(ctx *gin.Context, req *dto.BoxChartReq) (*dto.RenkoRes, error) {
+	bars, start, box, err := uc.boxSeries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RenkoRes{
+		Symbol:    req.Series.Symbol,
+		Adjust:    req.Series.Adjust,
+		Interval:  req.Series.Interval,
+		Currency:  req.Series.Currency,
+		Box:       box,
+		BoxSource: boxSource(req.Params),
+		ATR:       req.Params.ATR,
+		Bricks:    chart.Renko(bars[start:], box),
+	}, nil
+}
+
+func (uc *Usecase) PointFigure(ctx *gin.Context, req *dto.BoxChartReq) (*dto.PointFigureRes, error) {
+	bars, start, box, err := uc.boxSeries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PointFigureRes{
+		Symbol:    req.Series.Symbol,
+		Adjust:    req.Series.Adjust,
+		Interval:  req.Series.Interval,
+		Currency:  req.Series.Currency,
+		Box:       box,
+		BoxSource: boxSource(req.Params),
+		ATR:       req.Params.ATR,
+		Reversal:  req.Params.Reversal,
+		Columns:   chart.PointFigure(bars[start:], box, req.Params.Reversal),
+	}, nil
+}
+
+// Series with the box size asked for; an ATR box is taken at the window
+// end, over bars before the window too
+func (uc *Usecase) boxSeries(ctx *gin.Context, req *dto.BoxChartReq) ([]dto.DailyOHLCVRes, int, decimal.Decimal, error) {
+	bars, start, err := uc.loadSeries(ctx, &req.Series)
+	if err != nil {
+		return nil, 0, decimal.Zero, err
+	}
+	err = chart.Normalize(&req.Params, bars[start:])
+	if err != nil {
+		return nil, 0, decimal.Zero, err
+	}
+	if req.Params.Box != nil {
+		return bars, start, *req.Params.Box, nil
+	}
+	box := chart.ATRBox(bars, req.Params.ATR)
+	if box == nil {
+		return nil, 0, decimal.Zero, constant.ErrNoBoxSize
+	}
+	return bars, start, *box, nil
+}
+
+func boxSource(params dto.BoxParams) string {
+	if params.Box != nil {
+		return constant.BoxFixed
+	}
+	return constant.BoxATR
+}
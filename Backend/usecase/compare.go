@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func (uc *Usecase) Compare(ctx *gin.Context, req *dto.CompareReq) (*dto.CompareRes, error) {
+	symbols := uniqueSymbols(req.Symbols)
+	if len(symbols) < 2 {
+		return nil, constant.ErrNotEnoughSymbols
+	}
+
+	currency, err := currencyCode(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Closes per symbol by date, and every date any symbol traded on
+	closes := make([]map[string]decimal.Decimal, len(symbols))
+	starts := make([]dto.DateOnly, len(symbols))
+	loaded := make([]dto.SeriesReq, len(symbols))
+	dates := make(map[string]dto.DateOnly)
+	for i, symbol := range symbols {
+		series := dto.SeriesReq{
+			Symbol:   symbol,
+			From:     req.From,
+			To:       req.To,
+			Adjust:   req.Adjust,
+			Interval: req.Interval,
+			Currency: currency,
+		}
+		bars, start, err := uc.loadSeries(ctx, &series)
+		if err != nil {
+			return nil, err
+		}
+		req.Adjust, req.Interval = series.Adjust, series.Interval
+		loaded[i] = series
+		starts[i] = bars[start].Day
+		closes[i] = make(map[string]decimal.Decimal, len(bars)-start)
+		for _, bar := range bars[start:] {
+			closes[i][bar.Day.String()] = bar.OHLC["close"]
+			dates[bar.Day.String()] = bar.Day
+		}
+	}
+
+	res := &dto.CompareRes{
+		Symbols:  symbols,
+		Adjust:   req.Adjust,
+		Interval: req.Interval,
+		Dates:    make([]dto.DateOnly, 0, len(dates)),
+		Series:   make([]dto.CompareSeriesRes, len(symbols)),
+	}
+	for _, date := range dates {
+		res.Dates = append(res.Dates, date)
+	}
+	sort.Slice(res.Dates, func(i, j int) bool {
+		return res.Dates[i].Before(res.Dates[j])
+	})
+	res.From, res.To = res.Dates[0], res.Dates[len(res.Dates)-1]
+	res.CommonStart = starts[0]
+	for _, start := range starts[1:] {
+		if start.After(res.CommonStart) {
+			res.CommonStart = start
+		}
+	}
+
+	hundred := decimal.NewFromInt(100)
+	for i, symbol := range symbols {
+		base := closes[i][starts[i].String()]
+		series := dto.CompareSeriesRes{
+			Symbol:     symbol,
+			Start:      starts[i],
+			Currency:   loaded[i].Currency,
+			Conversion: loaded[i].Conversion,
+			Values:     make([]*decimal.Decimal, len(res.Dates)),
+		}
+		var last, common *decimal.Decimal
+		for j, date := range res.Dates {
+			if close, ok := closes[i][date.String()]; ok {
+				last = &close
+			}
+			if last == nil || base.IsZero() {
+				continue
+			}
+			rebased := last.Div(base).Mul(hundred)
+			series.Values[j] = rounded(&rebased)
+			if common == nil && !date.Before(res.CommonStart) {
+				common = last
+			}
+		}
+		if last != nil {
+			series.Return = analytics.CumulativeReturn([]decimal.Decimal{base, *last}).
+				Round(constant.AnalyticsPlaces)
+		}
+		if common != nil {
+			series.CommonReturn = analytics.CumulativeReturn([]decimal.Decimal{*common, *last}).
+				Round(constant.AnalyticsPlaces)
+		}
+		res.Series[i] = series
+	}
+	return res, nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func (uc *Usecase) Correlation(ctx *gin.Context, req *dto.CorrelationReq) (*dto.CorrelationRes, error) {
+	symbols := uniqueSymbols(req.Symbols)
+	if len(symbols) < 2 {
+		return nil, constant.ErrNotEnoughSymbols
+	}
+	pair := make([]int, 0, 2)
+	if len(req.Pair) > 0 {
+		if len(req.Pair) != 2 || req.Pair[0] == req.Pair[1] {
+			return nil, constant.ErrInvalidPair
+		}
+		for _, symbol := range req.Pair {
+			i := slices.Index(symbols, symbol)
+			if i < 0 {
+				return nil, constant.ErrInvalidPair
+			}
+			pair = append(pair, i)
+		}
+	}
+	window := req.Window
+	if window == 0 {
+		window = constant.DefaultCorrelationWindow
+	}
+	currency, err := currencyCode(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	series := dto.SeriesReq{
+		From:     req.From,
+		To:       req.To,
+		Adjust:   req.Adjust,
+		Interval: req.Interval,
+		Currency: currency,
+	}
+	common, returns, conversions, err := uc.commonReturns(ctx, symbols, &series)
+	if err != nil {
+		return nil, err
+	}
+	req.Adjust, req.Interval = series.Adjust, series.Interval
+
+	res := &dto.CorrelationRes{
+		Symbols:     symbols,
+		Adjust:      req.Adjust,
+		Interval:    req.Interval,
+		From:        common[0],
+		To:          common[len(common)-1],
+		Days:        len(common) - 1,
+		Currency:    currency,
+		Conversions: conversions,
+		Correlation: make([][]*decimal.Decimal, len(symbols)),
+		Covariance:  make([][]decimal.Decimal, len(symbols)),
+	}
+	for i := range symbols {
+		res.Correlation[i] = make([]*decimal.Decimal, len(symbols))
+		res.Covariance[i] = make([]decimal.Decimal, len(symbols))
+		for j := range symbols {
+			res.Correlation[i][j] = rounded(analytics.Correlation(returns[i], returns[j]))
+			res.Covariance[i][j] = analytics.Covariance(returns[i], returns[j]).Round(constant.CovariancePlaces)
+		}
+	}
+
+	if len(pair) == 2 {
+		rolling := analytics.RollingCorrelation(returns[pair[0]], returns[pair[1]], window)
+		res.Rolling = &dto.RollingCorrelationRes{
+			Pair:   []string{symbols[pair[0]], symbols[pair[1]]},
+			Window: window,
+			WarmUp: min(window-1, len(rolling)),
+			Points: make([]dto.RollingPointRes, len(rolling)),
+		}
+		for i, value := range rolling {
+			res.Rolling.Points[i] = dto.RollingPointRes{Date: common[i+1], Value: rounded(value)}
+		}
+	}
+	return res, nil
+}
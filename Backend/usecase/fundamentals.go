@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/provider"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *Usecase) Fundamentals(ctx *gin.Context, req *dto.FundamentalsReq) (*dto.FundamentalsRes, error) {
+	// repo
+	return uc.rp.GetFundamentals(ctx, req.Symbol)
+}
+
+// Fetch the symbol's fundamentals from Alpha Vantage and store them
+func (uc *Usecase) SyncFundamentals(ctx *gin.Context, req *dto.FundamentalsReq) (*dto.FundamentalsRes, error) {
+	_, err := uc.rp.GetSymbol(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	source, ok := uc.providers[provider.AlphaVantageName].(provider.FundamentalSourceItf)
+	if !ok {
+		return nil, constant.ErrUnknownProvider(provider.AlphaVantageName)
+	}
+	err = uc.rp.AddAPICalls(ctx, provider.AlphaVantageName, 1)
+	if err != nil {
+		return nil, err
+	}
+	fundamentals, err := source.Fundamentals(req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	fundamentals.UpdatedAt = time.Now().UTC()
+
+	err = uc.rp.UpsertFundamentals(ctx, fundamentals)
+	if err != nil {
+		return nil, err
+	}
+	return fundamentals, nil
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"Backend/calendar"
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/provider"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func (uc *Usecase) FXRates(ctx *gin.Context, req *dto.FXRatesReq) ([]dto.FXRateRes, error) {
+	err := fxPair(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.From != nil && req.To != nil && req.From.After(*req.To) {
+		return nil, constant.ErrDateRange
+	}
+
+	// repo
+	return uc.rp.FXRates(ctx, req)
+}
+
+// Fetch the pair's daily rates from Alpha Vantage and store them
+func (uc *Usecase) SyncFXRates(ctx *gin.Context, req *dto.FXRatesReq) (*dto.FXSyncRes, error) {
+	err := fxPair(req)
+	if err != nil {
+		return nil, err
+	}
+
+	source, ok := uc.providers[provider.AlphaVantageName].(provider.FXSourceItf)
+	if !ok {
+		return nil, constant.ErrUnknownProvider(provider.AlphaVantageName)
+	}
+	err = uc.rp.AddAPICalls(ctx, provider.AlphaVantageName, 1)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := source.FXDaily(req.Base, req.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.rp.UpsertFXRates(ctx, rates)
+	if err != nil {
+		return nil, err
+	}
+	res := &dto.FXSyncRes{
+		Base:  req.Base,
+		Quote: req.Quote,
+		Rates: len(rates),
+	}
+	if len(rates) > 0 {
+		res.From, res.To = rates[0].Date, rates[len(rates)-1].Date
+	}
+	return res, nil
+}
+
+// Upper-cased three-letter currency code; empty stays empty
+func currencyCode(text string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(text))
+	if code == "" {
+		return "", nil
+	}
+	if len(code) != 3 {
+		return "", constant.ErrInvalidCurrency
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", constant.ErrInvalidCurrency
+		}
+	}
+	return code, nil
+}
+
+func fxPair(req *dto.FXRatesReq) error {
+	base, err := currencyCode(req.Base)
+	if err != nil {
+		return err
+	}
+	quote, err := currencyCode(req.Quote)
+	if err != nil {
+		return err
+	}
+	if base == "" || quote == "" {
+		return constant.ErrInvalidCurrency
+	}
+	if base == quote {
+		return constant.ErrSameCurrency
+	}
+	req.Base, req.Quote = base, quote
+	return nil
+}
+
+// Currency the symbol is quoted in; symbols collected before it was
+// recorded go by their exchange
+func nativeCurrency(meta *dto.SymbolDataMeta) string {
+	if meta.Currency != "" {
+		return meta.Currency
+	}
+	return calendar.Currency(meta.Symbol)
+}
+
+// Currency a fraction of which is quoted, e.g. GBP for GBX, and how
+// many quoted units make one of it
+func majorCurrency(currency string) (string, decimal.Decimal) {
+	if minor, ok := constant.MinorCurrencies[currency]; ok {
+		return minor.Major, decimal.NewFromInt(minor.Units)
+	}
+	return currency, decimal.NewFromInt(1)
+}
+
+// Daily rates turning a price in native into one in currency, sorted by
+// date. A pair stored the other way round is inverted, and fractional
+// currencies go by their major one; between a currency and its own
+// fraction the rate is fixed, so a single undated rate is returned.
+func (uc *Usecase) conversionRates(ctx *gin.Context, native, currency string) ([]dto.FXRateRes, error) {
+	base, baseUnits := majorCurrency(native)
+	quote, quoteUnits := majorCurrency(currency)
+	scale := quoteUnits.Div(baseUnits)
+	if base == quote {
+		return []dto.FXRateRes{{Base: native, Quote: currency, Rate: scale}}, nil
+	}
+
+	rates, err := uc.rp.FXRates(ctx, &dto.FXRatesReq{Base: base, Quote: quote})
+	if err != nil {
+		return nil, err
+	}
+	inverted := false
+	if len(rates) == 0 {
+		rates, err = uc.rp.FXRates(ctx, &dto.FXRatesReq{Base: quote, Quote: base})
+		if err != nil {
+			return nil, err
+		}
+		inverted = true
+	}
+	if len(rates) == 0 {
+		return nil, constant.ErrFXRatesNotFound
+	}
+
+	one := decimal.NewFromInt(1)
+	converted := make([]dto.FXRateRes, 0, len(rates))
+	for _, rate := range rates {
+		value := rate.Rate
+		if inverted {
+			if value.IsZero() {
+				continue
+			}
+			value = one.DivRound(value, constant.InvertedRatePlaces)
+		}
+		converted = append(converted, dto.FXRateRes{
+			Base:  native,
+			Quote: currency,
+			Date:  rate.Date,
+			Rate:  value.Mul(scale),
+		})
+	}
+	return converted, nil
+}
+
+// Bars priced in currency instead of the symbol's native one, each at
+// its day's rate or, on FX holidays, the latest rate before it. The
+// rates used by bars from `from` on are reported; nil when there is
+// nothing to convert.
+func (uc *Usecase) convertBars(ctx *gin.Context, meta *dto.SymbolDataMeta, currency string, bars []dto.DailyOHLCVRes, from *dto.DateOnly) ([]dto.DailyOHLCVRes, *dto.ConversionRes, error) {
+	native := nativeCurrency(meta)
+	if currency == "" || currency == native {
+		return bars, nil, nil
+	}
+	rates, err := uc.conversionRates(ctx, native, currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	fixed := len(rates) == 1 && time.Time(rates[0].Date).IsZero()
+
+	conversion := &dto.ConversionRes{
+		Symbol:   meta.Symbol,
+		Native:   native,
+		Currency: currency,
+		Rates:    make([]dto.ConversionRateRes, 0),
+	}
+	converted := make([]dto.DailyOHLCVRes, len(bars))
+	next := 0
+	for i, bar := range bars {
+		rate := rates[0]
+		if fixed {
+			rate.Date = bar.Day
+		} else {
+			for next < len(rates) && !rates[next].Date.After(bar.Day) {
+				next++
+			}
+			if next == 0 {
+				base, _ := majorCurrency(native)
+				quote, _ := majorCurrency(currency)
+				return nil, nil, constant.ErrNoFXRate(base, quote, bar.Day.String())
+			}
+			rate = rates[next-1]
+		}
+
+		converted[i] = bar
+		converted[i].OHLC = make(map[string]decimal.Decimal, len(bar.OHLC))
+		for key, price := range bar.OHLC {
+			converted[i].OHLC[key] = price.Mul(rate.Rate).
+				Round(constant.ConvertedPricePlaces)
+		}
+		if from == nil || !bar.Day.Before(*from) {
+			conversion.Rates = append(conversion.Rates, dto.ConversionRateRes{
+				Date:     bar.Day,
+				RateDate: rate.Date,
+				Rate:     rate.Rate,
+			})
+		}
+	}
+	return converted, conversion, nil
+}
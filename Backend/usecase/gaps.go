@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"Backend/calendar"
+	"Backend/constant"
+	"Backend/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *Usecase) SymbolGaps(ctx *gin.Context, req *dto.GapsReq) (*dto.SymbolGapsRes, error) {
+	meta, err := uc.rp.GetSymbol(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	gaps, err := uc.symbolGaps(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Refetch {
+		err = uc.refetchGaps(ctx, gaps, req.Provider)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return gaps, nil
+}
+
+func (uc *Usecase) GapsSummary(ctx *gin.Context, req *dto.GapsReq) ([]*dto.SymbolGapsRes, error) {
+	symbols, err := uc.rp.TrackedSymbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := make([]*dto.SymbolGapsRes, 0)
+	for _, meta := range symbols {
+		gaps, err := uc.symbolGaps(ctx, &meta)
+		if err != nil {
+			return nil, err
+		}
+
+		// Ranges are enough for the overview
+		gaps.Missing = nil
+
+		if req.Refetch {
+			err = uc.refetchGaps(ctx, gaps, req.Provider)
+			if err != nil {
+				return nil, err
+			}
+		}
+		summary = append(summary, gaps)
+	}
+	return summary, nil
+}
+
+// Compare stored bars against the trading calendar,
+// from the first stored bar up to the last refresh
+func (uc *Usecase) symbolGaps(ctx *gin.Context, meta *dto.SymbolDataMeta) (*dto.SymbolGapsRes, error) {
+	dates, err := uc.rp.BarDates(ctx, meta.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	gaps := &dto.SymbolGapsRes{
+		Symbol:        meta.Symbol,
+		LastRefreshed: meta.LastRefreshed,
+		Missing:       make([]dto.DateOnly, 0),
+		Ranges:        make([]dto.GapRangeRes, 0),
+	}
+	if len(dates) == 0 {
+		return gaps, nil
+	}
+	first := dates[0]
+	gaps.FirstBar = &first
+
+	stored := make(map[string]bool, len(dates))
+	for _, date := range dates {
+		stored[date.String()] = true
+	}
+
+	// Quarantined bars were fetched already; refetching them would only
+	// quarantine the same bars again
+	held := make(map[string]bool)
+	for _, status := range []string{constant.QuarantinePending, constant.QuarantineRejected} {
+		bars, err := uc.rp.QuarantinedBars(ctx, &dto.QuarantineReq{
+			Symbol: meta.Symbol,
+			Status: status,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, bar := range bars {
+			held[bar.Bar.Day.String()] = true
+		}
+	}
+
+	sessions := calendar.ForSymbol(meta.Symbol).SessionsBetween(first, meta.LastRefreshed)
+	gaps.ExpectedSessions = len(sessions)
+
+	var current *dto.GapRangeRes
+	for _, session := range sessions {
+		if stored[session.String()] || held[session.String()] {
+			if stored[session.String()] {
+				gaps.StoredSessions++
+			} else {
+				gaps.QuarantinedSessions++
+			}
+			if current != nil {
+				gaps.Ranges = append(gaps.Ranges, *current)
+				current = nil
+			}
+			continue
+		}
+
+		gaps.Missing = append(gaps.Missing, session)
+		if current == nil {
+			current = &dto.GapRangeRes{From: session}
+		}
+		current.To = session
+		current.Sessions++
+	}
+	if current != nil {
+		gaps.Ranges = append(gaps.Ranges, *current)
+	}
+	gaps.MissingSessions = len(gaps.Missing)
+
+	return gaps, nil
+}
+
+// Queue one backfill per missing range, unless a queued, running or
+// waiting backfill covers it already; that job is listed instead
+func (uc *Usecase) refetchGaps(ctx *gin.Context, gaps *dto.SymbolGapsRes, provider string) error {
+	active, err := uc.rp.ActiveJobs(ctx, constant.JobTypeBackfill, gaps.Symbol)
+	if err != nil {
+		return err
+	}
+
+	gaps.Jobs = make([]*dto.JobRes, 0)
+	for _, gap := range gaps.Ranges {
+		if job := coveringJob(active, gap); job != nil {
+			gaps.Jobs = append(gaps.Jobs, job)
+			continue
+		}
+
+		from, to := gap.From, gap.To
+		job, err := uc.EnqueueBackfill(ctx, &dto.BackfillReq{
+			Symbol:   gaps.Symbol,
+			From:     &from,
+			To:       &to,
+			Provider: provider,
+		})
+		if err != nil {
+			return err
+		}
+		gaps.Jobs = append(gaps.Jobs, job)
+	}
+	return nil
+}
+
+func coveringJob(jobs []*dto.JobRes, gap dto.GapRangeRes) *dto.JobRes {
+	for _, job := range jobs {
+		if job.From != nil && job.To != nil &&
+			!job.From.After(gap.From) && !job.To.Before(gap.To) {
+			return job
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func (uc *Usecase) Indicators(ctx *gin.Context, req *dto.IndicatorsReq) (*dto.IndicatorsRes, error) {
+	if len(req.Types) == 0 {
+		return nil, constant.ErrNoIndicator
+	}
+	for _, name := range req.Types {
+		if name != constant.IndicatorMACD {
+			if _, ok := constant.DefaultIndicatorPeriods[name]; !ok {
+				return nil, constant.ErrUnknownIndicator(name)
+			}
+		}
+	}
+
+	bars, start, err := uc.loadSeries(ctx, &req.Series)
+	if err != nil {
+		return nil, err
+	}
+	closes := closesOf(bars)
+
+	res := &dto.IndicatorsRes{
+		Symbol:     req.Series.Symbol,
+		Adjust:     req.Series.Adjust,
+		Interval:   req.Series.Interval,
+		Currency:   req.Series.Currency,
+		Conversion: req.Series.Conversion,
+		Indicators: make([]dto.IndicatorRes, 0, len(req.Types)),
+		Points:     make([]dto.IndicatorPointRes, 0, len(bars)-start),
+	}
+	for _, bar := range bars[start:] {
+		res.Points = append(res.Points, dto.IndicatorPointRes{
+			Date:   bar.Day,
+			Close:  bar.OHLC["close"],
+			Values: make(map[string]*decimal.Decimal),
+		})
+	}
+
+	for _, name := range req.Types {
+		period := req.Period
+		if period == 0 {
+			period = constant.DefaultIndicatorPeriods[name]
+		}
+
+		indicator := dto.IndicatorRes{
+			Name:   name,
+			Params: map[string]int{"period": period},
+		}
+		series := make(map[string][]*decimal.Decimal)
+		switch name {
+		case constant.IndicatorSMA:
+			series["sma"] = analytics.SMA(closes, period)
+		case constant.IndicatorEMA:
+			series["ema"] = analytics.EMA(closes, period)
+		case constant.IndicatorRSI:
+			series["rsi"] = analytics.RSI(closes, period)
+		case constant.IndicatorMACD:
+			indicator.Params = map[string]int{
+				"fast":   constant.MACDFast,
+				"slow":   constant.MACDSlow,
+				"signal": constant.MACDSignal,
+			}
+			series["macd"], series["macd_signal"], series["macd_histogram"] = analytics.MACD(
+				closes, constant.MACDFast, constant.MACDSlow, constant.MACDSignal)
+		case constant.IndicatorBBands:
+			series["bbands_middle"], series["bbands_upper"], series["bbands_lower"] = analytics.BollingerBands(
+				closes, period, decimal.NewFromInt(constant.BBandsWidth))
+		}
+
+		// Warm-up lasts until every value of the indicator is there
+		for key, values := range series {
+			indicator.Keys = append(indicator.Keys, key)
+			warmUp := 0
+			for i, value := range values[start:] {
+				res.Points[i].Values[key] = rounded(value)
+				if value == nil {
+					warmUp = i + 1
+				}
+			}
+			indicator.WarmUp = max(indicator.WarmUp, warmUp)
+		}
+		sort.Strings(indicator.Keys)
+		res.Indicators = append(res.Indicators, indicator)
+	}
+	return res, nil
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *Usecase) EnqueueCollect(ctx *gin.Context, req *dto.CollectSymbolReq) (*dto.JobRes, error) {
+	job, err, _ := uc.collects.Do("enqueue:"+strings.ToUpper(req.Symbol), func() (any, error) {
+		shared, cancel := util.NewDetachedContext(ctx, constant.JobTimeout)
+		defer cancel()
+		return uc.enqueueCollect(shared, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job.(*dto.JobRes), nil
+}
+
+func (uc *Usecase) enqueueCollect(ctx *gin.Context, req *dto.CollectSymbolReq) (*dto.JobRes, error) {
+	// Fail fast rather than queueing a job that can only fail
+	exists, err := uc.rp.CheckSymbolExists(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, constant.ErrStockAlready
+	}
+
+	// A collection already on its way is shared, not repeated
+	job, err := uc.rp.ActiveJob(ctx, constant.JobTypeCollect, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	if job != nil {
+		return job, nil
+	}
+
+	// repo
+	return uc.rp.InsertJob(ctx, &dto.JobReq{
+		Type:   constant.JobTypeCollect,
+		Symbol: req.Symbol,
+	})
+}
+
+func (uc *Usecase) GetJob(ctx *gin.Context, req *dto.GetJobReq) (*dto.JobRes, error) {
+	// repo
+	return uc.rp.GetJob(ctx, req)
+}
+
+func (uc *Usecase) ClaimJob(ctx *gin.Context) (*dto.JobRes, error) {
+	// repo
+	return uc.rp.ClaimJob(ctx)
+}
+
+func (uc *Usecase) RequeueJobs(ctx *gin.Context) (int64, error) {
+	// repo
+	return uc.rp.RequeueRunningJobs(ctx, constant.JobMaxAttempts,
+		jobError(constant.ErrJobInterrupted))
+}
+
+// Run a claimed job and record its outcome
+func (uc *Usecase) RunJob(ctx *gin.Context, job *dto.JobRes) error {
+	result, err := uc.runJob(ctx, job)
+	job.Error = nil
+	job.ResumeAt = nil
+
+	now := time.Now().UTC()
+	switch {
+	case errors.Is(err, constant.ErrAPIExceed):
+		// Out of quota; wait for it to reset and carry on from there
+		resumeAt := now.Truncate(24*time.Hour).AddDate(0, 0, 1)
+		job.Status = constant.JobWaiting
+		job.Error = jobError(err)
+		job.ResumeAt = &resumeAt
+	case errors.Is(err, constant.ErrWebhookFailed) && job.Attempts < constant.WebhookMaxAttempts:
+		// Receiver unreachable or erroring; try again, backing off each time
+		resumeAt := now.Add(constant.WebhookRetryDelay << max(job.Attempts-1, 0))
+		job.Status = constant.JobWaiting
+		job.Error = jobError(err)
+		job.ResumeAt = &resumeAt
+	case err != nil:
+		job.Status = constant.JobFailed
+		job.Error = jobError(err)
+		job.FinishedAt = &now
+	default:
+		job.Status = constant.JobSucceeded
+		job.Result = result
+		job.FinishedAt = &now
+	}
+
+	// repo
+	return uc.rp.UpdateJob(ctx, job)
+}
+
+func (uc *Usecase) runJob(ctx *gin.Context, job *dto.JobRes) (map[string]any, error) {
+	switch job.Type {
+	case constant.JobTypeCollect:
+		stockData, err := uc.CollectSymbol(ctx,
+			&dto.CollectSymbolReq{Symbol: job.Symbol})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{
+			"symbol":         stockData.MetaData.Symbol,
+			"last_refreshed": stockData.MetaData.LastRefreshed.String(),
+			"size":           stockData.MetaData.Size,
+			"quarantined":    stockData.MetaData.Quarantined,
+		}, nil
+	case constant.JobTypeBackfill:
+		return uc.Backfill(ctx, job)
+	case constant.JobTypeAlert:
+		return uc.DeliverAlert(ctx, job)
+	}
+	return nil, fmt.Errorf("unknown job type %q", job.Type)
+}
+
+// Keep the status code of custom errors, so clients see the same
+// error a synchronous request would have given them
+func jobError(err error) *dto.JobErrorRes {
+	var ce constant.CustomError
+	if errors.As(err, &ce) {
+		return &dto.JobErrorRes{
+			StatusCode: ce.StatusCode,
+			Message:    ce.Error(),
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &dto.JobErrorRes{
+			StatusCode: http.StatusGatewayTimeout,
+			Message:    err.Error(),
+		}
+	}
+	return &dto.JobErrorRes{
+		StatusCode: http.StatusInternalServerError,
+		Message:    err.Error(),
+	}
+}
@@ -0,0 +1,276 @@
+package usecase
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/ledger"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func validTransaction(req *dto.TransactionReq) error {
+	if time.Time(req.Date).IsZero() {
+		return constant.ErrInvalidTransaction
+	}
+	if req.Symbol == "" && req.Type != constant.TransactionFee {
+		return constant.ErrInvalidTransaction
+	}
+	var valid bool
+	switch req.Type {
+	case constant.TransactionBuy, constant.TransactionSell:
+		valid = req.Quantity.IsPositive() && req.Price.IsPositive()
+	case constant.TransactionDividend, constant.TransactionFee:
+		valid = req.Amount.IsPositive()
+	case constant.TransactionSplit:
+		valid = req.Ratio.IsPositive()
+	default:
+		return constant.ErrInvalidTransactionType
+	}
+	if !valid {
+		return constant.ErrInvalidTransaction
+	}
+	return nil
+}
+
+func lotMethod(method string) (string, error) {
+	if method == "" {
+		return constant.LotFIFO, nil
+	}
+	if !ledger.ValidMethod(method) {
+		return "", constant.ErrInvalidLotMethod
+	}
+	return method, nil
+}
+
+// Replay a portfolio's ledger, after checking the portfolio exists
+func (uc *Usecase) replayLedger(ctx *gin.Context, portfolioId string, method string) (*ledger.Book, error) {
+	req := dto.GetPortfolioReq{Id: portfolioId}
+	_, err := uc.rp.GetPortfolio(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := uc.rp.Transactions(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return ledger.Replay(transactions, method)
+}
+
+func (uc *Usecase) AddTransaction(ctx *gin.Context, req *dto.TransactionReq) (*dto.TransactionRes, error) {
+	err := validTransaction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// A sale must be covered by what the ledger holds by then
+	portfolio := dto.GetPortfolioReq{Id: req.PortfolioId}
+	_, err = uc.rp.GetPortfolio(ctx, &portfolio)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := uc.rp.Transactions(ctx, &portfolio)
+	if err != nil {
+		return nil, err
+	}
+	_, err = ledger.Replay(append(transactions, dto.TransactionRes{
+		Symbol:   req.Symbol,
+		Type:     req.Type,
+		Date:     req.Date,
+		Quantity: req.Quantity,
+		Price:    req.Price,
+		Amount:   req.Amount,
+		Ratio:    req.Ratio,
+	}), constant.LotFIFO)
+	if err != nil {
+		return nil, err
+	}
+
+	// repo
+	transaction, err := uc.rp.InsertTransaction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Symbol != "" {
+		jobs, err := uc.collectUntracked(ctx, req.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		if len(jobs) > 0 {
+			transaction.Collecting = jobs[0]
+		}
+	}
+	return transaction, nil
+}
+
+func (uc *Usecase) Transactions(ctx *gin.Context, req *dto.GetPortfolioReq) ([]dto.TransactionRes, error) {
+	_, err := uc.rp.GetPortfolio(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// repo
+	return uc.rp.Transactions(ctx, req)
+}
+
+func (uc *Usecase) DeleteTransaction(ctx *gin.Context, req *dto.GetTransactionReq) error {
+	// Removing a purchase mustn't leave a later sale uncovered
+	transactions, err := uc.rp.Transactions(ctx, &dto.GetPortfolioReq{Id: req.PortfolioId})
+	if err != nil {
+		return err
+	}
+	remaining := make([]dto.TransactionRes, 0, len(transactions))
+	for _, transaction := range transactions {
+		if transaction.Id != req.Id {
+			remaining = append(remaining, transaction)
+		}
+	}
+	if len(remaining) == len(transactions) {
+		return constant.ErrTransactionNotFound
+	}
+	_, err = ledger.Replay(remaining, constant.LotFIFO)
+	if err != nil {
+		return err
+	}
+
+	// repo
+	return uc.rp.DeleteTransaction(ctx, req)
+}
+
+func (uc *Usecase) Lots(ctx *gin.Context, req *dto.LotsReq) (*dto.LotsRes, error) {
+	method, err := lotMethod(req.Method)
+	if err != nil {
+		return nil, err
+	}
+	book, err := uc.replayLedger(ctx, req.PortfolioId, method)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make([]string, 0, len(book.Open))
+	for _, lot := range book.Open {
+		symbols = append(symbols, lot.Symbol)
+	}
+	currencies, err := uc.symbolCurrencies(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.LotsRes{
+		PortfolioId: req.PortfolioId,
+		Method:      method,
+		Lots:        book.Open,
+		Totals:      make(map[string]dto.LotsTotalRes),
+	}
+
+	// Mark each lot to its symbol's latest stored close
+	latest := make(map[string]*dto.DailyOHLCVRes)
+	tomorrow := dto.NewDateOnly(time.Now().UTC()).AddDate(0, 0, 1)
+	for i := range res.Lots {
+		lot := &res.Lots[i]
+		lot.Currency = currencies[lot.Symbol]
+		bar, ok := latest[lot.Symbol]
+		if !ok {
+			bar, err = uc.rp.LatestBarBefore(ctx, lot.Symbol, tomorrow)
+			if err != nil {
+				return nil, err
+			}
+			latest[lot.Symbol] = bar
+		}
+		if bar == nil {
+			continue
+		}
+		close := bar.OHLC["close"]
+		value := lot.Quantity.Mul(close)
+		unrealized := value.Sub(lot.Cost)
+		lot.Close, lot.CloseDate = &close, &bar.Day
+		lot.MarketValue, lot.Unrealized = &value, &unrealized
+
+		total := res.Totals[lot.Currency]
+		total.Cost = total.Cost.Add(lot.Cost)
+		total.MarketValue = total.MarketValue.Add(value)
+		total.Unrealized = total.Unrealized.Add(unrealized)
+		res.Totals[lot.Currency] = total
+	}
+	return res, nil
+}
+
+func (uc *Usecase) Realized(ctx *gin.Context, req *dto.LotsReq) (*dto.RealizedRes, error) {
+	method, err := lotMethod(req.Method)
+	if err != nil {
+		return nil, err
+	}
+	book, err := uc.replayLedger(ctx, req.PortfolioId, method)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make([]string, 0, len(book.Open)+len(book.Closed)+len(book.Dividends)+len(book.Fees))
+	for _, lot := range book.Open {
+		symbols = append(symbols, lot.Symbol)
+	}
+	for _, lot := range book.Closed {
+		symbols = append(symbols, lot.Symbol)
+	}
+	for symbol := range book.Dividends {
+		symbols = append(symbols, symbol)
+	}
+	for symbol := range book.Fees {
+		if symbol != "" {
+			symbols = append(symbols, symbol)
+		}
+	}
+	currencies, err := uc.symbolCurrencies(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.RealizedRes{
+		PortfolioId: req.PortfolioId,
+		Method:      method,
+		Closed:      book.Closed,
+		Dividends:   book.Dividends,
+		Totals:      make(map[string]dto.RealizedTotalRes),
+	}
+	for i := range res.Closed {
+		lot := &res.Closed[i]
+		lot.Currency = currencies[lot.Symbol]
+		total := res.Totals[lot.Currency]
+		total.Gain = total.Gain.Add(lot.Gain)
+		res.Totals[lot.Currency] = total
+	}
+	for symbol, amount := range book.Dividends {
+		total := res.Totals[currencies[symbol]]
+		total.Dividends = total.Dividends.Add(amount)
+		res.Totals[currencies[symbol]] = total
+	}
+	for symbol, amount := range book.Fees {
+		if symbol == "" {
+			continue
+		}
+		total := res.Totals[currencies[symbol]]
+		total.Fees = total.Fees.Add(amount)
+		res.Totals[currencies[symbol]] = total
+	}
+	// A fee on the whole portfolio has no currency of its own, so it
+	// only counts where the ledger has a single one
+	res.UnassignedFees = book.Fees[""]
+	ledgerCurrencies := make(map[string]bool)
+	for _, currency := range currencies {
+		ledgerCurrencies[currency] = true
+	}
+	if len(ledgerCurrencies) == 1 && !res.UnassignedFees.IsZero() {
+		for currency := range ledgerCurrencies {
+			total := res.Totals[currency]
+			total.Fees = total.Fees.Add(res.UnassignedFees)
+			res.Totals[currency] = total
+		}
+		res.UnassignedFees = decimal.Zero
+	}
+	for currency, total := range res.Totals {
+		total.Net = total.Gain.Add(total.Dividends).Sub(total.Fees)
+		res.Totals[currency] = total
+	}
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/pattern"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *Usecase) Patterns(ctx *gin.Context, req *dto.PatternsReq) (*dto.PatternsRes, error) {
+	for _, name := range req.Types {
+		if !slices.Contains(constant.Patterns, name) {
+			return nil, constant.ErrUnknownPattern(name)
+		}
+	}
+	types := req.Types
+	if len(types) == 0 {
+		types = constant.Patterns
+	}
+
+	bars, start, err := uc.loadSeries(ctx, &req.Series)
+	if err != nil {
+		return nil, err
+	}
+	// Candles before the window still complete patterns ending in it
+	found := pattern.Detect(bars, types)
+
+	res := &dto.PatternsRes{
+		Symbol:   req.Series.Symbol,
+		Adjust:   req.Series.Adjust,
+		Interval: req.Series.Interval,
+		Currency: req.Series.Currency,
+		Types:    types,
+		Counts:   make(map[string]int, len(types)),
+		Dates:    make([]dto.PatternDateRes, 0),
+	}
+	for _, name := range types {
+		res.Counts[name] = 0
+	}
+	for i, bar := range bars[start:] {
+		patterns := found[start+i]
+		if len(patterns) == 0 {
+			continue
+		}
+		for _, p := range patterns {
+			res.Counts[p.Name]++
+		}
+		res.Dates = append(res.Dates, dto.PatternDateRes{
+			Date:     bar.Day,
+			Close:    bar.OHLC["close"],
+			Patterns: patterns,
+		})
+	}
+	return res, nil
+}
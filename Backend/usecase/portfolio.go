@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"Backend/calendar"
+	"Backend/constant"
+	"Backend/dto"
+	"errors"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func validHoldings(holdings []dto.HoldingRes) error {
+	seen := make(map[string]bool, len(holdings))
+	for _, holding := range holdings {
+		if holding.Symbol == "" || seen[holding.Symbol] || !holding.Quantity.IsPositive() {
+			return constant.ErrInvalidHolding
+		}
+		seen[holding.Symbol] = true
+	}
+	return nil
+}
+
+// Queue collection of the symbols that aren't tracked yet
+func (uc *Usecase) collectUntracked(ctx *gin.Context, symbols ...string) ([]*dto.JobRes, error) {
+	jobs := make([]*dto.JobRes, 0)
+	for _, symbol := range symbols {
+		req := dto.CollectSymbolReq{Symbol: symbol}
+		exists, err := uc.rp.CheckSymbolExists(ctx, &req)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+		job, err := uc.EnqueueCollect(ctx, &req)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func holdingSymbols(holdings []dto.HoldingRes) []string {
+	symbols := make([]string, len(holdings))
+	for i, holding := range holdings {
+		symbols[i] = holding.Symbol
+	}
+	return symbols
+}
+
+// Currency the symbol is priced in, going by its exchange until it's
+// tracked
+func (uc *Usecase) symbolCurrency(ctx *gin.Context, symbol string) (string, error) {
+	meta, err := uc.rp.GetSymbol(ctx, symbol)
+	if errors.Is(err, constant.ErrSymbolNotTracked) {
+		return calendar.Currency(symbol), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return nativeCurrency(meta), nil
+}
+
+// Currency each of the symbols is priced in
+func (uc *Usecase) symbolCurrencies(ctx *gin.Context, symbols []string) (map[string]string, error) {
+	currencies := make(map[string]string, len(symbols))
+	for _, symbol := range symbols {
+		if _, ok := currencies[symbol]; ok {
+			continue
+		}
+		currency, err := uc.symbolCurrency(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+		currencies[symbol] = currency
+	}
+	return currencies, nil
+}
+
+func (uc *Usecase) CreatePortfolio(ctx *gin.Context, req *dto.PortfolioReq) (*dto.PortfolioRes, error) {
+	err := validHoldings(req.Holdings)
+	if err != nil {
+		return nil, err
+	}
+
+	// repo
+	portfolio, err := uc.rp.InsertPortfolio(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	portfolio.Collecting, err = uc.collectUntracked(ctx, holdingSymbols(req.Holdings)...)
+	if err != nil {
+		return nil, err
+	}
+	return portfolio, nil
+}
+
+func (uc *Usecase) Portfolios(ctx *gin.Context) ([]*dto.PortfolioRes, error) {
+	// repo
+	return uc.rp.Portfolios(ctx)
+}
+
+func (uc *Usecase) GetPortfolio(ctx *gin.Context, req *dto.GetPortfolioReq) (*dto.PortfolioRes, error) {
+	// repo
+	return uc.rp.GetPortfolio(ctx, req)
+}
+
+func (uc *Usecase) UpdatePortfolio(ctx *gin.Context, req *dto.PortfolioReq) (*dto.PortfolioRes, error) {
+	err := validHoldings(req.Holdings)
+	if err != nil {
+		return nil, err
+	}
+
+	// repo
+	portfolio, err := uc.rp.UpdatePortfolio(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	portfolio.Collecting, err = uc.collectUntracked(ctx, holdingSymbols(req.Holdings)...)
+	if err != nil {
+		return nil, err
+	}
+	return portfolio, nil
+}
+
+func (uc *Usecase) DeletePortfolio(ctx *gin.Context, req *dto.GetPortfolioReq) error {
+	// repo
+	return uc.rp.DeletePortfolio(ctx, req)
+}
+
+func (uc *Usecase) ValuePortfolio(ctx *gin.Context, req *dto.ValuationReq) (*dto.ValuationRes, error) {
+	currency, err := currencyCode(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	portfolio, err := uc.rp.GetPortfolio(ctx, &dto.GetPortfolioReq{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	res := &dto.ValuationRes{
+		Id:     portfolio.Id,
+		Name:   portfolio.Name,
+		Points: make([]dto.ValuationPointRes, 0),
+	}
+
+	// Closes per valued holding by date, and every date any traded on;
+	// points start on the first date in the window with every close known
+	var valued []dto.HoldingRes
+	var closes []map[string]decimal.Decimal
+	var first, windowStart dto.DateOnly
+	dates := make(map[string]dto.DateOnly)
+	for _, holding := range portfolio.Holdings {
+		series := dto.SeriesReq{
+			Symbol:   holding.Symbol,
+			From:     req.From,
+			To:       req.To,
+			Adjust:   req.Adjust,
+			Interval: req.Interval,
+			Currency: currency,
+		}
+		bars, start, err := uc.loadSeries(ctx, &series)
+		if errors.Is(err, constant.ErrSymbolNotTracked) || errors.Is(err, constant.ErrNoBars) {
+			res.Pending = append(res.Pending, holding.Symbol)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Without a currency asked, the rest are converted into the
+		// first valued holding's own
+		currency = series.Currency
+		res.Adjust, res.Interval, res.Currency = series.Adjust, series.Interval, series.Currency
+		if series.Conversion != nil {
+			res.Conversions = append(res.Conversions, *series.Conversion)
+		}
+
+		if len(valued) == 0 || bars[start].Day.Before(windowStart) {
+			windowStart = bars[start].Day
+		}
+		// Keep the close before the window so it can be carried forward
+		if start > 0 {
+			start--
+		}
+		byDate := make(map[string]decimal.Decimal, len(bars)-start)
+		for _, bar := range bars[start:] {
+			byDate[bar.Day.String()] = bar.OHLC["close"]
+			dates[bar.Day.String()] = bar.Day
+		}
+		if first.Before(bars[start].Day) {
+			first = bars[start].Day
+		}
+		valued = append(valued, holding)
+		closes = append(closes, byDate)
+	}
+	if len(valued) == 0 {
+		return res, nil
+	}
+
+	sorted := make([]dto.DateOnly, 0, len(dates))
+	for _, date := range dates {
+		sorted = append(sorted, date)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+
+	last := make([]decimal.Decimal, len(valued))
+	var initial []decimal.Decimal
+	initialTotal := decimal.Zero
+	for _, date := range sorted {
+		for i := range valued {
+			if close, ok := closes[i][date.String()]; ok {
+				last[i] = close
+			}
+		}
+		if date.Before(first) || date.Before(windowStart) {
+			continue
+		}
+
+		values := make([]decimal.Decimal, len(valued))
+		total := decimal.Zero
+		for i, holding := range valued {
+			values[i] = holding.Quantity.Mul(last[i])
+			total = total.Add(values[i])
+		}
+		if initial == nil {
+			initial, initialTotal = values, total
+		}
+
+		point := dto.ValuationPointRes{
+			Date:     date,
+			Value:    total.Round(constant.AnalyticsPlaces),
+			Holdings: make(map[string]dto.HoldingValueRes, len(valued)),
+		}
+		if !initialTotal.IsZero() {
+			point.Return = total.Div(initialTotal).Sub(decimal.NewFromInt(1)).
+				Round(constant.AnalyticsPlaces)
+		}
+		for i, holding := range valued {
+			value := dto.HoldingValueRes{
+				Quantity: holding.Quantity,
+				Close:    last[i],
+				Value:    values[i].Round(constant.AnalyticsPlaces),
+			}
+			if !total.IsZero() {
+				value.Weight = values[i].Div(total).Round(constant.AnalyticsPlaces)
+			}
+			if !initialTotal.IsZero() {
+				value.Contribution = values[i].Sub(initial[i]).Div(initialTotal).
+					Round(constant.AnalyticsPlaces)
+			}
+			point.Holdings[holding.Symbol] = value
+		}
+		res.Points = append(res.Points, point)
+	}
+	return res, nil
+}
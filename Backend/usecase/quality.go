@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/quality"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func maxDailyJump() decimal.Decimal {
+	jump, err := decimal.NewFromString(os.Getenv("MAX_DAILY_JUMP"))
+	if err != nil || jump.IsNegative() {
+		return decimal.RequireFromString(constant.DefaultMaxDailyJump)
+	}
+	return jump
+}
+
+// Quarantine the bars failing validation and return the rest;
+// prev is the latest stored bar before them, if any
+func (uc *Usecase) screenBars(ctx *gin.Context, symbol string, prev *dto.DailyOHLCVRes, bars []dto.DailyOHLCVRes) ([]dto.DailyOHLCVRes, int, error) {
+	// Stored splits explain their day's jump
+	actions, err := uc.rp.CorporateActions(ctx, symbol)
+	if err != nil {
+		return nil, 0, err
+	}
+	good, flagged := quality.Split(bars, prev, maxDailyJump(), actions)
+	if len(flagged) == 0 {
+		return good, 0, nil
+	}
+
+	quarantined := make([]dto.QuarantinedBarRes, len(flagged))
+	for i, bar := range flagged {
+		quarantined[i] = dto.QuarantinedBarRes{
+			Symbol:  symbol,
+			Bar:     bar.Bar,
+			Reasons: bar.Reasons,
+		}
+	}
+	err = uc.rp.InsertQuarantine(ctx, quarantined)
+	if err != nil {
+		return nil, 0, err
+	}
+	return good, len(flagged), nil
+}
+
+func (uc *Usecase) QuarantinedBars(ctx *gin.Context, req *dto.QuarantineReq) ([]*dto.QuarantinedBarRes, error) {
+	switch req.Status {
+	case "":
+		req.Status = constant.QuarantinePending
+	case constant.QuarantinePending, constant.QuarantineAccepted, constant.QuarantineRejected:
+	default:
+		return nil, constant.ErrInvalidQuarantineStatus
+	}
+
+	// repo
+	return uc.rp.QuarantinedBars(ctx, req)
+}
+
+// Accepting stores the bar as it is; rejecting only records the decision
+func (uc *Usecase) ReviewQuarantined(ctx *gin.Context, req *dto.ReviewQuarantineReq) (*dto.QuarantinedBarRes, error) {
+	bar, err := uc.rp.GetQuarantined(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if bar.Status != constant.QuarantinePending {
+		return nil, constant.ErrQuarantineReviewed
+	}
+
+	if !req.Accept {
+		err = uc.rp.ReviewQuarantined(ctx, req.Id, constant.QuarantineRejected)
+		if err != nil {
+			return nil, err
+		}
+		return reviewed(bar, constant.QuarantineRejected), nil
+	}
+
+	meta, err := uc.rp.GetSymbol(ctx, bar.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mark it first, so two reviewers cannot both insert the bar
+	err = uc.rp.ReviewQuarantined(ctx, req.Id, constant.QuarantineAccepted)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.rp.InsertBars(ctx, &dto.DataPerSymbol{
+		MetaData:   meta,
+		TimeSeries: []dto.DailyOHLCVRes{bar.Bar},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if bar.Bar.Day.After(meta.LastRefreshed) {
+		meta.LastRefreshed = bar.Bar.Day
+		err = uc.rp.UpdateLastRefreshed(ctx, meta)
+		if err != nil {
+			return nil, err
+		}
+		uc.checkAlerts(ctx, bar.Symbol)
+	}
+	return reviewed(bar, constant.QuarantineAccepted), nil
+}
+
+func reviewed(bar *dto.QuarantinedBarRes, status string) *dto.QuarantinedBarRes {
+	now := time.Now().UTC()
+	bar.Status = status
+	bar.ReviewedAt = &now
+	return bar
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"Backend/calendar"
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/provider"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (uc *Usecase) RefreshSymbol(ctx *gin.Context, req *dto.RefreshSymbolReq) (*dto.RefreshSymbolRes, error) {
+	// Retrieve data from Alpha Vantage API
+	alphaData, err := uc.FetchAlphaDaily(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	lastRefreshed, err := provider.ParseAlphaDate(
+		alphaData.MetaData.LastRefreshed, alphaData.MetaData.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.RefreshSymbolRes{
+		Symbol:        req.Symbol,
+		LastRefreshed: req.LastRefreshed,
+	}
+
+	// Nothing new since the previous refresh
+	if !lastRefreshed.After(req.LastRefreshed) {
+		return res, nil
+	}
+
+	// Only keep the bars after what is already stored
+	timeSeries, err := uc.ParseTimeSeries(ctx, alphaData,
+		req.LastRefreshed.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	// Bars failing validation are quarantined instead of stored
+	if len(timeSeries) > 0 {
+		prev, err := uc.rp.LatestBarBefore(ctx, req.Symbol, timeSeries[0].Day)
+		if err != nil {
+			return nil, err
+		}
+		timeSeries, res.Quarantined, err = uc.screenBars(ctx, req.Symbol, prev, timeSeries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	metaData := &dto.SymbolDataMeta{
+		Symbol:        req.Symbol,
+		LastRefreshed: lastRefreshed,
+		Size:          len(timeSeries),
+	}
+	err = uc.rp.InsertBars(ctx, &dto.DataPerSymbol{
+		MetaData: metaData, TimeSeries: timeSeries})
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.rp.UpdateLastRefreshed(ctx, metaData)
+	if err != nil {
+		return nil, err
+	}
+	if len(timeSeries) > 0 {
+		uc.checkAlerts(ctx, req.Symbol)
+	}
+
+	res.LastRefreshed = lastRefreshed
+	res.Added = len(timeSeries)
+	return res, nil
+}
+
+func (uc *Usecase) RefreshTracked(ctx *gin.Context, req *dto.RefreshTrackedReq) (*dto.SchedulerRunRes, error) {
+	now := time.Now().UTC()
+	today := now.Truncate(24 * time.Hour)
+
+	// Work out how many API calls are left today, counting every
+	// request made to Alpha Vantage, not just the scheduler's
+	used, err := uc.rp.APICallsSince(ctx, provider.AlphaVantageName, today)
+	if err != nil {
+		return nil, err
+	}
+	run := &dto.SchedulerRunRes{
+		Trigger:   req.Trigger,
+		StartedAt: now,
+		Budget:    max(alphaDailyQuota()-used, 0),
+		Refreshed: make([]string, 0),
+		UpToDate:  make([]string, 0),
+		Skipped:   make([]string, 0),
+		Failures:  make([]dto.RefreshFailure, 0),
+	}
+
+	// Tracked symbols come stalest first,
+	// so they are the first to get the remaining quota
+	symbols, err := uc.rp.TrackedSymbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exhausted := false
+	for _, symbol := range symbols {
+		// Up to date means having the latest session's bar,
+		// counting from today where the exchange is
+		cal := calendar.ForSymbol(symbol.Symbol)
+		latest := dto.NewDateOnly(now.In(cal.Location()))
+		if !cal.IsTradingDay(latest) {
+			latest = cal.PrevSession(latest)
+		}
+		if !symbol.LastRefreshed.Before(latest) {
+			run.UpToDate = append(run.UpToDate, symbol.Symbol)
+			continue
+		}
+		if exhausted || run.APICalls >= run.Budget {
+			run.Skipped = append(run.Skipped, symbol.Symbol)
+			continue
+		}
+
+		run.APICalls++
+		_, err := uc.RefreshSymbol(ctx, &dto.RefreshSymbolReq{
+			Symbol:        symbol.Symbol,
+			LastRefreshed: symbol.LastRefreshed,
+		})
+		if err != nil {
+			run.Failures = append(run.Failures, dto.RefreshFailure{
+				Symbol:  symbol.Symbol,
+				Message: err.Error(),
+			})
+
+			// Provider says quota is gone, regardless of our own count
+			if errors.Is(err, constant.ErrAPIExceed) {
+				exhausted = true
+			}
+			continue
+		}
+		run.Refreshed = append(run.Refreshed, symbol.Symbol)
+	}
+	run.FinishedAt = time.Now().UTC()
+
+	// Persist run history
+	err = uc.rp.InsertSchedulerRun(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+func (uc *Usecase) SchedulerRuns(ctx *gin.Context) ([]*dto.SchedulerRunRes, error) {
+	// repo
+	return uc.rp.SchedulerRuns(ctx, constant.SchedulerRunsShown)
+}
+
+func alphaDailyQuota() int {
+	quota, err := strconv.Atoi(os.Getenv("ALPHA_VANTAGE_DAILY_QUOTA"))
+	if err != nil || quota <= 0 {
+		return constant.DefaultAlphaDailyQuota
+	}
+	return quota
+}
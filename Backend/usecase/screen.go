@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/screen"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// Evaluate the conditions against the latest bars of every tracked symbol
+func (uc *Usecase) Screen(ctx *gin.Context, req *dto.ScreenReq) (*dto.ScreenRes, error) {
+	if req.Logic == "" {
+		req.Logic = constant.ScreenAnd
+	}
+	if req.Logic != constant.ScreenAnd && req.Logic != constant.ScreenOr {
+		return nil, constant.ErrInvalidScreenLogic
+	}
+	mode, err := adjustMode(req.Adjust)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]string, 0, len(req.Conditions))
+	for i := range req.Conditions {
+		cond := &req.Conditions[i]
+		err = screen.Normalize(cond)
+		if err != nil {
+			return nil, err
+		}
+		if column := screen.Column(cond); column != "" && !slices.Contains(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+	err = screenSort(req, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols, err := uc.rp.TrackedSymbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fundamentals, err := uc.rp.AllFundamentals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.ScreenRes{
+		Logic:    req.Logic,
+		Columns:  columns,
+		Sort:     req.Sort,
+		Order:    req.Order,
+		Screened: len(symbols),
+		Matches:  make([]dto.ScreenMatchRes, 0),
+	}
+	for _, symbol := range symbols {
+		bars, err := uc.rp.SymbolBars(ctx, symbol.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		if len(bars) == 0 {
+			continue
+		}
+		bars, err = uc.adjustBars(ctx, symbol.Symbol, bars, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		match := dto.ScreenMatchRes{
+			Symbol: symbol.Symbol,
+			Date:   bars[len(bars)-1].Day,
+			Sector: fundamentals[symbol.Symbol].Sector,
+			Values: make(map[string]*decimal.Decimal, len(columns)),
+		}
+		matched := req.Logic == constant.ScreenAnd
+		for i := range req.Conditions {
+			cond := &req.Conditions[i]
+			value, holds := screen.Evaluate(cond, bars, match.Sector)
+			if column := screen.Column(cond); column != "" {
+				match.Values[column] = value
+			}
+			if req.Logic == constant.ScreenAnd {
+				matched = matched && holds
+			} else {
+				matched = matched || holds
+			}
+		}
+		if matched {
+			res.Matches = append(res.Matches, match)
+		}
+	}
+
+	sortMatches(res.Matches, req.Sort, req.Order)
+	res.Matched = len(res.Matches)
+	if req.Limit > 0 && len(res.Matches) > req.Limit {
+		res.Matches = res.Matches[:req.Limit]
+	}
+	return res, nil
+}
+
+// Symbols sort ascending by default, values descending
+func screenSort(req *dto.ScreenReq, columns []string) error {
+	if req.Sort == "" {
+		req.Sort = "symbol"
+	}
+	if req.Sort != "symbol" && req.Sort != constant.ScreenSector && !slices.Contains(columns, req.Sort) {
+		return constant.ErrInvalidScreenSort
+	}
+	if req.Order == "" {
+		req.Order = constant.SortDesc
+		if req.Sort == "symbol" || req.Sort == constant.ScreenSector {
+			req.Order = constant.SortAsc
+		}
+	}
+	if req.Order != constant.SortAsc && req.Order != constant.SortDesc {
+		return constant.ErrInvalidScreenSort
+	}
+	return nil
+}
+
+// Missing values go last whatever the order; ties keep symbol order
+func sortMatches(matches []dto.ScreenMatchRes, column, order string) {
+	compare := func(a, b *dto.ScreenMatchRes) int {
+		switch column {
+		case "symbol":
+			return strings.Compare(a.Symbol, b.Symbol)
+		case constant.ScreenSector:
+			return strings.Compare(a.Sector, b.Sector)
+		}
+		return a.Values[column].Cmp(*b.Values[column])
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := &matches[i], &matches[j]
+		if column != "symbol" && column != constant.ScreenSector {
+			if a.Values[column] == nil || b.Values[column] == nil {
+				return a.Values[column] != nil && b.Values[column] == nil
+			}
+		}
+		if cmp := compare(a, b); cmp != 0 {
+			return (cmp < 0) == (order == constant.SortAsc)
+		}
+		return a.Symbol < b.Symbol
+	})
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"Backend/calendar"
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/season"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// Daily returns of the window, each dated by the close it ends on and
+// measured from the close before it
+func (uc *Usecase) windowReturns(ctx *gin.Context, req *dto.SeriesReq) ([]dto.DateOnly, []decimal.Decimal, error) {
+	req.Interval = constant.IntervalDay
+	bars, start, err := uc.loadSeries(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	start = max(start, 1)
+	if start >= len(bars) {
+		return nil, nil, constant.ErrNotEnoughBars
+	}
+	days := make([]dto.DateOnly, 0, len(bars)-start)
+	returns := make([]decimal.Decimal, 0, len(bars)-start)
+	for i := start; i < len(bars); i++ {
+		prev := bars[i-1].OHLC["close"]
+		if prev.IsZero() {
+			continue
+		}
+		days = append(days, bars[i].Day)
+		returns = append(returns, bars[i].OHLC["close"].Div(prev).Sub(decimal.NewFromInt(1)))
+	}
+	if len(returns) == 0 {
+		return nil, nil, constant.ErrNotEnoughBars
+	}
+	return days, returns, nil
+}
+
+// Whether the period's returns span all its sessions, judged by the
+// exchange's sessions either side of it
+func complete(cal calendar.CalendarItf, period season.Period, key func(dto.DateOnly) int) bool {
+	return key(cal.PrevSession(period.First)) != period.Key &&
+		key(cal.NextSession(period.Last)) != period.Key
+}
+
+func (uc *Usecase) Seasonality(ctx *gin.Context, req *dto.SeasonalityReq) (*dto.SeasonalityRes, error) {
+	days, returns, err := uc.windowReturns(ctx, &req.Series)
+	if err != nil {
+		return nil, err
+	}
+	cal := calendar.ForSymbol(req.Series.Symbol)
+
+	weekdays := make([]int, len(days))
+	for i, day := range days {
+		weekdays[i] = season.WeekdayKey(day)
+	}
+	// Whole periods only, bucketed by month or week of the year
+	periodBuckets := func(key func(dto.DateOnly) int) ([]int, []decimal.Decimal) {
+		var buckets []int
+		var periodReturns []decimal.Decimal
+		for _, period := range season.Compound(days, returns, key) {
+			if complete(cal, period, key) {
+				buckets = append(buckets, period.Key%100)
+				periodReturns = append(periodReturns, period.Return)
+			}
+		}
+		return buckets, periodReturns
+	}
+	months, monthReturns := periodBuckets(season.MonthKey)
+	weeks, weekReturns := periodBuckets(season.WeekKey)
+
+	return &dto.SeasonalityRes{
+		Symbol:   req.Series.Symbol,
+		Adjust:   req.Series.Adjust,
+		Currency: req.Series.Currency,
+		From:     days[0],
+		To:       days[len(days)-1],
+		Days:     len(days),
+		Weekdays: season.Buckets(weekdays, returns, season.WeekdayLabel),
+		Months:   season.Buckets(months, monthReturns, season.MonthLabel),
+		Weeks:    season.Buckets(weeks, weekReturns, season.WeekLabel),
+	}, nil
+}
+
+func (uc *Usecase) Heatmap(ctx *gin.Context, req *dto.SeasonalityReq) (*dto.HeatmapRes, error) {
+	days, returns, err := uc.windowReturns(ctx, &req.Series)
+	if err != nil {
+		return nil, err
+	}
+	cal := calendar.ForSymbol(req.Series.Symbol)
+
+	res := &dto.HeatmapRes{
+		Symbol:   req.Series.Symbol,
+		Adjust:   req.Series.Adjust,
+		Currency: req.Series.Currency,
+		From:     days[0],
+		To:       days[len(days)-1],
+		Min:      decimal.Min(returns[0], returns[1:]...).Round(constant.AnalyticsPlaces),
+		Max:      decimal.Max(returns[0], returns[1:]...).Round(constant.AnalyticsPlaces),
+		Years:    make([]dto.HeatmapYearRes, 0),
+	}
+	for _, year := range season.Compound(days, returns, season.YearKey) {
+		res.Years = append(res.Years, dto.HeatmapYearRes{
+			Year:    year.Key,
+			Return:  year.Return.Round(constant.AnalyticsPlaces),
+			Partial: !complete(cal, year, season.YearKey),
+			Months:  make([]dto.HeatmapMonthRes, 0),
+			Days:    make([]dto.HeatmapDayRes, 0),
+		})
+	}
+	for _, month := range season.Compound(days, returns, season.MonthKey) {
+		year := &res.Years[yearIndex(res.Years, month.Key/100)]
+		year.Months = append(year.Months, dto.HeatmapMonthRes{
+			Month:   month.Key % 100,
+			Return:  month.Return.Round(constant.AnalyticsPlaces),
+			Partial: !complete(cal, month, season.MonthKey),
+		})
+	}
+	for i, day := range days {
+		year := &res.Years[yearIndex(res.Years, season.YearKey(day))]
+		year.Days = append(year.Days, dto.HeatmapDayRes{
+			Date:    day,
+			Week:    season.WeekKey(day) % 100,
+			Weekday: season.WeekdayKey(day),
+			Return:  returns[i].Round(constant.AnalyticsPlaces),
+		})
+	}
+	return res, nil
+}
+
+// Index of the year in the heatmap, which holds every year of its days
+func yearIndex(years []dto.HeatmapYearRes, year int) int {
+	for i := range years {
+		if years[i].Year == year {
+			return i
+		}
+	}
+	return -1
+}
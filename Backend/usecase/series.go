@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"Backend/analytics"
+	"Backend/calendar"
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/resample"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// Stored bars of a tracked symbol up to req.To, adjusted, converted and
+// resampled as asked, and the index of the first bar from req.From; the
+// earlier bars are kept so calculations can warm up on them. Resampled
+// bars are dated by their period, and the period holding req.From is
+// included.
+func (uc *Usecase) loadSeries(ctx *gin.Context, req *dto.SeriesReq) ([]dto.DailyOHLCVRes, int, error) {
+	mode, err := adjustMode(req.Adjust)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Adjust = mode
+	interval, err := intervalMode(req.Interval)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Interval = interval
+	currency, err := currencyCode(req.Currency)
+	if err != nil {
+		return nil, 0, err
+	}
+	if req.From != nil && req.To != nil && req.From.After(*req.To) {
+		return nil, 0, constant.ErrDateRange
+	}
+
+	meta, err := uc.rp.GetSymbol(ctx, req.Symbol)
+	if err != nil {
+		return nil, 0, err
+	}
+	if currency == "" {
+		currency = nativeCurrency(meta)
+	}
+	req.Currency = currency
+	bars, err := uc.rp.SymbolBars(ctx, req.Symbol)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Adjust before cutting the window, as dividends after it still count
+	bars, err = uc.adjustBars(ctx, req.Symbol, bars, mode)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	end := len(bars)
+	if req.To != nil {
+		for end > 0 && bars[end-1].Day.After(*req.To) {
+			end--
+		}
+	}
+	bars = bars[:end]
+
+	from := req.From
+	weekStart := calendar.ForSymbol(req.Symbol).WeekStart()
+	if interval != constant.IntervalDay && from != nil {
+		period := resample.PeriodStart(*from, interval, weekStart)
+		from = &period
+	}
+
+	// Convert daily bars, so each period's bar comes from converted ones
+	bars, req.Conversion, err = uc.convertBars(ctx, meta, currency, bars, from)
+	if err != nil {
+		return nil, 0, err
+	}
+	if interval != constant.IntervalDay {
+		bars = periodBars(resample.Bars(bars, interval, weekStart))
+	}
+
+	start := 0
+	if from != nil {
+		for start < len(bars) && bars[start].Day.Before(*from) {
+			start++
+		}
+	}
+	if start == len(bars) {
+		return nil, 0, constant.ErrNoBars
+	}
+	return bars, start, nil
+}
+
+// Returns of the symbols between consecutive dates all of them traded
+// on, counting from the close before the window; the dates come along,
+// one more than the returns, with the conversions of symbols priced in
+// another currency than asked. req.Symbol is ignored.
+func (uc *Usecase) commonReturns(ctx *gin.Context, symbols []string, req *dto.SeriesReq) ([]dto.DateOnly, [][]decimal.Decimal, []dto.ConversionRes, error) {
+	closes := make([]map[string]decimal.Decimal, len(symbols))
+	conversions := make([]dto.ConversionRes, 0)
+	var dates []dto.DateOnly
+	for i, symbol := range symbols {
+		series := *req
+		series.Symbol = symbol
+		bars, start, err := uc.loadSeries(ctx, &series)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		req.Adjust, req.Interval = series.Adjust, series.Interval
+		if series.Conversion != nil {
+			conversions = append(conversions, *series.Conversion)
+		}
+		if start > 0 {
+			start--
+		}
+		closes[i] = make(map[string]decimal.Decimal, len(bars)-start)
+		for _, bar := range bars[start:] {
+			closes[i][bar.Day.String()] = bar.OHLC["close"]
+		}
+		if i == 0 {
+			for _, bar := range bars[start:] {
+				dates = append(dates, bar.Day)
+			}
+		}
+	}
+
+	common := make([]dto.DateOnly, 0, len(dates))
+	for _, date := range dates {
+		shared := true
+		for _, byDate := range closes[1:] {
+			if _, ok := byDate[date.String()]; !ok {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			common = append(common, date)
+		}
+	}
+	if len(common) < 3 {
+		return nil, nil, nil, constant.ErrNotEnoughCommonDates
+	}
+	returns := make([][]decimal.Decimal, len(symbols))
+	for i, byDate := range closes {
+		aligned := make([]decimal.Decimal, len(common))
+		for j, date := range common {
+			aligned[j] = byDate[date.String()]
+		}
+		returns[i] = analytics.SimpleReturns(aligned)
+	}
+	return common, returns, conversions, nil
+}
+
+func intervalMode(interval string) (string, error) {
+	if interval == "" {
+		return constant.IntervalDay, nil
+	}
+	if !resample.ValidInterval(interval) {
+		return "", constant.ErrInvalidInterval
+	}
+	return interval, nil
+}
+
+// Aggregated bars as daily ones dated by their period, for analytics
+func periodBars(periods []dto.BarRes) []dto.DailyOHLCVRes {
+	bars := make([]dto.DailyOHLCVRes, len(periods))
+	for i, period := range periods {
+		bars[i] = dto.DailyOHLCVRes{
+			Day:    period.Period,
+			OHLC:   period.OHLC,
+			Volume: period.Volume,
+		}
+	}
+	return bars
+}
+
+// Symbols in their first-asked order, without repeats
+func uniqueSymbols(symbols []string) []string {
+	unique := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		if !slices.Contains(unique, symbol) {
+			unique = append(unique, symbol)
+		}
+	}
+	return unique
+}
+
+func closesOf(bars []dto.DailyOHLCVRes) []decimal.Decimal {
+	closes := make([]decimal.Decimal, len(bars))
+	for i, bar := range bars {
+		closes[i] = bar.OHLC["close"]
+	}
+	return closes
+}
+
+func rounded(d *decimal.Decimal) *decimal.Decimal {
+	if d == nil {
+		return nil
+	}
+	r := d.Round(constant.AnalyticsPlaces)
+	return &r
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"Backend/analytics"
+	"Backend/constant"
+	"Backend/dto"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func riskFreeRate() decimal.Decimal {
+	rate, err := decimal.NewFromString(os.Getenv("RISK_FREE_RATE"))
+	if err != nil {
+		return decimal.RequireFromString(constant.DefaultRiskFreeRate)
+	}
+	return rate
+}
+
+func (uc *Usecase) Stats(ctx *gin.Context, req *dto.StatsReq) (*dto.StatsRes, error) {
+	riskFree := riskFreeRate()
+	if req.RiskFree != nil {
+		riskFree = *req.RiskFree
+	}
+
+	bars, start, err := uc.loadSeries(ctx, &req.Series)
+	if err != nil {
+		return nil, err
+	}
+	// The close before the window is the base of its first return
+	if start > 0 {
+		start--
+	}
+	bars = bars[start:]
+	if len(bars) < 2 {
+		return nil, constant.ErrNotEnoughBars
+	}
+
+	periodsPerYear := constant.PeriodsPerYear[req.Series.Interval]
+	closes := closesOf(bars)
+	simple := analytics.SimpleReturns(closes)
+	logs := analytics.LogReturns(closes)
+	drawdown, peak, trough := analytics.MaxDrawdown(closes)
+
+	res := &dto.StatsRes{
+		Symbol:               req.Series.Symbol,
+		Adjust:               req.Series.Adjust,
+		Interval:             req.Series.Interval,
+		Currency:             req.Series.Currency,
+		Conversion:           req.Series.Conversion,
+		From:                 bars[0].Day,
+		To:                   bars[len(bars)-1].Day,
+		Days:                 len(simple),
+		RiskFreeRate:         riskFree,
+		CumulativeReturn:     analytics.CumulativeReturn(closes).Round(constant.AnalyticsPlaces),
+		AnnualizedVolatility: analytics.AnnualizedVolatility(simple, periodsPerYear).Round(constant.AnalyticsPlaces),
+		Sharpe:               rounded(analytics.Sharpe(simple, riskFree, periodsPerYear)),
+		Sortino:              rounded(analytics.Sortino(simple, riskFree, periodsPerYear)),
+		MaxDrawdown: dto.DrawdownRes{
+			Value:  drawdown.Round(constant.AnalyticsPlaces),
+			Peak:   bars[peak].Day,
+			Trough: bars[trough].Day,
+		},
+		Returns: make([]dto.ReturnPointRes, len(simple)),
+	}
+
+	best, worst := 0, 0
+	for i, r := range simple {
+		if r.GreaterThan(simple[best]) {
+			best = i
+		}
+		if r.LessThan(simple[worst]) {
+			worst = i
+		}
+		res.Returns[i] = dto.ReturnPointRes{
+			Date:   bars[i+1].Day,
+			Close:  closes[i+1],
+			Simple: r.Round(constant.AnalyticsPlaces),
+			Log:    logs[i].Round(constant.AnalyticsPlaces),
+		}
+	}
+	res.BestDay = dto.DayReturnRes{Date: bars[best+1].Day, Return: res.Returns[best].Simple}
+	res.WorstDay = dto.DayReturnRes{Date: bars[worst+1].Day, Return: res.Returns[worst].Simple}
+	return res, nil
+}
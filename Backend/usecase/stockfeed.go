@@ -1,145 +1,203 @@
 package usecase
 
 import (
+	"Backend/anomaly"
+	"Backend/calendar"
 	"Backend/constant"
 	"Backend/dto"
+	"Backend/pattern"
+	"Backend/provider"
 	"Backend/repo"
+	"Backend/resample"
 	"Backend/util"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"os"
+	"slices"
 	"sort"
-	"strconv"
 	"strings"
-	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/shopspring/decimal"
+	"golang.org/x/sync/singleflight"
 )
 
 type UsecaseItf interface {
 	// Helper methods
 	GetUnexpectedInfo([]byte) error
 	ParseOHLCV(*gin.Context, *map[string]string) (*dto.DailyOHLCVRes, error)
-	PrevWeekend(dto.DateOnly) dto.DateOnly
-	NextWeek(dto.DateOnly) *dto.WeekRes
+	PrevWeekend(calendar.CalendarItf, dto.DateOnly) dto.DateOnly
+	NextWeek(calendar.CalendarItf, dto.DateOnly) *dto.WeekRes
 	BuildStockData(*dto.DataPerSymbol) *dto.StockDataRes
+	FetchAlphaDaily(*gin.Context, string) (*dto.AlphaStockDataRes, error)
+	ParseTimeSeries(*gin.Context, *dto.AlphaStockDataRes, dto.DateOnly) ([]dto.DailyOHLCVRes, error)
 
 	// Main methods
 	GetSymbols(*gin.Context, *dto.GetSymbolsReq) (*dto.AlphaSymbolsRes, error)
 	CollectSymbol(*gin.Context, *dto.CollectSymbolReq) (*dto.StockDataRes, error)
 	DeleteSymbol(*gin.Context, *dto.DeleteSymbolReq) error
-	StoredData(*gin.Context) ([]*dto.StockDataRes, error)
+	StoredData(*gin.Context, *dto.StoredDataReq) ([]*dto.StockDataRes, error)
+
+	// Refreshing tracked symbols
+	RefreshSymbol(*gin.Context, *dto.RefreshSymbolReq) (*dto.RefreshSymbolRes, error)
+	RefreshTracked(*gin.Context, *dto.RefreshTrackedReq) (*dto.SchedulerRunRes, error)
+	SchedulerRuns(*gin.Context) ([]*dto.SchedulerRunRes, error)
+
+	// Collection and backfill jobs
+	EnqueueCollect(*gin.Context, *dto.CollectSymbolReq) (*dto.JobRes, error)
+	GetJob(*gin.Context, *dto.GetJobReq) (*dto.JobRes, error)
+	ClaimJob(*gin.Context) (*dto.JobRes, error)
+	RunJob(*gin.Context, *dto.JobRes) error
+	RequeueJobs(*gin.Context) (int64, error)
+
+	// Backfill
+	EnqueueBackfill(*gin.Context, *dto.BackfillReq) (*dto.JobRes, error)
+	Backfill(*gin.Context, *dto.JobRes) (map[string]any, error)
+
+	// Gap detection
+	SymbolGaps(*gin.Context, *dto.GapsReq) (*dto.SymbolGapsRes, error)
+	GapsSummary(*gin.Context, *dto.GapsReq) ([]*dto.SymbolGapsRes, error)
+
+	// Data-quality quarantine
+	QuarantinedBars(*gin.Context, *dto.QuarantineReq) ([]*dto.QuarantinedBarRes, error)
+	ReviewQuarantined(*gin.Context, *dto.ReviewQuarantineReq) (*dto.QuarantinedBarRes, error)
+
+	// Corporate actions
+	CorporateActions(*gin.Context, *dto.CorporateActionsReq) ([]dto.CorporateActionRes, error)
+	SyncCorporateActions(*gin.Context, *dto.CorporateActionsReq) ([]dto.CorporateActionRes, error)
+
+	// Analytics over stored bars
+	Indicators(*gin.Context, *dto.IndicatorsReq) (*dto.IndicatorsRes, error)
+	Stats(*gin.Context, *dto.StatsReq) (*dto.StatsRes, error)
+	Correlation(*gin.Context, *dto.CorrelationReq) (*dto.CorrelationRes, error)
+	Compare(*gin.Context, *dto.CompareReq) (*dto.CompareRes, error)
+
+	// Portfolios
+	CreatePortfolio(*gin.Context, *dto.PortfolioReq) (*dto.PortfolioRes, error)
+	Portfolios(*gin.Context) ([]*dto.PortfolioRes, error)
+	GetPortfolio(*gin.Context, *dto.GetPortfolioReq) (*dto.PortfolioRes, error)
+	UpdatePortfolio(*gin.Context, *dto.PortfolioReq) (*dto.PortfolioRes, error)
+	DeletePortfolio(*gin.Context, *dto.GetPortfolioReq) error
+	ValuePortfolio(*gin.Context, *dto.ValuationReq) (*dto.ValuationRes, error)
+
+	// Portfolio transaction ledger
+	AddTransaction(*gin.Context, *dto.TransactionReq) (*dto.TransactionRes, error)
+	Transactions(*gin.Context, *dto.GetPortfolioReq) ([]dto.TransactionRes, error)
+	DeleteTransaction(*gin.Context, *dto.GetTransactionReq) error
+	Lots(*gin.Context, *dto.LotsReq) (*dto.LotsRes, error)
+	Realized(*gin.Context, *dto.LotsReq) (*dto.RealizedRes, error)
+
+	// Alert rules
+	CreateAlertRule(*gin.Context, *dto.AlertRuleReq) (*dto.AlertRuleRes, error)
+	AlertRules(*gin.Context, *dto.AlertRulesReq) ([]*dto.AlertRuleRes, error)
+	GetAlertRule(*gin.Context, *dto.GetAlertReq) (*dto.AlertRuleRes, error)
+	DeleteAlertRule(*gin.Context, *dto.GetAlertReq) error
+	AlertTriggers(*gin.Context, *dto.GetAlertReq) ([]*dto.AlertTriggerRes, error)
+	DeliverAlert(*gin.Context, *dto.JobRes) (map[string]any, error)
+
+	// Backtests
+	RunBacktest(*gin.Context, *dto.BacktestReq) (*dto.BacktestRes, error)
+	GetBacktest(*gin.Context, *dto.GetBacktestReq) (*dto.BacktestRes, error)
+
+	// Fundamentals and screening
+	Fundamentals(*gin.Context, *dto.FundamentalsReq) (*dto.FundamentalsRes, error)
+	SyncFundamentals(*gin.Context, *dto.FundamentalsReq) (*dto.FundamentalsRes, error)
+	Screen(*gin.Context, *dto.ScreenReq) (*dto.ScreenRes, error)
+
+	// Beta and alpha against a benchmark
+	Beta(*gin.Context, *dto.BetaReq) (*dto.BetaRes, error)
+	Betas(*gin.Context, *dto.BetasReq) (*dto.BetasRes, error)
+
+	// Candlestick patterns
+	Patterns(*gin.Context, *dto.PatternsReq) (*dto.PatternsRes, error)
+
+	// Return and volume anomalies
+	Anomalies(*gin.Context, *dto.AnomaliesReq) (*dto.AnomaliesRes, error)
+
+	// Seasonality and calendar effects
+	Seasonality(*gin.Context, *dto.SeasonalityReq) (*dto.SeasonalityRes, error)
+	Heatmap(*gin.Context, *dto.SeasonalityReq) (*dto.HeatmapRes, error)
+
+	// Alternative chart transforms
+	HeikinAshi(*gin.Context, *dto.HeikinAshiReq) (*dto.HeikinAshiRes, error)
+	Renko(*gin.Context, *dto.BoxChartReq) (*dto.RenkoRes, error)
+	PointFigure(*gin.Context, *dto.BoxChartReq) (*dto.PointFigureRes, error)
+
+	// FX rates for currency conversion
+	FXRates(*gin.Context, *dto.FXRatesReq) ([]dto.FXRateRes, error)
+	SyncFXRates(*gin.Context, *dto.FXRatesReq) (*dto.FXSyncRes, error)
 }
 
 type Usecase struct {
-	rp repo.RepoItf
-	hc util.HttpClientItf
+	rp        repo.RepoItf
+	hc        util.HttpClientItf
+	providers map[string]provider.ProviderItf
+
+	// Concurrent collections of a symbol share one fetch and one write
+	collects *singleflight.Group
 }
 
 func NewUsecase(rp repo.RepoItf, hc util.HttpClientItf) *Usecase {
 	return &Usecase{
-		rp: rp,
-		hc: hc,
+		rp:        rp,
+		hc:        hc,
+		providers: provider.NewProviders(hc),
+		collects:  new(singleflight.Group),
 	}
 }
 
 func (uc *Usecase) GetUnexpectedInfo(body []byte) error {
-	var info dto.AlphaInfo
-	err := json.Unmarshal(body, &info)
-	if err != nil {
-		return constant.ErrAlphaUnmarshal(err)
-	}
-
-	// Indicate if this is not an information-JSON body
-	if info.Info == "" {
-		return nil
-	}
-
-	// Erase any trace of my API key
-	info.Info = strings.ReplaceAll(info.Info,
-		os.Getenv("ALPHA_VANTAGE_API_KEY"), "[REDACTED]")
-
-	// Simplify exceed-API-limit message
-	if info.Info == constant.APIExceedLimit {
-		return constant.ErrAPIExceed
-	}
-
-	// For any unexpected error I have never seen before
-	return constant.NewCError(http.StatusBadGateway, info.Info)
+	return provider.AlphaUnexpectedInfo(body)
 }
 
 func (uc *Usecase) ParseOHLCV(ctx *gin.Context, timeSeries *map[string]string) (*dto.DailyOHLCVRes, error) {
-	TimeSeries := *timeSeries
-	var ohlcv dto.DailyOHLCVRes
-	ohlcv.OHLC = make(map[string]decimal.Decimal)
-
-	// - OHLC
-	for _, value := range []string{"1. open", "2. high",
-		"3. low", "4. close"} {
-
-		parts := strings.Split(value, " ")
-		text, ok := TimeSeries[value]
-		if !ok {
-			return nil, constant.ErrAlphaParseBody(
-				fmt.Sprintf("can't find %s price as usual", parts[1]),
-			)
-		}
-
-		dec, err := decimal.NewFromString(text)
-		if err != nil {
-			return nil, constant.ErrAlphaParseBody(err.Error())
-		}
-
-		ohlcv.OHLC[parts[1]] = dec
-	}
-
-	// - Volume
-	text, ok := TimeSeries["5. volume"]
-	if !ok {
-		return nil, constant.ErrAlphaParseBody(
-			"can't find volume as usual")
-	}
-	vol, err := strconv.Atoi(text)
-	if err != nil {
-		return nil, constant.ErrAlphaParseBody(err.Error())
-	}
-	ohlcv.Volume = vol
-
-	return &ohlcv, nil
+	return provider.ParseAlphaOHLCV(*timeSeries)
 }
 
-func (uc *Usecase) PrevWeekend(t dto.DateOnly) dto.DateOnly {
+// Latest weekend day of the exchange on or before t
+func (uc *Usecase) PrevWeekend(cal calendar.CalendarItf, t dto.DateOnly) dto.DateOnly {
 	for {
-		weekday := t.Weekday()
-		if weekday == time.Saturday || weekday == time.Sunday {
+		if cal.IsWeekend(t) {
 			return t
 		}
 		t = t.AddDate(0, 0, -1)
 	}
 }
 
-func (uc *Usecase) NextWeek(t dto.DateOnly) *dto.WeekRes {
+// The exchange's week after t, labelled with its trading sessions
+func (uc *Usecase) NextWeek(cal calendar.CalendarItf, t dto.DateOnly) *dto.WeekRes {
 	week := &dto.WeekRes{}
-	t1 := t
-	for {
-		weekday := t1.Weekday()
-		if weekday == time.Monday {
-			week.Monday = t1
-			break
+	monday := t.AddDate(0, 0, 1)
+	for monday.Weekday() != cal.WeekStart() {
+		monday = monday.AddDate(0, 0, 1)
+	}
+	friday := monday
+	for day := monday; day.Before(monday.AddDate(0, 0, 7)); day = day.AddDate(0, 0, 1) {
+		if !cal.IsWeekend(day) {
+			friday = day
 		}
-		t1 = t1.AddDate(0, 0, 1)
 	}
-	t2 := week.Monday
-	for {
-		weekday := t2.Weekday()
-		if weekday == time.Friday {
-			week.Friday = t2
-			break
+
+	sessions := cal.SessionsBetween(monday, friday)
+	if len(sessions) == 0 {
+		week.Monday = monday
+		week.Friday = friday
+	} else {
+		week.Monday = sessions[0]
+		week.Friday = sessions[len(sessions)-1]
+	}
+
+	for day := monday; !day.After(friday); day = day.AddDate(0, 0, 1) {
+		if name, ok := cal.Holiday(day); ok {
+			week.Holidays = append(week.Holidays, dto.HolidayRes{
+				Date: day,
+				Name: name,
+			})
+		}
+		if cal.IsEarlyClose(day) {
+			week.EarlyCloses = append(week.EarlyCloses, day)
 		}
-		t2 = t2.AddDate(0, 0, 1)
 	}
+
 	week.DailyData = make([]dto.DailyOHLCVRes, 0)
 	return week
 }
@@ -148,15 +206,27 @@ func (uc *Usecase) BuildStockData(data *dto.DataPerSymbol) *dto.StockDataRes {
 	var stockData dto.StockDataRes
 	stockData.MetaData = data.MetaData
 
+	// Weeks follow the exchange the symbol is listed on
+	cal := calendar.Default()
+	if data.MetaData != nil {
+		cal = calendar.ForSymbol(data.MetaData.Symbol)
+		data.MetaData.Exchange = cal.Name()
+	}
+
 	// Processing to divide time series to weeks for presentation
+	stockData.Weeks = make([]*dto.WeekRes, 0)
+	if len(data.TimeSeries) == 0 {
+		return &stockData
+	}
 	var weekIndex int
 	date := data.TimeSeries[0].Day
-	date = uc.PrevWeekend(date)
-	stockData.Weeks = append(stockData.Weeks, uc.NextWeek(date))
+	date = uc.PrevWeekend(cal, date)
+	stockData.Weeks = append(stockData.Weeks, uc.NextWeek(cal, date))
 	thisWeek := stockData.Weeks[weekIndex]
 	for _, day := range data.TimeSeries {
-		if day.Day.After(thisWeek.Friday) {
-			stockData.Weeks = append(stockData.Weeks, uc.NextWeek(thisWeek.Friday))
+		// Weeks without data (e.g. gaps in history) are kept, but empty
+		for day.Day.After(thisWeek.Friday) {
+			stockData.Weeks = append(stockData.Weeks, uc.NextWeek(cal, thisWeek.Friday))
 			weekIndex++
 			thisWeek = stockData.Weeks[weekIndex]
 		}
@@ -166,15 +236,20 @@ func (uc *Usecase) BuildStockData(data *dto.DataPerSymbol) *dto.StockDataRes {
 	return &stockData
 }
 
-func (uc *Usecase) GetSymbols(ctx *gin.Context, req *dto.GetSymbolsReq) (*dto.AlphaSymbolsRes, error) {
+func (uc *Usecase) FetchAlphaDaily(ctx *gin.Context, symbol string) (*dto.AlphaStockDataRes, error) {
 	// Retrieve data from Alpha Vantage API
 	url := fmt.Sprintf("https://www.alphavantage.co/"+
-		"query?function=SYMBOL_SEARCH"+
-		"&keywords=%s&apikey=%s",
-		req.Prefix,
+		"query?function=TIME_SERIES_DAILY"+
+		"&symbol=%s&apikey=%s",
+		symbol,
 		os.Getenv("ALPHA_VANTAGE_API_KEY"),
 	)
 
+	// Count the request against the daily quota
+	err := uc.rp.AddAPICalls(ctx, provider.AlphaVantageName, 1)
+	if err != nil {
+		return nil, err
+	}
 	response, err := uc.hc.Get(url)
 	if err != nil {
 		return nil, constant.ErrAlphaGet(err)
@@ -193,33 +268,58 @@ func (uc *Usecase) GetSymbols(ctx *gin.Context, req *dto.GetSymbolsReq) (*dto.Al
 	}
 
 	// Unmarshal body
-	var symbols dto.AlphaSymbolsRes
-	err = json.Unmarshal(body, &symbols)
+	var alphaData dto.AlphaStockDataRes
+	err = json.Unmarshal(body, &alphaData)
 	if err != nil {
 		return nil, constant.ErrAlphaUnmarshal(err)
 	}
 
-	return &symbols, nil
+	return &alphaData, nil
 }
 
-func (uc *Usecase) CollectSymbol(ctx *gin.Context, req *dto.CollectSymbolReq) (*dto.StockDataRes, error) {
-	// Check if symbol is in database already
-	exists, err := uc.rp.CheckSymbolExists(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	if exists {
-		return nil, constant.ErrStockAlready
+// Parse the daily bars dated on or after `since`, sorted by date
+func (uc *Usecase) ParseTimeSeries(ctx *gin.Context, alphaData *dto.AlphaStockDataRes, since dto.DateOnly) ([]dto.DailyOHLCVRes, error) {
+	timeSeries := make([]dto.DailyOHLCVRes, 0)
+	for key, value := range alphaData.TimeSeries {
+		keyDate, err := provider.ParseAlphaDate(key, alphaData.MetaData.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+
+		if !keyDate.Before(since) {
+
+			ohlcv, err := uc.ParseOHLCV(ctx, &value)
+
+			if err != nil {
+				return nil, err
+			}
+			ohlcv.Day = keyDate
+			timeSeries = append(timeSeries, *ohlcv)
+		}
 	}
 
+	sort.SliceStable(timeSeries, func(i, j int) bool {
+		return timeSeries[i].Day.Before(
+			timeSeries[j].Day,
+		)
+	})
+	return timeSeries, nil
+}
+
+func (uc *Usecase) GetSymbols(ctx *gin.Context, req *dto.GetSymbolsReq) (*dto.AlphaSymbolsRes, error) {
 	// Retrieve data from Alpha Vantage API
 	url := fmt.Sprintf("https://www.alphavantage.co/"+
-		"query?function=TIME_SERIES_DAILY"+
-		"&symbol=%s&apikey=%s",
-		req.Symbol,
+		"query?function=SYMBOL_SEARCH"+
+		"&keywords=%s&apikey=%s",
+		req.Prefix,
 		os.Getenv("ALPHA_VANTAGE_API_KEY"),
 	)
 
+	// Count the request against the daily quota
+	err := uc.rp.AddAPICalls(ctx, provider.AlphaVantageName, 1)
+	if err != nil {
+		return nil, err
+	}
 	response, err := uc.hc.Get(url)
 	if err != nil {
 		return nil, constant.ErrAlphaGet(err)
@@ -238,12 +338,44 @@ func (uc *Usecase) CollectSymbol(ctx *gin.Context, req *dto.CollectSymbolReq) (*
 	}
 
 	// Unmarshal body
-	var alphaData dto.AlphaStockDataRes
-	err = json.Unmarshal(body, &alphaData)
+	var symbols dto.AlphaSymbolsRes
+	err = json.Unmarshal(body, &symbols)
 	if err != nil {
 		return nil, constant.ErrAlphaUnmarshal(err)
 	}
 
+	return &symbols, nil
+}
+
+func (uc *Usecase) CollectSymbol(ctx *gin.Context, req *dto.CollectSymbolReq) (*dto.StockDataRes, error) {
+	// Callers share the first one's work, which mustn't end with its request
+	stockData, err, _ := uc.collects.Do("collect:"+strings.ToUpper(req.Symbol), func() (any, error) {
+		shared, cancel := util.NewDetachedContext(ctx, constant.JobTimeout)
+		defer cancel()
+		return uc.collectSymbol(shared, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stockData.(*dto.StockDataRes), nil
+}
+
+func (uc *Usecase) collectSymbol(ctx *gin.Context, req *dto.CollectSymbolReq) (*dto.StockDataRes, error) {
+	// Check if symbol is in database already
+	exists, err := uc.rp.CheckSymbolExists(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, constant.ErrStockAlready
+	}
+
+	// Retrieve data from Alpha Vantage API
+	alphaData, err := uc.FetchAlphaDaily(ctx, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
 	alphaMeta := alphaData.MetaData
 
 	// Process data from API:
@@ -251,47 +383,34 @@ func (uc *Usecase) CollectSymbol(ctx *gin.Context, req *dto.CollectSymbolReq) (*
 
 	// 1. collect some metadata
 	metaData.Symbol = alphaMeta.Symbol
+	metaData.TimeZone = alphaMeta.TimeZone
+	metaData.Currency = calendar.Currency(metaData.Symbol)
 
-	t, err := time.Parse(constant.LayoutISO, alphaMeta.LastRefreshed)
+	metaData.LastRefreshed, err = provider.ParseAlphaDate(
+		alphaMeta.LastRefreshed, alphaMeta.TimeZone)
 	if err != nil {
-		return nil, constant.ErrAlphaParseBody(err.Error())
+		return nil, err
 	}
-	metaData.LastRefreshed = dto.DateOnly(t)
 
 	// 2. collect first constant.DefaultStocksNum days of time series data
 	date := metaData.LastRefreshed.AddDate(0, 0,
 		-constant.DefaultStocksNum+1)
-	date = uc.PrevWeekend(date)
-	dateTime := time.Time(date)
-	timeSeries := make([]dto.DailyOHLCVRes, 0)
-	for key, value := range alphaData.TimeSeries {
-		keyDate, err := time.Parse(constant.LayoutISO, key)
-		if err != nil {
-			return nil, constant.ErrAlphaParseBody(err.Error())
-		}
-
-		if !keyDate.Before(dateTime) {
-
-			ohlcv, err := uc.ParseOHLCV(ctx, &value)
+	date = uc.PrevWeekend(calendar.ForSymbol(metaData.Symbol), date)
+	timeSeries, err := uc.ParseTimeSeries(ctx, alphaData, date)
+	if err != nil {
+		return nil, err
+	}
 
-			if err != nil {
-				return nil, err
-			}
-			ohlcv.Day = dto.DateOnly(keyDate)
-			timeSeries = append(timeSeries, *ohlcv)
-		}
+	// - bars failing validation are quarantined instead of stored
+	timeSeries, metaData.Quarantined, err = uc.screenBars(ctx,
+		metaData.Symbol, nil, timeSeries)
+	if err != nil {
+		return nil, err
 	}
 
 	// - figure out number of time series data kept
 	metaData.Size = len(timeSeries)
 
-	// 3. sort the kept time series data
-	sort.SliceStable(timeSeries, func(i, j int) bool {
-		return timeSeries[i].Day.Before(
-			timeSeries[j].Day,
-		)
-	})
-
 	dataForSym := &dto.DataPerSymbol{
 		MetaData: &metaData, TimeSeries: timeSeries}
 
@@ -300,6 +419,7 @@ func (uc *Usecase) CollectSymbol(ctx *gin.Context, req *dto.CollectSymbolReq) (*
 	if err != nil {
 		return nil, err
 	}
+	uc.checkAlerts(ctx, metaData.Symbol)
 
 	// Processing to divide time series to weeks for presentation
 	// just before returning
@@ -311,7 +431,24 @@ func (uc *Usecase) DeleteSymbol(ctx *gin.Context, req *dto.DeleteSymbolReq) erro
 	return uc.rp.DeleteSymbol(ctx, req)
 }
 
-func (uc *Usecase) StoredData(ctx *gin.Context) ([]*dto.StockDataRes, error) {
+func (uc *Usecase) StoredData(ctx *gin.Context, req *dto.StoredDataReq) ([]*dto.StockDataRes, error) {
+	mode, err := adjustMode(req.Adjust)
+	if err != nil {
+		return nil, err
+	}
+	interval, err := intervalMode(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := currencyCode(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	err = annotations(req, interval)
+	if err != nil {
+		return nil, err
+	}
+
 	// repo
 	dataPerSymbol, err := uc.rp.StoredData(ctx)
 	if err != nil {
@@ -321,8 +458,60 @@ func (uc *Usecase) StoredData(ctx *gin.Context) ([]*dto.StockDataRes, error) {
 	// assemble data for presentation
 	stockData := make([]*dto.StockDataRes, 0)
 	for _, datum := range dataPerSymbol {
-		stockData = append(stockData, uc.BuildStockData(&datum))
+		datum.TimeSeries, err = uc.adjustBars(ctx,
+			datum.MetaData.Symbol, datum.TimeSeries, mode)
+		if err != nil {
+			return nil, err
+		}
+		datum.MetaData.Currency = nativeCurrency(datum.MetaData)
+		var conversion *dto.ConversionRes
+		datum.TimeSeries, conversion, err = uc.convertBars(ctx,
+			datum.MetaData, currency, datum.TimeSeries, nil)
+		if err != nil {
+			return nil, err
+		}
+		if interval != constant.IntervalDay {
+			weekStart := calendar.ForSymbol(datum.MetaData.Symbol).WeekStart()
+			stockData = append(stockData, &dto.StockDataRes{
+				MetaData:   datum.MetaData,
+				Interval:   interval,
+				Bars:       resample.Bars(datum.TimeSeries, interval, weekStart),
+				Conversion: conversion,
+			})
+			continue
+		}
+		annotateBars(datum.TimeSeries, req)
+		data := uc.BuildStockData(&datum)
+		data.Conversion = conversion
+		stockData = append(stockData, data)
 	}
 
 	return stockData, nil
 }
+
+// Check the annotations asked of stored data
+func annotations(req *dto.StoredDataReq, interval string) error {
+	for _, name := range req.Annotate {
+		if !slices.Contains(constant.Annotations, name) {
+			return constant.ErrInvalidAnnotation
+		}
+	}
+	if len(req.Annotate) > 0 && interval != constant.IntervalDay {
+		return constant.ErrInvalidAnnotation
+	}
+	return anomaly.Normalize(&req.Anomaly)
+}
+
+// Daily bars with the asked annotations filled in
+func annotateBars(bars []dto.DailyOHLCVRes, req *dto.StoredDataReq) {
+	if slices.Contains(req.Annotate, constant.AnnotatePatterns) {
+		for i, patterns := range pattern.Detect(bars, nil) {
+			bars[i].Patterns = patterns
+		}
+	}
+	if slices.Contains(req.Annotate, constant.AnnotateAnomalies) {
+		for i, flags := range anomaly.Detect(bars, req.Anomaly) {
+			bars[i].Anomalies = flags
+		}
+	}
+}
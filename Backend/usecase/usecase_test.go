@@ -1,12 +1,18 @@
 package usecase
 
 import (
+	"Backend/alert"
+	"Backend/calendar"
 	"Backend/constant"
 	"Backend/dto"
+	mocks3 "Backend/mocks/provider"
 	mocks1 "Backend/mocks/repo"
 	mocks2 "Backend/mocks/util"
+	"Backend/provider"
 	"Backend/repo"
 	"Backend/util"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -15,6 +21,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -380,6 +387,127 @@ func TestUnitUsecaseBuildStockData(t *testing.T) {
 				return output
 			},
 		},
+		{
+			name: "week with a holiday",
+			dataInput: func() *dto.DataPerSymbol {
+				data := new(dto.DataPerSymbol)
+
+				// Tuesday before Good Friday 2025
+				dateGen := util.DateGenerator(time.Date(2025, 4, 14, 0, 0, 0, 0, time.UTC))
+				ohlcvGen := util.NewOHLCVGenerator(
+					&dateGen, 100, 100)
+
+				data.TimeSeries = append(data.TimeSeries, ohlcvGen.Next())
+
+				return data
+			},
+			expectedOutput: func() *dto.StockDataRes {
+				output := new(dto.StockDataRes)
+
+				goodFriday := time.Date(2025, 4, 18, 0, 0, 0, 0, time.UTC)
+				week := new(dto.WeekRes)
+				week.Monday = dto.DateOnly(goodFriday).AddDate(0, 0, -4)
+				week.Friday = dto.DateOnly(goodFriday).AddDate(0, 0, -1)
+				week.Holidays = []dto.HolidayRes{
+					{Date: dto.DateOnly(goodFriday), Name: "Good Friday"},
+				}
+
+				dateGen := util.DateGenerator(time.Date(2025, 4, 14, 0, 0, 0, 0, time.UTC))
+				ohlcvGen := util.NewOHLCVGenerator(
+					&dateGen, 100, 100)
+
+				week.DailyData = append(week.DailyData, ohlcvGen.Next())
+
+				output.Weeks = append(output.Weeks, week)
+				return output
+			},
+		},
+		{
+			name: "gap between weeks",
+			dataInput: func() *dto.DataPerSymbol {
+				data := new(dto.DataPerSymbol)
+
+				dateGen := util.DateGenerator(timeDate.AddDate(0, 0, 2))
+				ohlcvGen := util.NewOHLCVGenerator(
+					&dateGen, 100, 100)
+
+				data.TimeSeries = append(data.TimeSeries, ohlcvGen.Next())
+
+				dateGen = util.DateGenerator(timeDate.AddDate(0, 0, 15))
+				ohlcvGen.DateGen = &dateGen
+
+				data.TimeSeries = append(data.TimeSeries, ohlcvGen.Next())
+				return data
+			},
+			expectedOutput: func() *dto.StockDataRes {
+				output := new(dto.StockDataRes)
+
+				dateGen := util.DateGenerator(timeDate.AddDate(0, 0, 2))
+				ohlcvGen := util.NewOHLCVGenerator(
+					&dateGen, 100, 100)
+
+				for i := range 3 {
+					week := new(dto.WeekRes)
+					week.Monday = dto.DateOnly(timeDate).AddDate(0, 0, 7*i)
+					week.Friday = dto.DateOnly(timeDate).AddDate(0, 0, 7*i+4)
+					week.DailyData = make([]dto.DailyOHLCVRes, 0)
+					output.Weeks = append(output.Weeks, week)
+				}
+
+				// Juneteenth falls in the third week
+				output.Weeks[2].Holidays = []dto.HolidayRes{
+					{Date: dto.DateOnly(timeDate).AddDate(0, 0, 17), Name: "Juneteenth"},
+				}
+
+				output.Weeks[0].DailyData = append(output.Weeks[0].DailyData, ohlcvGen.Next())
+
+				dateGen = util.DateGenerator(timeDate.AddDate(0, 0, 15))
+				ohlcvGen.DateGen = &dateGen
+
+				output.Weeks[2].DailyData = append(output.Weeks[2].DailyData, ohlcvGen.Next())
+				return output
+			},
+		},
+		{
+			name: "sunday to thursday exchange week",
+			dataInput: func() *dto.DataPerSymbol {
+				data := new(dto.DataPerSymbol)
+				data.MetaData = &dto.SymbolDataMeta{Symbol: "2222.SR"}
+
+				// Sunday 1 and Thursday 5 June, then Sunday 8 June 2025
+				for _, day := range []int{0, 4, 7} {
+					dateGen := util.DateGenerator(timeDate.AddDate(0, 0, day-2))
+					ohlcvGen := util.NewOHLCVGenerator(
+						&dateGen, 100, 100)
+					data.TimeSeries = append(data.TimeSeries, ohlcvGen.Next())
+				}
+				return data
+			},
+			expectedOutput: func() *dto.StockDataRes {
+				output := new(dto.StockDataRes)
+				output.MetaData = &dto.SymbolDataMeta{
+					Symbol:   "2222.SR",
+					Exchange: "TADAWUL",
+				}
+
+				sunday := dto.DateOnly(timeDate).AddDate(0, 0, -1)
+				for i := range 2 {
+					week := new(dto.WeekRes)
+					week.Monday = sunday.AddDate(0, 0, 7*i)
+					week.Friday = sunday.AddDate(0, 0, 7*i+4)
+					week.DailyData = make([]dto.DailyOHLCVRes, 0)
+					output.Weeks = append(output.Weeks, week)
+				}
+
+				for i, day := range []int{0, 4, 7} {
+					dateGen := util.DateGenerator(timeDate.AddDate(0, 0, day-2))
+					ohlcvGen := util.NewOHLCVGenerator(
+						&dateGen, 100, 100)
+					output.Weeks[i/2].DailyData = append(output.Weeks[i/2].DailyData, ohlcvGen.Next())
+				}
+				return output
+			},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -443,13 +571,13 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 			name:     "checking symbol exist lead to error",
 			inputReq: &dto.CollectSymbolReq{Symbol: "KAMBING"},
 			repoSetup: func(ctx *gin.Context) repo.RepoItf {
-				mock := new(mocks1.RepoItf)
-				mock.On(
+				mocked := new(mocks1.RepoItf)
+				mocked.On(
 					"CheckSymbolExists",
-					ctx,
+					mock.Anything,
 					&dto.CollectSymbolReq{Symbol: "KAMBING"},
 				).Return(false, errorSample)
-				return mock
+				return mocked
 			},
 			httpSetup: func(*gin.Context) util.HttpClientItf {
 				return new(mocks2.HttpClientItf)
@@ -463,13 +591,13 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 			name:     "symbol is already in database",
 			inputReq: &dto.CollectSymbolReq{Symbol: "KAMBING"},
 			repoSetup: func(ctx *gin.Context) repo.RepoItf {
-				mock := new(mocks1.RepoItf)
-				mock.On(
+				mocked := new(mocks1.RepoItf)
+				mocked.On(
 					"CheckSymbolExists",
-					ctx,
+					mock.Anything,
 					&dto.CollectSymbolReq{Symbol: "KAMBING"},
 				).Return(true, nil)
-				return mock
+				return mocked
 			},
 			httpSetup: func(*gin.Context) util.HttpClientItf {
 				return new(mocks2.HttpClientItf)
@@ -483,21 +611,21 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 			name:     "retrieving data returns error",
 			inputReq: &dto.CollectSymbolReq{Symbol: "KAMBING"},
 			repoSetup: func(ctx *gin.Context) repo.RepoItf {
-				mock := new(mocks1.RepoItf)
-				mock.On(
+				mocked := new(mocks1.RepoItf)
+				mocked.On(
 					"CheckSymbolExists",
-					ctx,
+					mock.Anything,
 					&dto.CollectSymbolReq{Symbol: "KAMBING"},
 				).Return(false, nil)
-				return mock
+				return mocked
 			},
 			httpSetup: func(*gin.Context) util.HttpClientItf {
-				mock := new(mocks2.HttpClientItf)
-				mock.On(
+				mocked := new(mocks2.HttpClientItf)
+				mocked.On(
 					"Get",
 					urlKambing,
 				).Return(nil, errorSample)
-				return mock
+				return mocked
 			},
 			expectedOutput: func() *dto.StockDataRes { return nil },
 			expectedErr: func(err error) {
@@ -512,13 +640,13 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 			name:     "failure reading response body",
 			inputReq: &dto.CollectSymbolReq{Symbol: "KAMBING"},
 			repoSetup: func(ctx *gin.Context) repo.RepoItf {
-				mock := new(mocks1.RepoItf)
-				mock.On(
+				mocked := new(mocks1.RepoItf)
+				mocked.On(
 					"CheckSymbolExists",
-					ctx,
+					mock.Anything,
 					&dto.CollectSymbolReq{Symbol: "KAMBING"},
 				).Return(false, nil)
-				return mock
+				return mocked
 			},
 			httpSetup: func(*gin.Context) util.HttpClientItf {
 				resp := &http.Response{
@@ -558,13 +686,13 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 			name:     "unexpected info error",
 			inputReq: &dto.CollectSymbolReq{Symbol: "KAMBING"},
 			repoSetup: func(ctx *gin.Context) repo.RepoItf {
-				mock := new(mocks1.RepoItf)
-				mock.On(
+				mocked := new(mocks1.RepoItf)
+				mocked.On(
 					"CheckSymbolExists",
-					ctx,
+					mock.Anything,
 					&dto.CollectSymbolReq{Symbol: "KAMBING"},
 				).Return(false, nil)
-				return mock
+				return mocked
 			},
 			httpSetup: func(*gin.Context) util.HttpClientItf {
 				resp := &http.Response{
@@ -606,13 +734,13 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 			name:     "unexpected body, neither info type or stock data type",
 			inputReq: &dto.CollectSymbolReq{Symbol: "KAMBING"},
 			repoSetup: func(ctx *gin.Context) repo.RepoItf {
-				mock := new(mocks1.RepoItf)
-				mock.On(
+				mocked := new(mocks1.RepoItf)
+				mocked.On(
 					"CheckSymbolExists",
-					ctx,
+					mock.Anything,
 					&dto.CollectSymbolReq{Symbol: "KAMBING"},
 				).Return(false, nil)
-				return mock
+				return mocked
 			},
 			httpSetup: func(*gin.Context) util.HttpClientItf {
 				resp := &http.Response{
@@ -660,13 +788,13 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 			name:     "can't parse time from provided API data",
 			inputReq: &dto.CollectSymbolReq{Symbol: "IBM"},
 			repoSetup: func(ctx *gin.Context) repo.RepoItf {
-				mock := new(mocks1.RepoItf)
-				mock.On(
+				mocked := new(mocks1.RepoItf)
+				mocked.On(
 					"CheckSymbolExists",
-					ctx,
+					mock.Anything,
 					&dto.CollectSymbolReq{Symbol: "IBM"},
 				).Return(false, nil)
-				return mock
+				return mocked
 			},
 
 			httpSetup: func(*gin.Context) util.HttpClientItf {
@@ -731,13 +859,13 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 			name:     "one of the time series keys can't be parsed as date",
 			inputReq: &dto.CollectSymbolReq{Symbol: "IBM"},
 			repoSetup: func(ctx *gin.Context) repo.RepoItf {
-				mock := new(mocks1.RepoItf)
-				mock.On(
+				mocked := new(mocks1.RepoItf)
+				mocked.On(
 					"CheckSymbolExists",
-					ctx,
+					mock.Anything,
 					&dto.CollectSymbolReq{Symbol: "IBM"},
 				).Return(false, nil)
-				return mock
+				return mocked
 			},
 			httpSetup: func(*gin.Context) util.HttpClientItf {
 				badDate := `"bad date": {
@@ -806,13 +934,13 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 			name:     "ParseOHLCV error",
 			inputReq: &dto.CollectSymbolReq{Symbol: "IBM"},
 			repoSetup: func(ctx *gin.Context) repo.RepoItf {
-				mock := new(mocks1.RepoItf)
-				mock.On(
+				mocked := new(mocks1.RepoItf)
+				mocked.On(
 					"CheckSymbolExists",
-					ctx,
+					mock.Anything,
 					&dto.CollectSymbolReq{Symbol: "IBM"},
 				).Return(false, nil)
-				return mock
+				return mocked
 			},
 			httpSetup: func(*gin.Context) util.HttpClientItf {
 				badOpen := `"2025-06-13": {
@@ -877,7 +1005,10 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			//given
 			c, _ := gin.CreateTestContext(httptest.NewRecorder())
-			uc := NewUsecase(tt.repoSetup(c), tt.httpSetup(c))
+			rp := tt.repoSetup(c).(*mocks1.RepoItf)
+			rp.On("AddAPICalls", mock.Anything, provider.AlphaVantageName, 1).Return(nil)
+			rp.On("CorporateActions", mock.Anything, mock.Anything).Return([]dto.CorporateActionRes{}, nil)
+			uc := NewUsecase(rp, tt.httpSetup(c))
 
 			//when
 			output, err := uc.CollectSymbol(c, tt.inputReq)
@@ -888,3 +1019,2198 @@ func TestUnitUsecaseCollectSymbol(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitUsecaseRequeueJobs(t *testing.T) {
+	//given
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	rp := new(mocks1.RepoItf)
+	rp.On("RequeueRunningJobs", c, constant.JobMaxAttempts, &dto.JobErrorRes{
+		StatusCode: http.StatusInternalServerError,
+		Message:    constant.ErrJobInterrupted.Error(),
+	}).Return(int64(2), nil)
+	uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+	//when
+	requeued, err := uc.RequeueJobs(c)
+
+	//then
+	assert.Equal(t, err, nil)
+	assert.Equal(t, requeued, int64(2))
+}
+
+func TestUnitUsecaseBackfill(t *testing.T) {
+	bar := func(text string) dto.DailyOHLCVRes {
+		one := decimal.NewFromInt(1)
+		return dto.DailyOHLCVRes{
+			Day:    util.Date(text),
+			OHLC:   map[string]decimal.Decimal{"open": one, "high": one, "low": one, "close": one},
+			Volume: 1,
+		}
+	}
+	newJob := func() *dto.JobRes {
+		from, to := util.Date("2025-06-01"), util.Date("2025-06-06")
+		return &dto.JobRes{
+			Id:       "1",
+			Type:     constant.JobTypeBackfill,
+			Symbol:   "IBM",
+			Provider: "fake",
+			From:     &from,
+			To:       &to,
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		providerSetup    func() provider.ProviderItf
+		fallbackSetup    func() provider.ProviderItf
+		expectedOutput   func(map[string]any)
+		expectedProgress func(*dto.JobProgressRes)
+		expectedErr      func(error)
+	}{
+		{
+			name: "fills missing bars chunk by chunk",
+			providerSetup: func() provider.ProviderItf {
+				mocked := new(mocks3.ProviderItf)
+				mocked.On("MaxRangeDays").Return(3)
+				mocked.On("DailyBars", "IBM", util.Date("2025-06-04"), util.Date("2025-06-06")).
+					Return([]dto.DailyOHLCVRes{bar("2025-06-04"), bar("2025-06-05"), bar("2025-06-06")}, nil)
+				mocked.On("DailyBars", "IBM", util.Date("2025-06-01"), util.Date("2025-06-03")).
+					Return([]dto.DailyOHLCVRes{bar("2025-06-02"), bar("2025-06-03")}, nil)
+				return mocked
+			},
+			expectedOutput: func(output map[string]any) {
+				assert.Equal(t, output["inserted"], 4)
+				assert.Equal(t, output["skipped_existing"], 1)
+				assert.Equal(t, output["filled_from"], "2025-06-02")
+				assert.Equal(t, output["filled_to"], "2025-06-06")
+			},
+			expectedProgress: func(progress *dto.JobProgressRes) {
+				assert.Equal(t, progress.Cursor.String(), "2025-05-31")
+			},
+			expectedErr: func(err error) {
+				assert.Equal(t, err, nil)
+			},
+		},
+		{
+			name: "quarantines bars failing validation",
+			providerSetup: func() provider.ProviderItf {
+				broken := bar("2025-06-04")
+				broken.OHLC["low"] = decimal.NewFromInt(2)
+
+				mocked := new(mocks3.ProviderItf)
+				mocked.On("MaxRangeDays").Return(0)
+				mocked.On("DailyBars", "IBM", util.Date("2025-06-01"), util.Date("2025-06-06")).
+					Return([]dto.DailyOHLCVRes{bar("2025-06-03"), broken, bar("2025-06-06")}, nil)
+				return mocked
+			},
+			expectedOutput: func(output map[string]any) {
+				assert.Equal(t, output["inserted"], 2)
+				assert.Equal(t, output["quarantined"], 1)
+				assert.Equal(t, output["skipped_existing"], 0)
+			},
+			expectedProgress: func(progress *dto.JobProgressRes) {
+				assert.Equal(t, progress.Quarantined, 1)
+			},
+			expectedErr: func(err error) {
+				assert.Equal(t, err, nil)
+			},
+		},
+		{
+			name: "stops where quota ran out",
+			providerSetup: func() provider.ProviderItf {
+				mocked := new(mocks3.ProviderItf)
+				mocked.On("MaxRangeDays").Return(3)
+				mocked.On("DailyBars", "IBM", util.Date("2025-06-04"), util.Date("2025-06-06")).
+					Return([]dto.DailyOHLCVRes{bar("2025-06-04")}, nil)
+				mocked.On("DailyBars", "IBM", util.Date("2025-06-01"), util.Date("2025-06-03")).
+					Return(nil, constant.ErrAPIExceed)
+				return mocked
+			},
+			expectedOutput: func(output map[string]any) {
+				assert.Equal(t, output == nil, true)
+			},
+			expectedProgress: func(progress *dto.JobProgressRes) {
+				assert.Equal(t, progress.Cursor.String(), "2025-06-03")
+				assert.Equal(t, progress.Inserted, 1)
+			},
+			expectedErr: func(err error) {
+				assert.Equal(t, errors.Is(err, constant.ErrAPIExceed), true)
+			},
+		},
+		{
+			name: "falls back to stooq for premium-only history",
+			providerSetup: func() provider.ProviderItf {
+				mocked := new(mocks3.ProviderItf)
+				mocked.On("MaxRangeDays").Return(0)
+				mocked.On("DailyBars", "IBM", util.Date("2025-06-01"), util.Date("2025-06-06")).
+					Return(nil, constant.ErrAPIPremium)
+				return mocked
+			},
+			fallbackSetup: func() provider.ProviderItf {
+				mocked := new(mocks3.ProviderItf)
+				mocked.On("MaxRangeDays").Return(0)
+				mocked.On("DailyBars", "IBM", util.Date("2025-06-01"), util.Date("2025-06-06")).
+					Return([]dto.DailyOHLCVRes{bar("2025-06-02"), bar("2025-06-03")}, nil)
+				return mocked
+			},
+			expectedOutput: func(output map[string]any) {
+				assert.Equal(t, output["provider"], provider.StooqName)
+				assert.Equal(t, output["inserted"], 2)
+			},
+			expectedProgress: func(progress *dto.JobProgressRes) {
+				assert.Equal(t, progress.Cursor.String(), "2025-05-31")
+			},
+			expectedErr: func(err error) {
+				assert.Equal(t, err, nil)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "IBM").Return(
+				&dto.SymbolDataMeta{Symbol: "IBM", LastRefreshed: util.Date("2025-06-30")}, nil)
+			rp.On("BarDates", c, "IBM").Return([]dto.DateOnly{util.Date("2025-06-05")}, nil)
+			rp.On("LatestBarBefore", c, "IBM", mock.Anything).Return(nil, nil)
+			rp.On("InsertBars", c, mock.Anything).Return(nil)
+			rp.On("InsertQuarantine", c, mock.Anything).Return(nil)
+			rp.On("UpdateJob", c, mock.Anything).Return(nil)
+			rp.On("AddAPICalls", c, "fake", 1).Return(nil)
+			rp.On("CorporateActions", c, "IBM").Return([]dto.CorporateActionRes{}, nil)
+			rp.On("AddAPICalls", c, provider.StooqName, 1).Return(nil)
+
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+			uc.providers = map[string]provider.ProviderItf{"fake": tt.providerSetup()}
+			if tt.fallbackSetup != nil {
+				uc.providers[provider.StooqName] = tt.fallbackSetup()
+			}
+			job := newJob()
+
+			//when
+			output, err := uc.Backfill(c, job)
+
+			//then
+			tt.expectedOutput(output)
+			tt.expectedProgress(job.Progress)
+			tt.expectedErr(err)
+		})
+	}
+}
+
+func TestUnitUsecaseSymbolGaps(t *testing.T) {
+	queuedFrom, queuedTo := util.Date("2025-06-01"), util.Date("2025-06-05")
+
+	testCases := []struct {
+		name           string
+		req            *dto.GapsReq
+		dates          []dto.DateOnly
+		quarantined    []*dto.QuarantinedBarRes
+		active         []*dto.JobRes
+		expectedOutput func(*dto.SymbolGapsRes)
+		expectedJobs   int
+	}{
+		{
+			name:  "nothing stored",
+			req:   &dto.GapsReq{Symbol: "IBM"},
+			dates: []dto.DateOnly{},
+			expectedOutput: func(output *dto.SymbolGapsRes) {
+				assert.Equal(t, output.FirstBar == nil, true)
+				assert.Equal(t, output.MissingSessions, 0)
+			},
+		},
+		{
+			name: "missing sessions grouped into ranges",
+			req:  &dto.GapsReq{Symbol: "IBM"},
+			dates: []dto.DateOnly{
+				util.Date("2025-06-02"), util.Date("2025-06-03"),
+				util.Date("2025-06-06"), util.Date("2025-06-09"),
+			},
+			expectedOutput: func(output *dto.SymbolGapsRes) {
+				assert.Equal(t, output.ExpectedSessions, 7)
+				assert.Equal(t, output.StoredSessions, 4)
+				assert.Equal(t, output.MissingSessions, 3)
+				assert.Equal(t, reflect.DeepEqual(output.Ranges, []dto.GapRangeRes{
+					{From: util.Date("2025-06-04"), To: util.Date("2025-06-05"), Sessions: 2},
+					{From: util.Date("2025-06-10"), To: util.Date("2025-06-10"), Sessions: 1},
+				}), true)
+			},
+		},
+		{
+			name: "refetch queues a backfill per range",
+			req:  &dto.GapsReq{Symbol: "IBM", Refetch: true},
+			dates: []dto.DateOnly{
+				util.Date("2025-06-02"), util.Date("2025-06-03"),
+				util.Date("2025-06-06"), util.Date("2025-06-09"),
+			},
+			expectedOutput: func(output *dto.SymbolGapsRes) {
+				assert.Equal(t, len(output.Jobs), 2)
+			},
+			expectedJobs: 2,
+		},
+		{
+			name: "quarantined sessions are not missing",
+			req:  &dto.GapsReq{Symbol: "IBM"},
+			dates: []dto.DateOnly{
+				util.Date("2025-06-02"), util.Date("2025-06-03"),
+				util.Date("2025-06-06"), util.Date("2025-06-09"),
+			},
+			quarantined: []*dto.QuarantinedBarRes{
+				{Bar: dto.DailyOHLCVRes{Day: util.Date("2025-06-10")}},
+			},
+			expectedOutput: func(output *dto.SymbolGapsRes) {
+				assert.Equal(t, output.MissingSessions, 2)
+				assert.Equal(t, output.QuarantinedSessions, 1)
+				assert.Equal(t, len(output.Ranges), 1)
+			},
+		},
+		{
+			name: "refetch leaves ranges a queued backfill covers",
+			req:  &dto.GapsReq{Symbol: "IBM", Refetch: true},
+			dates: []dto.DateOnly{
+				util.Date("2025-06-02"), util.Date("2025-06-03"),
+				util.Date("2025-06-06"), util.Date("2025-06-09"),
+			},
+			active: []*dto.JobRes{{Id: "queued", From: &queuedFrom, To: &queuedTo}},
+			expectedOutput: func(output *dto.SymbolGapsRes) {
+				assert.Equal(t, len(output.Jobs), 2)
+				assert.Equal(t, output.Jobs[0].Id, "queued")
+			},
+			expectedJobs: 1,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "IBM").Return(
+				&dto.SymbolDataMeta{Symbol: "IBM", LastRefreshed: util.Date("2025-06-10")}, nil)
+			rp.On("BarDates", c, "IBM").Return(tt.dates, nil)
+			rp.On("QuarantinedBars", c, &dto.QuarantineReq{Symbol: "IBM", Status: constant.QuarantinePending}).
+				Return(tt.quarantined, nil)
+			rp.On("QuarantinedBars", c, &dto.QuarantineReq{Symbol: "IBM", Status: constant.QuarantineRejected}).
+				Return([]*dto.QuarantinedBarRes{}, nil)
+			rp.On("ActiveJobs", c, constant.JobTypeBackfill, "IBM").Return(tt.active, nil)
+			rp.On("InsertJob", c, mock.AnythingOfType("*dto.JobReq")).Return(&dto.JobRes{}, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.SymbolGaps(c, tt.req)
+
+			//then
+			assert.Equal(t, err, nil)
+			tt.expectedOutput(output)
+			rp.AssertNumberOfCalls(t, "InsertJob", tt.expectedJobs)
+		})
+	}
+}
+
+func TestUnitUsecaseReviewQuarantined(t *testing.T) {
+	quarantined := func(status string) *dto.QuarantinedBarRes {
+		return &dto.QuarantinedBarRes{
+			Id:     "1",
+			Symbol: "IBM",
+			Bar:    dto.DailyOHLCVRes{Day: util.Date("2025-06-11")},
+			Status: status,
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		req             *dto.ReviewQuarantineReq
+		status          string
+		expectedStatus  string
+		expectedInserts int
+		expectedUpdates int
+		expectedErr     error
+	}{
+		{
+			name:            "accept stores the bar and moves last refreshed",
+			req:             &dto.ReviewQuarantineReq{Id: "1", Accept: true},
+			status:          constant.QuarantinePending,
+			expectedStatus:  constant.QuarantineAccepted,
+			expectedInserts: 1,
+			expectedUpdates: 1,
+		},
+		{
+			name:           "reject only records the decision",
+			req:            &dto.ReviewQuarantineReq{Id: "1"},
+			status:         constant.QuarantinePending,
+			expectedStatus: constant.QuarantineRejected,
+		},
+		{
+			name:        "already reviewed",
+			req:         &dto.ReviewQuarantineReq{Id: "1", Accept: true},
+			status:      constant.QuarantineRejected,
+			expectedErr: constant.ErrQuarantineReviewed,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetQuarantined", c, "1").Return(quarantined(tt.status), nil)
+			rp.On("GetSymbol", c, "IBM").Return(
+				&dto.SymbolDataMeta{Symbol: "IBM", LastRefreshed: util.Date("2025-06-10")}, nil)
+			rp.On("ReviewQuarantined", c, "1", mock.AnythingOfType("string")).Return(nil)
+			rp.On("InsertBars", c, mock.Anything).Return(nil)
+			rp.On("UpdateLastRefreshed", c, mock.Anything).Return(nil)
+			rp.On("AlertRules", c, mock.Anything).Return([]*dto.AlertRuleRes{}, nil).Maybe()
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.ReviewQuarantined(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, output.Status, tt.expectedStatus)
+			}
+			rp.AssertNumberOfCalls(t, "InsertBars", tt.expectedInserts)
+			rp.AssertNumberOfCalls(t, "UpdateLastRefreshed", tt.expectedUpdates)
+		})
+	}
+}
+
+func TestUnitUsecaseStoredData(t *testing.T) {
+	bar := func(text string, close int64) dto.DailyOHLCVRes {
+		return dto.DailyOHLCVRes{
+			Day:    util.Date(text),
+			OHLC:   map[string]decimal.Decimal{"close": decimal.NewFromInt(close)},
+			Volume: 100,
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		req            *dto.StoredDataReq
+		expectedCloses []string
+		expectedErr    error
+	}{
+		{
+			name:           "raw by default",
+			req:            &dto.StoredDataReq{},
+			expectedCloses: []string{"400", "100"},
+		},
+		{
+			name:           "split adjusted",
+			req:            &dto.StoredDataReq{Adjust: constant.AdjustSplits},
+			expectedCloses: []string{"100", "100"},
+		},
+		{
+			name:           "split adjusted weekly bars",
+			req:            &dto.StoredDataReq{Adjust: constant.AdjustSplits, Interval: constant.IntervalWeek},
+			expectedCloses: []string{"100"},
+		},
+		{
+			name:        "unknown adjustment",
+			req:         &dto.StoredDataReq{Adjust: "weird"},
+			expectedErr: constant.ErrInvalidAdjust,
+		},
+		{
+			name:        "unknown interval",
+			req:         &dto.StoredDataReq{Interval: "fortnight"},
+			expectedErr: constant.ErrInvalidInterval,
+		},
+		{
+			name:           "daily bars annotated with patterns",
+			req:            &dto.StoredDataReq{Annotate: []string{constant.AnnotatePatterns}},
+			expectedCloses: []string{"400", "100"},
+		},
+		{
+			name: "annotations of aggregated bars",
+			req: &dto.StoredDataReq{Interval: constant.IntervalWeek,
+				Annotate: []string{constant.AnnotatePatterns}},
+			expectedErr: constant.ErrInvalidAnnotation,
+		},
+		{
+			name:        "unknown annotation",
+			req:         &dto.StoredDataReq{Annotate: []string{"trends"}},
+			expectedErr: constant.ErrInvalidAnnotation,
+		},
+		{
+			name: "daily bars annotated with anomalies",
+			req: &dto.StoredDataReq{
+				Annotate: []string{constant.AnnotateAnomalies},
+				Anomaly:  dto.AnomalyParams{Method: constant.AnomalyMAD},
+			},
+			expectedCloses: []string{"400", "100"},
+		},
+		{
+			name: "unknown anomaly method",
+			req: &dto.StoredDataReq{
+				Annotate: []string{constant.AnnotateAnomalies},
+				Anomaly:  dto.AnomalyParams{Method: "iqr"},
+			},
+			expectedErr: constant.ErrInvalidAnomaly,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("StoredData", c).Return([]dto.DataPerSymbol{{
+				MetaData:   &dto.SymbolDataMeta{Symbol: "AAPL"},
+				TimeSeries: []dto.DailyOHLCVRes{bar("2025-06-02", 400), bar("2025-06-03", 100)},
+			}}, nil)
+			rp.On("CorporateActions", c, "AAPL").Return([]dto.CorporateActionRes{{
+				Symbol: "AAPL",
+				Date:   util.Date("2025-06-03"),
+				Type:   constant.ActionSplit,
+				Value:  decimal.NewFromInt(4),
+			}}, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.StoredData(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				closes := make([]string, 0)
+				for _, week := range output[0].Weeks {
+					for _, day := range week.DailyData {
+						closes = append(closes, day.OHLC["close"].String())
+					}
+				}
+				for _, bar := range output[0].Bars {
+					closes = append(closes, bar.OHLC["close"].String())
+				}
+				assert.Equal(t, closes, tt.expectedCloses)
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseCollectSymbolConcurrent(t *testing.T) {
+	t.Setenv("ALPHA_VANTAGE_API_KEY", "_________________________")
+
+	//given
+	// One caller's request is already cancelled, and the other asks in
+	// lower case
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	callers := []*gin.Context{
+		util.NewBackgroundContext(cancelled),
+		util.NewBackgroundContext(context.Background()),
+	}
+	symbols := []string{"IBM", "ibm"}
+	body := `{"Meta Data": {"2. Symbol": "IBM", "3. Last Refreshed": "2025-06-13",` +
+		`"5. Time Zone": "US/Eastern"}, "Time Series (Daily)": {"2025-06-13": ` +
+		`{"1. open": "10", "2. high": "12", "3. low": "9", "4. close": "11", "5. volume": "1000"}}}`
+
+	var workErr error
+	rp := new(mocks1.RepoItf)
+	rp.On("CheckSymbolExists", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		workErr = args.Get(0).(*gin.Context).Request.Context().Err()
+	}).Return(false, nil)
+	rp.On("InsertNewSymbolData", mock.Anything, mock.Anything).Return(nil)
+	rp.On("AlertRules", mock.Anything, mock.Anything).Return([]*dto.AlertRuleRes{}, nil)
+	rp.On("AddAPICalls", mock.Anything, provider.AlphaVantageName, 1).Return(nil)
+	rp.On("CorporateActions", mock.Anything, "IBM").Return([]dto.CorporateActionRes{}, nil)
+
+	// The fetch is slow enough for both callers to arrive while it runs
+	hc := new(mocks2.HttpClientItf)
+	hc.On("Get", mock.AnythingOfType("string")).
+		After(50*time.Millisecond).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))}, nil)
+	hc.On("ReadAll", mock.Anything).Return([]byte(body), nil)
+	uc := NewUsecase(rp, hc)
+
+	//when
+	outputs := make(chan *dto.StockDataRes, 2)
+	for i := range 2 {
+		go func() {
+			output, err := uc.CollectSymbol(callers[i], &dto.CollectSymbolReq{Symbol: symbols[i]})
+			assert.Equal(t, err, nil)
+			outputs <- output
+		}()
+	}
+	first, second := <-outputs, <-outputs
+
+	//then
+	assert.Equal(t, first == second, true)
+	assert.Equal(t, workErr, nil)
+	hc.AssertNumberOfCalls(t, "Get", 1)
+	rp.AssertNumberOfCalls(t, "AddAPICalls", 1)
+	rp.AssertNumberOfCalls(t, "InsertNewSymbolData", 1)
+}
+
+func TestUnitUsecaseIndicators(t *testing.T) {
+	from := util.Date("2025-06-04")
+	closes := []int64{10, 11, 12, 13, 14}
+	bars := make([]dto.DailyOHLCVRes, len(closes))
+	for i, close := range closes {
+		bars[i] = dto.DailyOHLCVRes{
+			Day:  util.Date("2025-06-02").AddDate(0, 0, i),
+			OHLC: map[string]decimal.Decimal{"close": decimal.NewFromInt(close)},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		req            *dto.IndicatorsReq
+		expectedWarmUp int
+		expectedSMA    []string
+		expectedErr    error
+	}{
+		{
+			name: "warms up on bars before the window",
+			req: &dto.IndicatorsReq{
+				Series: dto.SeriesReq{Symbol: "AAPL", From: &from},
+				Types:  []string{constant.IndicatorSMA},
+				Period: 3,
+			},
+			expectedWarmUp: 0,
+			expectedSMA:    []string{"11", "12", "13"},
+		},
+		{
+			name: "warm-up inside the window",
+			req: &dto.IndicatorsReq{
+				Series: dto.SeriesReq{Symbol: "AAPL"},
+				Types:  []string{constant.IndicatorSMA},
+				Period: 4,
+			},
+			expectedWarmUp: 3,
+			expectedSMA:    []string{"", "", "", "11.5", "12.5"},
+		},
+		{
+			name:        "no indicator",
+			req:         &dto.IndicatorsReq{Series: dto.SeriesReq{Symbol: "AAPL"}},
+			expectedErr: constant.ErrNoIndicator,
+		},
+		{
+			name: "unknown indicator",
+			req: &dto.IndicatorsReq{
+				Series: dto.SeriesReq{Symbol: "AAPL"},
+				Types:  []string{"vwap"},
+			},
+			expectedErr: constant.ErrUnknownIndicator("vwap"),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "AAPL").Return(&dto.SymbolDataMeta{Symbol: "AAPL"}, nil)
+			rp.On("SymbolBars", c, "AAPL").Return(bars, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.Indicators(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, output.Indicators[0].WarmUp, tt.expectedWarmUp)
+				sma := make([]string, len(output.Points))
+				for i, point := range output.Points {
+					if value := point.Values["sma"]; value != nil {
+						sma[i] = value.String()
+					}
+				}
+				assert.Equal(t, sma, tt.expectedSMA)
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseStats(t *testing.T) {
+	closes := []int64{100, 110, 99, 121}
+	bars := make([]dto.DailyOHLCVRes, len(closes))
+	for i, close := range closes {
+		bars[i] = dto.DailyOHLCVRes{
+			Day:  util.Date("2025-06-02").AddDate(0, 0, i),
+			OHLC: map[string]decimal.Decimal{"close": decimal.NewFromInt(close)},
+		}
+	}
+	from := util.Date("2025-06-03")
+	to := util.Date("2025-06-04")
+	first := util.Date("2025-06-02")
+	after := util.Date("2025-06-06")
+
+	testCases := []struct {
+		name               string
+		req                *dto.StatsReq
+		expectedCumulative string
+		expectedBest       string
+		expectedWorst      string
+		expectedDrawdown   []string
+		expectedErr        error
+	}{
+		{
+			name:               "whole history",
+			req:                &dto.StatsReq{Series: dto.SeriesReq{Symbol: "AAPL"}},
+			expectedCumulative: "0.21",
+			expectedBest:       "2025-06-05 0.2222",
+			expectedWorst:      "2025-06-04 -0.1",
+			expectedDrawdown:   []string{"-0.1", "2025-06-03", "2025-06-04"},
+		},
+		{
+			name:               "window measured from the close before it",
+			req:                &dto.StatsReq{Series: dto.SeriesReq{Symbol: "AAPL", From: &from, To: &to}},
+			expectedCumulative: "-0.01",
+			expectedBest:       "2025-06-03 0.1",
+			expectedWorst:      "2025-06-04 -0.1",
+			expectedDrawdown:   []string{"-0.1", "2025-06-03", "2025-06-04"},
+		},
+		{
+			name:        "single bar",
+			req:         &dto.StatsReq{Series: dto.SeriesReq{Symbol: "AAPL", To: &first}},
+			expectedErr: constant.ErrNotEnoughBars,
+		},
+		{
+			name:        "window without bars",
+			req:         &dto.StatsReq{Series: dto.SeriesReq{Symbol: "AAPL", From: &after}},
+			expectedErr: constant.ErrNoBars,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "AAPL").Return(&dto.SymbolDataMeta{Symbol: "AAPL"}, nil)
+			rp.On("SymbolBars", c, "AAPL").Return(bars, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.Stats(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, output.CumulativeReturn.String(), tt.expectedCumulative)
+				assert.Equal(t, output.BestDay.Date.String()+" "+output.BestDay.Return.String(), tt.expectedBest)
+				assert.Equal(t, output.WorstDay.Date.String()+" "+output.WorstDay.Return.String(), tt.expectedWorst)
+				assert.Equal(t, []string{
+					output.MaxDrawdown.Value.String(),
+					output.MaxDrawdown.Peak.String(),
+					output.MaxDrawdown.Trough.String(),
+				}, tt.expectedDrawdown)
+				assert.Equal(t, output.Days, len(output.Returns))
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseCorrelation(t *testing.T) {
+	aapl := util.Bars("2025-06-02", 100, 110, 99, 132)
+	// Skips 2025-06-04, and moves with AAPL on the dates both traded
+	msft := append(util.Bars("2025-06-02", 200, 220), util.Bars("2025-06-05", 264, 250)...)
+	// Moves against AAPL
+	ibm := util.Bars("2025-06-02", 100, 90, 99, 81)
+
+	testCases := []struct {
+		name                string
+		req                 *dto.CorrelationReq
+		expectedCorrelation [][]string
+		expectedDays        int
+		expectedRolling     []string
+		expectedErr         error
+	}{
+		{
+			name: "aligned on common dates",
+			req:  &dto.CorrelationReq{Symbols: []string{"AAPL", "MSFT"}},
+			expectedCorrelation: [][]string{
+				{"1", "1"},
+				{"1", "1"},
+			},
+			expectedDays: 2,
+		},
+		{
+			name: "rolling correlation of a pair",
+			req: &dto.CorrelationReq{
+				Symbols: []string{"AAPL", "IBM", "AAPL"},
+				Pair:    []string{"IBM", "AAPL"},
+				Window:  2,
+			},
+			expectedCorrelation: [][]string{
+				{"1", "-0.9605"},
+				{"-0.9605", "1"},
+			},
+			expectedDays:    3,
+			expectedRolling: []string{"-", "-1", "-1"},
+		},
+		{
+			name:        "single symbol",
+			req:         &dto.CorrelationReq{Symbols: []string{"AAPL", "AAPL"}},
+			expectedErr: constant.ErrNotEnoughSymbols,
+		},
+		{
+			name: "pair outside the symbols",
+			req: &dto.CorrelationReq{
+				Symbols: []string{"AAPL", "MSFT"},
+				Pair:    []string{"AAPL", "IBM"},
+			},
+			expectedErr: constant.ErrInvalidPair,
+		},
+		{
+			name:        "untracked symbol",
+			req:         &dto.CorrelationReq{Symbols: []string{"AAPL", "TSLA"}},
+			expectedErr: constant.ErrSymbolNotTracked,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			for symbol, series := range map[string][]dto.DailyOHLCVRes{"AAPL": aapl, "MSFT": msft, "IBM": ibm} {
+				rp.On("GetSymbol", c, symbol).Return(&dto.SymbolDataMeta{Symbol: symbol}, nil)
+				rp.On("SymbolBars", c, symbol).Return(series, nil)
+			}
+			rp.On("GetSymbol", c, "TSLA").Return(nil, constant.ErrSymbolNotTracked)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.Correlation(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				correlation := make([][]string, len(output.Correlation))
+				for i, row := range output.Correlation {
+					for _, value := range row {
+						correlation[i] = append(correlation[i], value.String())
+					}
+				}
+				assert.Equal(t, correlation, tt.expectedCorrelation)
+				assert.Equal(t, output.Days, tt.expectedDays)
+				if tt.expectedRolling != nil {
+					rolling := make([]string, len(output.Rolling.Points))
+					for i, point := range output.Rolling.Points {
+						rolling[i] = "-"
+						if point.Value != nil {
+							rolling[i] = point.Value.String()
+						}
+					}
+					assert.Equal(t, rolling, tt.expectedRolling)
+					assert.Equal(t, output.Rolling.WarmUp, 1)
+				}
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseCompare(t *testing.T) {
+	from := util.Date("2025-06-03")
+
+	testCases := []struct {
+		name           string
+		req            *dto.CompareReq
+		expectedDates  []string
+		expectedValues map[string][]string
+		expectedReturn map[string][]string
+		expectedErr    error
+	}{
+		{
+			name:          "different start dates",
+			req:           &dto.CompareReq{Symbols: []string{"AAPL", "NEW"}},
+			expectedDates: []string{"2025-06-02", "2025-06-03", "2025-06-04", "2025-06-05", "2025-06-06"},
+			expectedValues: map[string][]string{
+				"AAPL": {"100", "110", "99", "132", "132"},
+				"NEW":  {"-", "-", "100", "110", "120"},
+			},
+			expectedReturn: map[string][]string{
+				"AAPL": {"0.32", "0.3333"},
+				"NEW":  {"0.2", "0.2"},
+			},
+		},
+		{
+			name:          "rebased at the window start",
+			req:           &dto.CompareReq{Symbols: []string{"AAPL", "NEW"}, From: &from},
+			expectedDates: []string{"2025-06-03", "2025-06-04", "2025-06-05", "2025-06-06"},
+			expectedValues: map[string][]string{
+				"AAPL": {"100", "90", "120", "120"},
+				"NEW":  {"-", "100", "110", "120"},
+			},
+			expectedReturn: map[string][]string{
+				"AAPL": {"0.2", "0.3333"},
+				"NEW":  {"0.2", "0.2"},
+			},
+		},
+		{
+			name:        "single symbol",
+			req:         &dto.CompareReq{Symbols: []string{"AAPL"}},
+			expectedErr: constant.ErrNotEnoughSymbols,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			for symbol, series := range map[string][]dto.DailyOHLCVRes{
+				"AAPL": util.Bars("2025-06-02", 100, 110, 99, 132),
+				"NEW":  util.Bars("2025-06-04", 50, 55, 60),
+			} {
+				rp.On("GetSymbol", c, symbol).Return(&dto.SymbolDataMeta{Symbol: symbol}, nil)
+				rp.On("SymbolBars", c, symbol).Return(series, nil)
+			}
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.Compare(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				dates := make([]string, len(output.Dates))
+				for i, day := range output.Dates {
+					dates[i] = day.String()
+				}
+				assert.Equal(t, dates, tt.expectedDates)
+				for _, series := range output.Series {
+					values := make([]string, len(series.Values))
+					for i, value := range series.Values {
+						values[i] = "-"
+						if value != nil {
+							values[i] = value.String()
+						}
+					}
+					assert.Equal(t, values, tt.expectedValues[series.Symbol])
+					assert.Equal(t, []string{series.Return.String(), series.CommonReturn.String()},
+						tt.expectedReturn[series.Symbol])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseCreatePortfolio(t *testing.T) {
+	holding := func(symbol string, quantity int64) dto.HoldingRes {
+		return dto.HoldingRes{Symbol: symbol, Quantity: decimal.NewFromInt(quantity)}
+	}
+
+	testCases := []struct {
+		name               string
+		holdings           []dto.HoldingRes
+		expectedCollecting []string
+		expectedErr        error
+	}{
+		{
+			name:               "collects untracked symbols",
+			holdings:           []dto.HoldingRes{holding("AAPL", 10), holding("NEW", 5)},
+			expectedCollecting: []string{"NEW"},
+		},
+		{
+			name:        "repeated symbol",
+			holdings:    []dto.HoldingRes{holding("AAPL", 10), holding("AAPL", 5)},
+			expectedErr: constant.ErrInvalidHolding,
+		},
+		{
+			name:        "non-positive quantity",
+			holdings:    []dto.HoldingRes{holding("AAPL", 0)},
+			expectedErr: constant.ErrInvalidHolding,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			req := &dto.PortfolioReq{Name: "core", Holdings: tt.holdings}
+
+			rp := new(mocks1.RepoItf)
+			rp.On("InsertPortfolio", mock.Anything, req).Return(&dto.PortfolioRes{
+				Id:       "p1",
+				Name:     req.Name,
+				Holdings: req.Holdings,
+			}, nil)
+			rp.On("CheckSymbolExists", mock.Anything, &dto.CollectSymbolReq{Symbol: "AAPL"}).Return(true, nil)
+			rp.On("CheckSymbolExists", mock.Anything, &dto.CollectSymbolReq{Symbol: "NEW"}).Return(false, nil)
+			rp.On("ActiveJob", mock.Anything, constant.JobTypeCollect, "NEW").Return(nil, nil)
+			rp.On("InsertJob", mock.Anything, &dto.JobReq{Type: constant.JobTypeCollect, Symbol: "NEW"}).
+				Return(&dto.JobRes{Id: "j1", Type: constant.JobTypeCollect, Symbol: "NEW"}, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.CreatePortfolio(c, req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				collecting := make([]string, len(output.Collecting))
+				for i, job := range output.Collecting {
+					collecting[i] = job.Symbol
+				}
+				assert.Equal(t, collecting, tt.expectedCollecting)
+			} else {
+				rp.AssertNotCalled(t, "InsertPortfolio", c, req)
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseValuePortfolio(t *testing.T) {
+	from := util.Date("2025-06-03")
+
+	testCases := []struct {
+		name                string
+		req                 *dto.ValuationReq
+		expectedPoints      []string
+		expectedPending     []string
+		expectedCurrency    string
+		expectedConversions int
+		expectedErr         error
+	}{
+		{
+			name: "starts once every holding is priced",
+			req:  &dto.ValuationReq{Id: "p1"},
+			expectedPoints: []string{
+				"2025-06-03 1200 0 AAPL 0.9167/0 MSFT 0.0833/0",
+				"2025-06-04 1300 0.0833 AAPL 0.9231/0.0833 MSFT 0.0769/0",
+				"2025-06-05 1320 0.1 AAPL 0.9091/0.0833 MSFT 0.0909/0.0167",
+			},
+			expectedPending:  []string{"NEW"},
+			expectedCurrency: "USD",
+		},
+		{
+			name: "carries the close before the window",
+			req:  &dto.ValuationReq{Id: "p1", From: &from},
+			expectedPoints: []string{
+				"2025-06-03 1200 0 AAPL 0.9167/0 MSFT 0.0833/0",
+				"2025-06-04 1300 0.0833 AAPL 0.9231/0.0833 MSFT 0.0769/0",
+				"2025-06-05 1320 0.1 AAPL 0.9091/0.0833 MSFT 0.0909/0.0167",
+			},
+			expectedPending:  []string{"NEW"},
+			expectedCurrency: "USD",
+		},
+		{
+			name: "converts holdings into the asked currency",
+			req:  &dto.ValuationReq{Id: "p3", Currency: "usd"},
+			expectedPoints: []string{
+				"2025-06-02 1375 0 AAPL 0.7273/0 TSCO.LON 0.2727/0",
+				"2025-06-03 1487.5 0.0818 AAPL 0.7395/0.0727 TSCO.LON 0.2605/0.0091",
+				"2025-06-04 1600 0.1636 AAPL 0.75/0.1455 TSCO.LON 0.25/0.0182",
+			},
+			expectedCurrency:    "USD",
+			expectedConversions: 1,
+		},
+		{
+			name: "converts holdings into the first one's currency by default",
+			req:  &dto.ValuationReq{Id: "p3"},
+			expectedPoints: []string{
+				"2025-06-02 1375 0 AAPL 0.7273/0 TSCO.LON 0.2727/0",
+				"2025-06-03 1487.5 0.0818 AAPL 0.7395/0.0727 TSCO.LON 0.2605/0.0091",
+				"2025-06-04 1600 0.1636 AAPL 0.75/0.1455 TSCO.LON 0.25/0.0182",
+			},
+			expectedCurrency:    "USD",
+			expectedConversions: 1,
+		},
+		{
+			name:        "invalid currency",
+			req:         &dto.ValuationReq{Id: "p1", Currency: "US"},
+			expectedErr: constant.ErrInvalidCurrency,
+		},
+		{
+			name:        "unknown portfolio",
+			req:         &dto.ValuationReq{Id: "p2"},
+			expectedErr: constant.ErrPortfolioNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetPortfolio", c, &dto.GetPortfolioReq{Id: "p1"}).Return(&dto.PortfolioRes{
+				Id:   "p1",
+				Name: "core",
+				Holdings: []dto.HoldingRes{
+					{Symbol: "AAPL", Quantity: decimal.NewFromInt(10)},
+					{Symbol: "MSFT", Quantity: decimal.NewFromInt(1)},
+					{Symbol: "NEW", Quantity: decimal.NewFromInt(3)},
+				},
+			}, nil)
+			rp.On("GetPortfolio", c, &dto.GetPortfolioReq{Id: "p2"}).Return(nil, constant.ErrPortfolioNotFound)
+			rp.On("GetPortfolio", c, &dto.GetPortfolioReq{Id: "p3"}).Return(&dto.PortfolioRes{
+				Id:   "p3",
+				Name: "global",
+				Holdings: []dto.HoldingRes{
+					{Symbol: "AAPL", Quantity: decimal.NewFromInt(10)},
+					{Symbol: "TSCO.LON", Quantity: decimal.NewFromInt(100)},
+				},
+			}, nil)
+			rp.On("GetSymbol", c, "AAPL").Return(&dto.SymbolDataMeta{Symbol: "AAPL"}, nil)
+			rp.On("GetSymbol", c, "MSFT").Return(&dto.SymbolDataMeta{Symbol: "MSFT"}, nil)
+			rp.On("GetSymbol", c, "TSCO.LON").Return(&dto.SymbolDataMeta{Symbol: "TSCO.LON"}, nil)
+			rp.On("GetSymbol", c, "NEW").Return(nil, constant.ErrSymbolNotTracked)
+			// AAPL doesn't trade on 2025-06-05
+			rp.On("SymbolBars", c, "AAPL").Return(util.Bars("2025-06-02", 100, 110, 120), nil)
+			rp.On("SymbolBars", c, "MSFT").Return(util.Bars("2025-06-03", 100, 100, 120), nil)
+			rp.On("SymbolBars", c, "TSCO.LON").Return(util.Bars("2025-06-02", 300, 310, 320), nil)
+			rp.On("FXRates", c, &dto.FXRatesReq{Base: "GBP", Quote: "USD"}).Return([]dto.FXRateRes{
+				{Base: "GBP", Quote: "USD", Date: util.Date("2025-06-01"), Rate: decimal.RequireFromString("1.25")},
+			}, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.ValuePortfolio(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, output.Pending, tt.expectedPending)
+				assert.Equal(t, output.Currency, tt.expectedCurrency)
+				assert.Equal(t, len(output.Conversions), tt.expectedConversions)
+				points := make([]string, len(output.Points))
+				for i, point := range output.Points {
+					points[i] = point.Date.String() + " " + point.Value.String() + " " + point.Return.String()
+					symbols := make([]string, 0, len(point.Holdings))
+					for symbol := range point.Holdings {
+						symbols = append(symbols, symbol)
+					}
+					sort.Strings(symbols)
+					for _, symbol := range symbols {
+						held := point.Holdings[symbol]
+						points[i] += " " + symbol + " " + held.Weight.String() + "/" + held.Contribution.String()
+					}
+				}
+				assert.Equal(t, points, tt.expectedPoints)
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseAddTransaction(t *testing.T) {
+	bought := dto.TransactionRes{
+		Id:       "t1",
+		Symbol:   "AAPL",
+		Type:     constant.TransactionBuy,
+		Date:     util.Date("2025-01-02"),
+		Quantity: decimal.NewFromInt(10),
+		Price:    decimal.NewFromInt(100),
+	}
+
+	testCases := []struct {
+		name               string
+		req                *dto.TransactionReq
+		expectedCollecting bool
+		expectedErr        error
+	}{
+		{
+			name: "sale covered by the ledger",
+			req: &dto.TransactionReq{
+				Symbol:   "AAPL",
+				Type:     constant.TransactionSell,
+				Date:     util.Date("2025-02-03"),
+				Quantity: decimal.NewFromInt(10),
+				Price:    decimal.NewFromInt(120),
+			},
+		},
+		{
+			name: "sale before the purchase",
+			req: &dto.TransactionReq{
+				Symbol:   "AAPL",
+				Type:     constant.TransactionSell,
+				Date:     util.Date("2024-12-31"),
+				Quantity: decimal.NewFromInt(1),
+				Price:    decimal.NewFromInt(120),
+			},
+			expectedErr: constant.ErrOversold,
+		},
+		{
+			name: "purchase of an untracked symbol",
+			req: &dto.TransactionReq{
+				Symbol:   "NEW",
+				Type:     constant.TransactionBuy,
+				Date:     util.Date("2025-02-03"),
+				Quantity: decimal.NewFromInt(1),
+				Price:    decimal.NewFromInt(10),
+			},
+			expectedCollecting: true,
+		},
+		{
+			name: "fee without a symbol",
+			req: &dto.TransactionReq{
+				Type:   constant.TransactionFee,
+				Date:   util.Date("2025-02-03"),
+				Amount: decimal.NewFromInt(2),
+			},
+		},
+		{
+			name: "dividend without an amount",
+			req: &dto.TransactionReq{
+				Symbol: "AAPL",
+				Type:   constant.TransactionDividend,
+				Date:   util.Date("2025-02-03"),
+			},
+			expectedErr: constant.ErrInvalidTransaction,
+		},
+		{
+			name:        "unknown type",
+			req:         &dto.TransactionReq{Symbol: "AAPL", Type: "gift", Date: util.Date("2025-02-03")},
+			expectedErr: constant.ErrInvalidTransactionType,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			tt.req.PortfolioId = "p1"
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetPortfolio", mock.Anything, &dto.GetPortfolioReq{Id: "p1"}).Return(&dto.PortfolioRes{Id: "p1"}, nil)
+			rp.On("Transactions", mock.Anything, &dto.GetPortfolioReq{Id: "p1"}).Return([]dto.TransactionRes{bought}, nil)
+			rp.On("InsertTransaction", mock.Anything, tt.req).Return(&dto.TransactionRes{Id: "t2", Symbol: tt.req.Symbol}, nil)
+			rp.On("CheckSymbolExists", mock.Anything, &dto.CollectSymbolReq{Symbol: "AAPL"}).Return(true, nil)
+			rp.On("CheckSymbolExists", mock.Anything, &dto.CollectSymbolReq{Symbol: "NEW"}).Return(false, nil)
+			rp.On("ActiveJob", mock.Anything, constant.JobTypeCollect, "NEW").Return(nil, nil)
+			rp.On("InsertJob", mock.Anything, &dto.JobReq{Type: constant.JobTypeCollect, Symbol: "NEW"}).
+				Return(&dto.JobRes{Id: "j1", Type: constant.JobTypeCollect, Symbol: "NEW"}, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.AddTransaction(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, output.Collecting != nil, tt.expectedCollecting)
+			} else {
+				rp.AssertNotCalled(t, "InsertTransaction", c, tt.req)
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseLots(t *testing.T) {
+	//given
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	trade := func(symbol, kind, day string, quantity, price int64) dto.TransactionRes {
+		return dto.TransactionRes{
+			Symbol:   symbol,
+			Type:     kind,
+			Date:     util.Date(day),
+			Quantity: decimal.NewFromInt(quantity),
+			Price:    decimal.NewFromInt(price),
+		}
+	}
+
+	rp := new(mocks1.RepoItf)
+	rp.On("GetPortfolio", c, &dto.GetPortfolioReq{Id: "p1"}).Return(&dto.PortfolioRes{Id: "p1"}, nil)
+	rp.On("Transactions", c, &dto.GetPortfolioReq{Id: "p1"}).Return([]dto.TransactionRes{
+		trade("AAPL", constant.TransactionBuy, "2025-01-02", 10, 100),
+		trade("NEW", constant.TransactionBuy, "2025-01-03", 5, 10),
+		trade("AAPL", constant.TransactionBuy, "2025-02-03", 10, 130),
+		trade("AAPL", constant.TransactionSell, "2025-03-03", 15, 150),
+		{Type: constant.TransactionFee, Date: util.Date("2025-03-04"), Amount: decimal.NewFromInt(2)},
+	}, nil)
+	rp.On("LatestBarBefore", c, "AAPL", mock.Anything).Return(&dto.DailyOHLCVRes{
+		Day:  util.Date("2025-06-13"),
+		OHLC: map[string]decimal.Decimal{"close": decimal.NewFromInt(160)},
+	}, nil)
+	rp.On("LatestBarBefore", c, "NEW", mock.Anything).Return(nil, nil)
+	rp.On("GetPortfolio", c, &dto.GetPortfolioReq{Id: "p2"}).Return(&dto.PortfolioRes{Id: "p2"}, nil)
+	rp.On("Transactions", c, &dto.GetPortfolioReq{Id: "p2"}).Return([]dto.TransactionRes{
+		trade("AAPL", constant.TransactionBuy, "2025-01-02", 10, 100),
+		trade("TSCO.LON", constant.TransactionBuy, "2025-01-03", 100, 300),
+		trade("TSCO.LON", constant.TransactionSell, "2025-02-03", 50, 320),
+		{Type: constant.TransactionFee, Date: util.Date("2025-02-04"), Amount: decimal.NewFromInt(3)},
+	}, nil)
+	rp.On("LatestBarBefore", c, "TSCO.LON", mock.Anything).Return(&dto.DailyOHLCVRes{
+		Day:  util.Date("2025-06-13"),
+		OHLC: map[string]decimal.Decimal{"close": decimal.NewFromInt(330)},
+	}, nil)
+	rp.On("GetSymbol", c, "AAPL").Return(&dto.SymbolDataMeta{Symbol: "AAPL"}, nil)
+	rp.On("GetSymbol", c, "NEW").Return(nil, constant.ErrSymbolNotTracked)
+	rp.On("GetSymbol", c, "TSCO.LON").Return(&dto.SymbolDataMeta{Symbol: "TSCO.LON", Currency: "GBX"}, nil)
+	uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+	//when
+	lots, err := uc.Lots(c, &dto.LotsReq{PortfolioId: "p1", Method: constant.LotLIFO})
+	realized, realizedErr := uc.Realized(c, &dto.LotsReq{PortfolioId: "p1"})
+	_, methodErr := uc.Lots(c, &dto.LotsReq{PortfolioId: "p1", Method: "hifo"})
+	mixed, mixedErr := uc.Lots(c, &dto.LotsReq{PortfolioId: "p2"})
+	mixedRealized, mixedRealizedErr := uc.Realized(c, &dto.LotsReq{PortfolioId: "p2"})
+
+	//then
+	assert.Equal(t, err, nil)
+	assert.Equal(t, len(lots.Lots), 2)
+	assert.Equal(t, lots.Lots[0].Currency, "USD")
+	assert.Equal(t, lots.Lots[0].Unrealized.String(), "300")
+	assert.Equal(t, lots.Lots[1].Close, (*decimal.Decimal)(nil))
+	assert.Equal(t, len(lots.Totals), 1)
+	assert.Equal(t, lots.Totals["USD"].Unrealized.String(), "300")
+	assert.Equal(t, realizedErr, nil)
+	assert.Equal(t, realized.Method, constant.LotFIFO)
+	assert.Equal(t, realized.Totals["USD"].Gain.String(), "600")
+	assert.Equal(t, realized.Totals["USD"].Fees.String(), "2")
+	assert.Equal(t, realized.Totals["USD"].Net.String(), "598")
+	assert.Equal(t, realized.UnassignedFees.String(), "0")
+	assert.Equal(t, methodErr, constant.ErrInvalidLotMethod)
+	assert.Equal(t, mixedErr, nil)
+	assert.Equal(t, mixed.Lots[1].Currency, "GBX")
+	assert.Equal(t, mixed.Totals["USD"].Cost.String(), "1000")
+	assert.Equal(t, mixed.Totals["GBX"].MarketValue.String(), "16500")
+	assert.Equal(t, mixedRealizedErr, nil)
+	assert.Equal(t, mixedRealized.Closed[0].Currency, "GBX")
+	assert.Equal(t, mixedRealized.Totals["GBX"].Net.String(), "1000")
+	assert.Equal(t, mixedRealized.UnassignedFees.String(), "3")
+}
+
+func TestUnitUsecaseEvaluateAlerts(t *testing.T) {
+	checked := util.Date("2025-06-03")
+	checkedBefore := util.Date("2025-06-02")
+
+	testCases := []struct {
+		name             string
+		lastChecked      *dto.DateOnly
+		bars             []dto.DailyOHLCVRes
+		claimed          bool
+		expectedMarks    int
+		expectedTriggers int
+		expectedDates    []string
+	}{
+		{
+			name:             "close crossing the level fires",
+			bars:             util.Bars("2025-06-02", 99, 101),
+			claimed:          true,
+			expectedMarks:    1,
+			expectedTriggers: 1,
+			expectedDates:    []string{"2025-06-03"},
+		},
+		{
+			name:             "every bar stored since the last check is evaluated",
+			lastChecked:      &checkedBefore,
+			bars:             util.Bars("2025-06-02", 99, 101, 99, 102),
+			claimed:          true,
+			expectedMarks:    3,
+			expectedTriggers: 2,
+			expectedDates:    []string{"2025-06-03", "2025-06-05"},
+		},
+		{
+			name:          "close staying above the level doesn't fire again",
+			bars:          util.Bars("2025-06-02", 101, 102),
+			claimed:       true,
+			expectedMarks: 1,
+		},
+		{
+			name:        "latest bar already evaluated",
+			lastChecked: &checked,
+			bars:        util.Bars("2025-06-02", 99, 101),
+			claimed:     true,
+		},
+		{
+			name:          "another evaluation claimed the bar first",
+			bars:          util.Bars("2025-06-02", 99, 101),
+			expectedMarks: 1,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			rule := &dto.AlertRuleRes{
+				Id:          "r1",
+				Symbol:      "IBM",
+				Type:        constant.AlertCloseAbove,
+				Level:       decimal.NewFromInt(100),
+				WebhookURL:  "http://example.com/hook",
+				LastChecked: tt.lastChecked,
+			}
+
+			rp := new(mocks1.RepoItf)
+			rp.On("AlertRules", c, &dto.AlertRulesReq{Symbol: "IBM"}).Return([]*dto.AlertRuleRes{rule}, nil)
+			rp.On("SymbolBars", c, "IBM").Return(tt.bars, nil)
+			rp.On("MarkAlertChecked", c, "r1", mock.Anything).Return(tt.claimed, nil)
+			var dates []string
+			rp.On("InsertAlertTrigger", c, mock.Anything).Run(func(args mock.Arguments) {
+				dates = append(dates, args.Get(1).(*dto.AlertTriggerRes).Date.String())
+			}).Return(&dto.AlertTriggerRes{Id: "t1"}, nil)
+			rp.On("InsertJob", c, &dto.JobReq{Type: constant.JobTypeAlert, Symbol: "IBM", Ref: "t1"}).
+				Return(&dto.JobRes{Id: "j1"}, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			err := uc.evaluateAlerts(c, "IBM")
+
+			//then
+			assert.Equal(t, err, nil)
+			rp.AssertNumberOfCalls(t, "MarkAlertChecked", tt.expectedMarks)
+			rp.AssertNumberOfCalls(t, "InsertAlertTrigger", tt.expectedTriggers)
+			rp.AssertNumberOfCalls(t, "InsertJob", tt.expectedTriggers)
+			assert.Equal(t, dates, tt.expectedDates)
+		})
+	}
+}
+
+func TestUnitUsecaseDeliverAlert(t *testing.T) {
+	secret := "webhook-secret"
+	t.Setenv("ALERT_WEBHOOK_SECRET", secret)
+
+	testCases := []struct {
+		name             string
+		unsetSecret      bool
+		publicOnly       bool
+		receiverStatus   int
+		attempts         int
+		expectedRequests int
+		expectedJob      string
+		expectedStatus   string
+	}{
+		{
+			name:             "receiver accepts",
+			receiverStatus:   http.StatusOK,
+			attempts:         1,
+			expectedRequests: 1,
+			expectedJob:      constant.JobSucceeded,
+			expectedStatus:   constant.DeliveryDelivered,
+		},
+		{
+			name:             "redirect isn't followed",
+			receiverStatus:   http.StatusTemporaryRedirect,
+			attempts:         1,
+			expectedRequests: 1,
+			expectedJob:      constant.JobWaiting,
+			expectedStatus:   constant.DeliveryRetrying,
+		},
+		{
+			name:           "receiver on loopback refused",
+			publicOnly:     true,
+			receiverStatus: http.StatusOK,
+			attempts:       1,
+			expectedJob:    constant.JobWaiting,
+			expectedStatus: constant.DeliveryRetrying,
+		},
+		{
+			name:           "secret unset fails at once",
+			unsetSecret:    true,
+			attempts:       1,
+			expectedJob:    constant.JobFailed,
+			expectedStatus: constant.DeliveryFailed,
+		},
+		{
+			name:             "receiver errors, retried later",
+			receiverStatus:   http.StatusInternalServerError,
+			attempts:         2,
+			expectedRequests: 1,
+			expectedJob:      constant.JobWaiting,
+			expectedStatus:   constant.DeliveryRetrying,
+		},
+		{
+			name:             "receiver errors on the last attempt",
+			receiverStatus:   http.StatusInternalServerError,
+			attempts:         constant.WebhookMaxAttempts,
+			expectedRequests: 1,
+			expectedJob:      constant.JobFailed,
+			expectedStatus:   constant.DeliveryFailed,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.unsetSecret {
+				t.Setenv("ALERT_WEBHOOK_SECRET", "")
+			}
+
+			var received dto.AlertWebhookReq
+			verified := false
+			requests := 0
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				body, _ := io.ReadAll(r.Body)
+				verified = alert.Verify(secret, r.Header.Get(constant.WebhookTimestampHeader),
+					body, r.Header.Get(constant.WebhookSignatureHeader))
+				json.Unmarshal(body, &received)
+				w.Header().Set("Location", "/elsewhere")
+				w.WriteHeader(tt.receiverStatus)
+			}))
+			defer receiver.Close()
+
+			trigger := &dto.AlertTriggerRes{
+				Id:         "t1",
+				RuleId:     "r1",
+				Symbol:     "IBM",
+				Type:       constant.AlertCloseAbove,
+				Close:      decimal.NewFromInt(101),
+				Value:      decimal.NewFromInt(101),
+				WebhookURL: receiver.URL,
+				Status:     constant.DeliveryPending,
+				Attempts:   tt.attempts - 1,
+			}
+			var delivery dto.AlertTriggerRes
+			rp := new(mocks1.RepoItf)
+			rp.On("GetAlertTrigger", c, "t1").Return(trigger, nil)
+			rp.On("UpdateAlertDelivery", c, mock.Anything).Run(func(args mock.Arguments) {
+				delivery = *args.Get(1).(*dto.AlertTriggerRes)
+			}).Return(nil)
+			rp.On("UpdateJob", c, mock.Anything).Return(nil)
+			hc := util.NewLocalHttpClient()
+			if tt.publicOnly {
+				hc = util.NewHttpClient()
+			}
+			uc := NewUsecase(rp, hc)
+			job := &dto.JobRes{Id: "j1", Type: constant.JobTypeAlert, Ref: "t1", Attempts: tt.attempts}
+
+			//when
+			err := uc.RunJob(c, job)
+
+			//then
+			assert.Equal(t, err, nil)
+			assert.Equal(t, requests, tt.expectedRequests)
+			if tt.expectedRequests > 0 {
+				assert.Equal(t, verified, true)
+				assert.Equal(t, received.TriggerId, "t1")
+			}
+			assert.Equal(t, job.Status, tt.expectedJob)
+			assert.Equal(t, job.ResumeAt != nil, tt.expectedJob == constant.JobWaiting)
+			assert.Equal(t, delivery.Status, tt.expectedStatus)
+			assert.Equal(t, delivery.Attempts, tt.attempts)
+		})
+	}
+}
+
+func TestUnitUsecaseRunBacktest(t *testing.T) {
+	opens := []int64{10, 10, 11, 12}
+	bars := make([]dto.DailyOHLCVRes, len(opens))
+	for i, open := range opens {
+		price := decimal.NewFromInt(open)
+		bars[i] = dto.DailyOHLCVRes{
+			Day:  util.Date("2025-06-02").AddDate(0, 0, i),
+			OHLC: map[string]decimal.Decimal{"open": price, "high": price, "low": price, "close": price},
+		}
+	}
+	from := util.Date("2025-06-03")
+	last := util.Date("2025-06-05")
+
+	testCases := []struct {
+		name           string
+		req            *dto.BacktestReq
+		expectedFrom   dto.DateOnly
+		expectedEquity int
+		expectedFinal  string
+		expectedErr    error
+	}{
+		{
+			name:           "trades from the first bar of the window",
+			req:            &dto.BacktestReq{Symbol: "IBM", Strategy: constant.StrategyBuyAndHold, From: &from},
+			expectedFrom:   from,
+			expectedEquity: 3,
+			expectedFinal:  "10909",
+		},
+		{
+			name:        "unknown strategy",
+			req:         &dto.BacktestReq{Symbol: "IBM", Strategy: "martingale"},
+			expectedErr: constant.ErrInvalidStrategy,
+		},
+		{
+			name:        "single bar in the window",
+			req:         &dto.BacktestReq{Symbol: "IBM", Strategy: constant.StrategyBuyAndHold, From: &last},
+			expectedErr: constant.ErrNotEnoughBars,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "IBM").Return(&dto.SymbolDataMeta{Symbol: "IBM"}, nil)
+			rp.On("SymbolBars", c, "IBM").Return(bars, nil)
+			rp.On("InsertBacktest", c, mock.Anything).Return(
+				func(_ *gin.Context, res *dto.BacktestRes) (*dto.BacktestRes, error) {
+					res.Id = "b1"
+					return res, nil
+				})
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.RunBacktest(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, output.Id, "b1")
+				assert.Equal(t, output.Adjust, constant.AdjustNone)
+				assert.Equal(t, output.From, tt.expectedFrom)
+				assert.Equal(t, len(output.Equity), tt.expectedEquity)
+				assert.Equal(t, output.Summary.FinalEquity.String(), tt.expectedFinal)
+				rp.AssertNumberOfCalls(t, "InsertBacktest", 1)
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseScreen(t *testing.T) {
+	above := func(value int64) dto.ScreenConditionReq {
+		return dto.ScreenConditionReq{Field: constant.ScreenClose, Op: constant.ScreenGT, Value: decimal.NewFromInt(value)}
+	}
+	rising := dto.ScreenConditionReq{Field: constant.ScreenChange, Op: constant.ScreenGT}
+	tech := dto.ScreenConditionReq{Field: constant.ScreenSector, Sector: "Technology"}
+
+	testCases := []struct {
+		name            string
+		req             *dto.ScreenReq
+		expectedSymbols []string
+		expectedMatched int
+		expectedErr     error
+	}{
+		{
+			name:            "all conditions",
+			req:             &dto.ScreenReq{Conditions: []dto.ScreenConditionReq{above(50), rising}},
+			expectedSymbols: []string{"AAPL"},
+			expectedMatched: 1,
+		},
+		{
+			name: "any condition, sorted by change",
+			req: &dto.ScreenReq{Conditions: []dto.ScreenConditionReq{above(150), rising},
+				Logic: constant.ScreenOr, Sort: "change_1d"},
+			expectedSymbols: []string{"IBM", "AAPL"},
+			expectedMatched: 2,
+		},
+		{
+			name: "sector, limited",
+			req: &dto.ScreenReq{Conditions: []dto.ScreenConditionReq{tech},
+				Sort: "symbol", Order: constant.SortDesc, Limit: 1},
+			expectedSymbols: []string{"IBM"},
+			expectedMatched: 2,
+		},
+		{
+			name:        "sort by a column not screened",
+			req:         &dto.ScreenReq{Conditions: []dto.ScreenConditionReq{above(50)}, Sort: "change_1d"},
+			expectedErr: constant.ErrInvalidScreenSort,
+		},
+		{
+			name:        "unknown logic",
+			req:         &dto.ScreenReq{Conditions: []dto.ScreenConditionReq{above(50)}, Logic: "xor"},
+			expectedErr: constant.ErrInvalidScreenLogic,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("TrackedSymbols", c).Return([]dto.SymbolDataMeta{
+				{Symbol: "XOM"}, {Symbol: "AAPL"}, {Symbol: "IBM"},
+			}, nil)
+			rp.On("AllFundamentals", c).Return(map[string]dto.FundamentalsRes{
+				"AAPL": {Symbol: "AAPL", Sector: "TECHNOLOGY"},
+				"IBM":  {Symbol: "IBM", Sector: "TECHNOLOGY"},
+				"XOM":  {Symbol: "XOM", Sector: "ENERGY"},
+			}, nil)
+			rp.On("SymbolBars", c, "AAPL").Return(util.Bars("2025-06-02", 190, 200), nil)
+			rp.On("SymbolBars", c, "IBM").Return(util.Bars("2025-06-02", 40, 44), nil)
+			rp.On("SymbolBars", c, "XOM").Return(util.Bars("2025-06-02", 110, 100), nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.Screen(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				symbols := make([]string, len(output.Matches))
+				for i, match := range output.Matches {
+					symbols[i] = match.Symbol
+				}
+				assert.Equal(t, symbols, tt.expectedSymbols)
+				assert.Equal(t, output.Matched, tt.expectedMatched)
+				assert.Equal(t, output.Screened, 3)
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseBeta(t *testing.T) {
+	testCases := []struct {
+		name               string
+		req                *dto.BetaReq
+		benchmarkTracked   bool
+		expectedBenchmark  string
+		expectedCollecting bool
+		expectedBeta       string
+		expectedErr        error
+	}{
+		{
+			name:              "against the default benchmark",
+			req:               &dto.BetaReq{Series: dto.SeriesReq{Symbol: "AAPL"}, Window: 2},
+			benchmarkTracked:  true,
+			expectedBenchmark: constant.DefaultBenchmark,
+			expectedBeta:      "2",
+		},
+		{
+			name:               "untracked benchmark is collected first",
+			req:                &dto.BetaReq{Series: dto.SeriesReq{Symbol: "AAPL"}, Benchmark: "QQQ"},
+			expectedBenchmark:  "QQQ",
+			expectedCollecting: true,
+		},
+		{
+			name:        "benchmark against itself",
+			req:         &dto.BetaReq{Series: dto.SeriesReq{Symbol: "SPY"}},
+			expectedErr: constant.ErrBenchmarkIsSymbol,
+		},
+		{
+			name:        "window of a single return",
+			req:         &dto.BetaReq{Series: dto.SeriesReq{Symbol: "AAPL"}, Window: 1},
+			expectedErr: constant.ErrInvalidBetaWindow,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", mock.Anything, mock.Anything).Return(&dto.SymbolDataMeta{}, nil)
+			rp.On("CheckSymbolExists", mock.Anything, mock.Anything).Return(tt.benchmarkTracked, nil)
+			rp.On("ActiveJob", mock.Anything, constant.JobTypeCollect, mock.Anything).Return(nil, nil)
+			rp.On("InsertJob", mock.Anything, mock.Anything).Return(&dto.JobRes{Id: "j1"}, nil)
+			// Benchmark moves 10%, 0%, -10%, 10%; the stock twice as much
+			rp.On("SymbolBars", mock.Anything, "SPY").Return(util.Bars("2025-06-02", 100, 110, 110, 99, 108.9), nil)
+			rp.On("SymbolBars", mock.Anything, "AAPL").Return(util.Bars("2025-06-02", 100, 120, 120, 96, 115.2), nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.Beta(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, output.Benchmark, tt.expectedBenchmark)
+			assert.Equal(t, output.Collecting != nil, tt.expectedCollecting)
+			if tt.expectedCollecting {
+				assert.Equal(t, output.Stats, (*dto.BetaStatsRes)(nil))
+				return
+			}
+			assert.Equal(t, output.Stats.Beta.String(), tt.expectedBeta)
+			assert.Equal(t, output.Stats.Periods, 4)
+			assert.Equal(t, len(output.Rolling.Points), 4)
+			assert.Equal(t, output.Rolling.Points[0].Value, (*decimal.Decimal)(nil))
+			assert.Equal(t, output.Rolling.Points[1].Value.String(), tt.expectedBeta)
+		})
+	}
+}
+
+func TestUnitUsecaseBetas(t *testing.T) {
+	//given
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	rp := new(mocks1.RepoItf)
+	rp.On("CheckSymbolExists", c, &dto.CollectSymbolReq{Symbol: "SPY"}).Return(true, nil)
+	rp.On("TrackedSymbols", c).Return([]dto.SymbolDataMeta{
+		{Symbol: "TSCO.LON"}, {Symbol: "SPY"}, {Symbol: "AAPL"},
+	}, nil)
+	rp.On("GetSymbol", c, "AAPL").Return(&dto.SymbolDataMeta{Symbol: "AAPL"}, nil)
+	rp.On("GetSymbol", c, "SPY").Return(&dto.SymbolDataMeta{Symbol: "SPY"}, nil)
+	rp.On("GetSymbol", c, "TSCO.LON").Return(&dto.SymbolDataMeta{Symbol: "TSCO.LON", Currency: "GBX"}, nil)
+	// Benchmark moves 10%, 0%, -10%, 10%; the stock twice as much
+	rp.On("SymbolBars", c, "SPY").Return(util.Bars("2025-06-02", 100, 110, 110, 99, 108.9), nil)
+	rp.On("SymbolBars", c, "AAPL").Return(util.Bars("2025-06-02", 100, 120, 120, 96, 115.2), nil)
+	rp.On("SymbolBars", c, "TSCO.LON").Return(util.Bars("2025-06-02", 1000, 1010, 1020, 1030, 1040), nil)
+	// Rates start after TSCO.LON's first bar
+	rp.On("FXRates", c, &dto.FXRatesReq{Base: "GBP", Quote: "USD"}).Return([]dto.FXRateRes{
+		{Base: "GBP", Quote: "USD", Date: util.Date("2025-06-04"), Rate: decimal.RequireFromString("1.25")},
+	}, nil)
+	uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+	//when
+	output, err := uc.Betas(c, &dto.BetasReq{Currency: "USD"})
+
+	//then
+	assert.Equal(t, err, nil)
+	assert.Equal(t, len(output.Symbols), 1)
+	assert.Equal(t, output.Symbols[0].Symbol, "AAPL")
+	assert.Equal(t, output.Symbols[0].Beta.String(), "2")
+	assert.Equal(t, output.Skipped, []string{"TSCO.LON"})
+}
+
+func TestUnitUsecaseConvertSeries(t *testing.T) {
+	rates := func(base, quote string, byDate ...string) []dto.FXRateRes {
+		out := make([]dto.FXRateRes, 0, len(byDate)/2)
+		for i := 0; i < len(byDate); i += 2 {
+			out = append(out, dto.FXRateRes{
+				Base:  base,
+				Quote: quote,
+				Date:  util.Date(byDate[i]),
+				Rate:  decimal.RequireFromString(byDate[i+1]),
+			})
+		}
+		return out
+	}
+	// Pence, on three sessions in a row
+	bars := make([]dto.DailyOHLCVRes, 3)
+	for i, close := range []int64{1000, 1010, 1020} {
+		bars[i] = dto.DailyOHLCVRes{
+			Day:  util.Date("2025-06-02").AddDate(0, 0, i),
+			OHLC: map[string]decimal.Decimal{"close": decimal.NewFromInt(close)},
+		}
+	}
+	from := util.Date("2025-06-03")
+
+	testCases := []struct {
+		name           string
+		currency       string
+		from           *dto.DateOnly
+		gbpUsd         []dto.FXRateRes
+		usdGbp         []dto.FXRateRes
+		expectedCloses []string
+		expectedRates  []string
+		expectedErr    error
+	}{
+		{
+			name:           "rate carried over an FX holiday",
+			currency:       "USD",
+			from:           &from,
+			gbpUsd:         rates("GBP", "USD", "2025-06-02", "1.25", "2025-06-04", "1.3"),
+			expectedCloses: []string{"12.5", "12.625", "13.26"},
+			expectedRates:  []string{"2025-06-03 2025-06-02 0.0125", "2025-06-04 2025-06-04 0.013"},
+		},
+		{
+			name:           "pair stored the other way round",
+			currency:       "usd",
+			usdGbp:         rates("USD", "GBP", "2025-06-01", "0.8"),
+			expectedCloses: []string{"12.5", "12.625", "12.75"},
+			expectedRates: []string{"2025-06-02 2025-06-01 0.0125",
+				"2025-06-03 2025-06-01 0.0125", "2025-06-04 2025-06-01 0.0125"},
+		},
+		{
+			name:           "pence to pounds at a fixed rate",
+			currency:       "GBP",
+			from:           &from,
+			expectedCloses: []string{"10", "10.1", "10.2"},
+			expectedRates:  []string{"2025-06-03 2025-06-03 0.01", "2025-06-04 2025-06-04 0.01"},
+		},
+		{
+			name:           "native currency",
+			currency:       "GBX",
+			expectedCloses: []string{"1000", "1010", "1020"},
+		},
+		{
+			name:        "no rate on or before a bar",
+			currency:    "USD",
+			gbpUsd:      rates("GBP", "USD", "2025-06-03", "1.25"),
+			expectedErr: constant.ErrNoFXRate("GBP", "USD", "2025-06-02"),
+		},
+		{
+			name:        "pair never synced",
+			currency:    "USD",
+			expectedErr: constant.ErrFXRatesNotFound,
+		},
+		{
+			name:        "invalid currency",
+			currency:    "US",
+			expectedErr: constant.ErrInvalidCurrency,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "TSCO.LON").Return(
+				&dto.SymbolDataMeta{Symbol: "TSCO.LON", Currency: "GBX"}, nil)
+			rp.On("SymbolBars", c, "TSCO.LON").Return(bars, nil)
+			rp.On("FXRates", c, &dto.FXRatesReq{Base: "GBP", Quote: "USD"}).Return(tt.gbpUsd, nil)
+			rp.On("FXRates", c, &dto.FXRatesReq{Base: "USD", Quote: "GBP"}).Return(tt.usdGbp, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+			req := &dto.SeriesReq{Symbol: "TSCO.LON", From: tt.from, Currency: tt.currency}
+
+			//when
+			output, _, err := uc.loadSeries(c, req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				assert.Equal(t, len(output), len(tt.expectedCloses))
+				for i, bar := range output {
+					assert.Equal(t, bar.OHLC["close"].String(), tt.expectedCloses[i])
+				}
+				assert.Equal(t, req.Conversion == nil, tt.expectedRates == nil)
+				if req.Conversion != nil {
+					assert.Equal(t, req.Conversion.Native, "GBX")
+					assert.Equal(t, req.Conversion.Currency, strings.ToUpper(tt.currency))
+					assert.Equal(t, len(req.Conversion.Rates), len(tt.expectedRates))
+					for i, rate := range req.Conversion.Rates {
+						assert.Equal(t, rate.Date.String()+" "+rate.RateDate.String()+" "+rate.Rate.String(),
+							tt.expectedRates[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestUnitUsecasePatterns(t *testing.T) {
+	// A bullish engulfing on the second day
+	candles := [][4]float64{{10, 10.2, 9.4, 9.5}, {9.4, 10.3, 9.3, 10.2}, {10.2, 10.5, 10.1, 10.4}}
+	bars := make([]dto.DailyOHLCVRes, len(candles))
+	for i, c := range candles {
+		bars[i] = dto.DailyOHLCVRes{
+			Day: util.Date("2025-06-02").AddDate(0, 0, i),
+			OHLC: map[string]decimal.Decimal{
+				"open":  decimal.NewFromFloat(c[0]),
+				"high":  decimal.NewFromFloat(c[1]),
+				"low":   decimal.NewFromFloat(c[2]),
+				"close": decimal.NewFromFloat(c[3]),
+			},
+		}
+	}
+	second := util.Date("2025-06-03")
+	third := util.Date("2025-06-04")
+
+	testCases := []struct {
+		name           string
+		req            *dto.PatternsReq
+		expectedDates  []string
+		expectedCounts map[string]int
+		expectedErr    error
+	}{
+		{
+			name:          "all patterns",
+			req:           &dto.PatternsReq{Series: dto.SeriesReq{Symbol: "AAPL"}},
+			expectedDates: []string{"2025-06-03 engulfing bullish"},
+		},
+		{
+			name:          "pattern completed by a candle before the window",
+			req:           &dto.PatternsReq{Series: dto.SeriesReq{Symbol: "AAPL", From: &second}},
+			expectedDates: []string{"2025-06-03 engulfing bullish"},
+		},
+		{
+			name:          "window after the pattern",
+			req:           &dto.PatternsReq{Series: dto.SeriesReq{Symbol: "AAPL", From: &third}},
+			expectedDates: []string{},
+		},
+		{
+			name: "only the asked patterns",
+			req: &dto.PatternsReq{Series: dto.SeriesReq{Symbol: "AAPL"},
+				Types: []string{constant.PatternHammer}},
+			expectedDates:  []string{},
+			expectedCounts: map[string]int{constant.PatternHammer: 0},
+		},
+		{
+			name:        "unknown pattern",
+			req:         &dto.PatternsReq{Series: dto.SeriesReq{Symbol: "AAPL"}, Types: []string{"cup"}},
+			expectedErr: constant.ErrUnknownPattern("cup"),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "AAPL").Return(&dto.SymbolDataMeta{Symbol: "AAPL"}, nil)
+			rp.On("SymbolBars", c, "AAPL").Return(bars, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.Patterns(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				dates := make([]string, 0)
+				for _, day := range output.Dates {
+					for _, p := range day.Patterns {
+						dates = append(dates, day.Date.String()+" "+p.Name+" "+p.Direction)
+					}
+				}
+				assert.Equal(t, dates, tt.expectedDates)
+				if tt.expectedCounts != nil {
+					assert.Equal(t, output.Counts, tt.expectedCounts)
+				} else {
+					assert.Equal(t, len(output.Counts), len(constant.Patterns))
+				}
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseSeasonality(t *testing.T) {
+	// Closes of 100 on every NYSE session from 2025-05-29 to 2025-07-02,
+	// but 110 on Monday 2025-06-30
+	var bars []dto.DailyOHLCVRes
+	day := util.Date("2025-05-29")
+	for !day.After(util.Date("2025-07-02")) {
+		close := decimal.NewFromInt(100)
+		if day == util.Date("2025-06-30") {
+			close = decimal.NewFromInt(110)
+		}
+		bars = append(bars, dto.DailyOHLCVRes{Day: day, OHLC: map[string]decimal.Decimal{"close": close}})
+		day = calendar.Default().NextSession(day)
+	}
+	first := util.Date("2025-05-29")
+
+	testCases := []struct {
+		name            string
+		req             *dto.SeasonalityReq
+		expectedMonths  []string
+		expectedWeeks   []int
+		expectedMonday  string
+		expectedHeatmap []string
+		expectedErr     error
+	}{
+		{
+			name: "partial months and weeks left out",
+			req:  &dto.SeasonalityReq{Series: dto.SeriesReq{Symbol: "AAPL"}},
+			// Only June is whole; weeks 22 and 27 are cut by the window
+			expectedMonths:  []string{"June 1 0.1"},
+			expectedWeeks:   []int{23, 24, 25, 26},
+			expectedMonday:  "5 0.2",
+			expectedHeatmap: []string{"2025 true", "5 true", "6 false", "7 true"},
+		},
+		{
+			name:        "no close before the window",
+			req:         &dto.SeasonalityReq{Series: dto.SeriesReq{Symbol: "AAPL", To: &first}},
+			expectedErr: constant.ErrNotEnoughBars,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "AAPL").Return(&dto.SymbolDataMeta{Symbol: "AAPL"}, nil)
+			rp.On("SymbolBars", c, "AAPL").Return(bars, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+			heatmapReq := *tt.req
+
+			//when
+			output, err := uc.Seasonality(c, tt.req)
+			heatmap, heatmapErr := uc.Heatmap(c, &heatmapReq)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			assert.Equal(t, heatmapErr, tt.expectedErr)
+			if err == nil {
+				months := make([]string, 0)
+				for _, month := range output.Months {
+					months = append(months, fmt.Sprintf("%s %d %s", month.Label, month.Count, month.Mean))
+				}
+				assert.Equal(t, months, tt.expectedMonths)
+				weeks := make([]int, 0)
+				for _, week := range output.Weeks {
+					weeks = append(weeks, week.Key)
+				}
+				assert.Equal(t, weeks, tt.expectedWeeks)
+				assert.Equal(t, output.Weekdays[0].Label, "Monday")
+				assert.Equal(t, fmt.Sprintf("%d %s", output.Weekdays[0].Count, output.Weekdays[0].HitRate),
+					tt.expectedMonday)
+
+				periods := make([]string, 0)
+				for _, year := range heatmap.Years {
+					periods = append(periods, fmt.Sprintf("%d %t", year.Year, year.Partial))
+					for _, month := range year.Months {
+						periods = append(periods, fmt.Sprintf("%d %t", month.Month, month.Partial))
+					}
+				}
+				assert.Equal(t, periods, tt.expectedHeatmap)
+				assert.Equal(t, heatmap.Max.String(), "0.1")
+				assert.Equal(t, heatmap.Min.String(), "-0.0909")
+				assert.Equal(t, len(heatmap.Years[0].Days), output.Days)
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseAnomalies(t *testing.T) {
+	// Closes alternating around 100 until a jump on the last day
+	closes := []int64{100, 101, 100, 101, 100, 101, 120}
+	bars := make([]dto.DailyOHLCVRes, len(closes))
+	for i, close := range closes {
+		bars[i] = dto.DailyOHLCVRes{
+			Day:    util.Date("2025-06-02").AddDate(0, 0, i),
+			OHLC:   map[string]decimal.Decimal{"close": decimal.NewFromInt(close)},
+			Volume: 100,
+		}
+	}
+	last := util.Date("2025-06-08")
+	high := decimal.NewFromInt(20)
+
+	testCases := []struct {
+		name           string
+		req            *dto.AnomaliesReq
+		expectedDates  []string
+		expectedCounts map[string]int
+		expectedErr    error
+	}{
+		{
+			name: "window filled from bars before it",
+			req: &dto.AnomaliesReq{
+				Series: dto.SeriesReq{Symbol: "AAPL", From: &last},
+				Params: dto.AnomalyParams{Window: 4},
+			},
+			expectedDates:  []string{"2025-06-08 return high"},
+			expectedCounts: map[string]int{constant.AnomalyReturn: 1, constant.AnomalyVolume: 0},
+		},
+		{
+			name: "threshold above the jump",
+			req: &dto.AnomaliesReq{
+				Series: dto.SeriesReq{Symbol: "AAPL"},
+				Params: dto.AnomalyParams{Window: 4, Threshold: &high},
+			},
+			expectedDates:  []string{},
+			expectedCounts: map[string]int{constant.AnomalyReturn: 0, constant.AnomalyVolume: 0},
+		},
+		{
+			name: "invalid window",
+			req: &dto.AnomaliesReq{
+				Series: dto.SeriesReq{Symbol: "AAPL"},
+				Params: dto.AnomalyParams{Window: 1},
+			},
+			expectedErr: constant.ErrInvalidAnomaly,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "AAPL").Return(&dto.SymbolDataMeta{Symbol: "AAPL"}, nil)
+			rp.On("SymbolBars", c, "AAPL").Return(bars, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.Anomalies(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				dates := make([]string, 0)
+				for _, day := range output.Dates {
+					for _, flag := range day.Flags {
+						dates = append(dates, day.Date.String()+" "+flag.Metric+" "+flag.Direction)
+					}
+				}
+				assert.Equal(t, dates, tt.expectedDates)
+				assert.Equal(t, output.Counts, tt.expectedCounts)
+				assert.Equal(t, output.Method, constant.AnomalyZScore)
+			}
+		})
+	}
+}
+
+func TestUnitUsecaseCharts(t *testing.T) {
+	// Closes rising a point a day, with a true range of 2 each day
+	bars := make([]dto.DailyOHLCVRes, 6)
+	for i := range bars {
+		close := decimal.NewFromInt(int64(10 + i))
+		bars[i] = dto.DailyOHLCVRes{
+			Day: util.Date("2025-06-02").AddDate(0, 0, i),
+			OHLC: map[string]decimal.Decimal{
+				"open": close, "high": close.Add(decimal.NewFromInt(1)),
+				"low": close.Sub(decimal.NewFromInt(1)), "close": close,
+			},
+			Volume: 100,
+		}
+	}
+	from := util.Date("2025-06-04")
+	box := decimal.NewFromInt(1)
+	tiny := decimal.RequireFromString("0.01")
+
+	testCases := []struct {
+		name           string
+		req            *dto.BoxChartReq
+		expectedBox    string
+		expectedSource string
+		expectedBricks []string
+		expectedErr    error
+	}{
+		{
+			name: "fixed box from the window start",
+			req: &dto.BoxChartReq{Series: dto.SeriesReq{Symbol: "AAPL", From: &from},
+				Params: dto.BoxParams{Box: &box}},
+			expectedBox:    "1",
+			expectedSource: constant.BoxFixed,
+			expectedBricks: []string{"2025-06-05 13", "2025-06-06 14", "2025-06-07 15"},
+		},
+		{
+			name: "atr box over bars before the window",
+			req: &dto.BoxChartReq{Series: dto.SeriesReq{Symbol: "AAPL", From: &from},
+				Params: dto.BoxParams{ATR: 3}},
+			expectedBox:    "2",
+			expectedSource: constant.BoxATR,
+			expectedBricks: []string{"2025-06-06 14"},
+		},
+		{
+			name: "too few bars for the atr",
+			req: &dto.BoxChartReq{Series: dto.SeriesReq{Symbol: "AAPL"},
+				Params: dto.BoxParams{ATR: 6}},
+			expectedErr: constant.ErrNoBoxSize,
+		},
+		{
+			name: "both box and atr",
+			req: &dto.BoxChartReq{Series: dto.SeriesReq{Symbol: "AAPL"},
+				Params: dto.BoxParams{Box: &box, ATR: 3}},
+			expectedErr: constant.ErrInvalidBox,
+		},
+		{
+			name: "box too small for the price",
+			req: &dto.BoxChartReq{Series: dto.SeriesReq{Symbol: "AAPL"},
+				Params: dto.BoxParams{Box: &tiny}},
+			expectedErr: constant.ErrInvalidBox,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			rp := new(mocks1.RepoItf)
+			rp.On("GetSymbol", c, "AAPL").Return(&dto.SymbolDataMeta{Symbol: "AAPL"}, nil)
+			rp.On("SymbolBars", c, "AAPL").Return(bars, nil)
+			uc := NewUsecase(rp, new(mocks2.HttpClientItf))
+
+			//when
+			output, err := uc.Renko(c, tt.req)
+
+			//then
+			assert.Equal(t, err, tt.expectedErr)
+			if err == nil {
+				bricks := make([]string, len(output.Bricks))
+				for i, brick := range output.Bricks {
+					bricks[i] = brick.Date.String() + " " + brick.Close.String()
+				}
+				assert.Equal(t, output.Box.String(), tt.expectedBox)
+				assert.Equal(t, output.BoxSource, tt.expectedSource)
+				assert.Equal(t, bricks, tt.expectedBricks)
+			}
+		})
+	}
+}
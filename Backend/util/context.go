@@ -0,0 +1,29 @@
+package util
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Wrap a plain context in a gin.Context, so that usecase and repo methods
+// can be reused by background work that is not tied to an HTTP request
+func NewBackgroundContext(c context.Context) *gin.Context {
+	req, _ := http.NewRequestWithContext(c, http.MethodGet, "/", nil)
+	return &gin.Context{Request: req}
+}
+
+// A context for work shared by several requests: it keeps the values of
+// ctx's request but neither its cancellation nor its deadline, so one
+// caller going away doesn't fail the others. The returned cancel bounds
+// the work by timeout instead.
+func NewDetachedContext(ctx *gin.Context, timeout time.Duration) (*gin.Context, context.CancelFunc) {
+	parent := context.Background()
+	if ctx.Request != nil {
+		parent = context.WithoutCancel(ctx.Request.Context())
+	}
+	c, cancel := context.WithTimeout(parent, timeout)
+	return NewBackgroundContext(c), cancel
+}
@@ -1,20 +1,28 @@
 package util
 
 import (
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
 )
 
 type HttpClientItf interface {
 	Get(string) (*http.Response, error)
 	ReadAll(io.Reader) ([]byte, error)
+	Do(*http.Request) (*http.Response, error)
 }
 
 type HttpClient struct {
+	// Client for Do; only reaches public addresses unless made for tests
+	client *http.Client
 }
 
 func NewHttpClient() *HttpClient {
-	return &HttpClient{}
+	return &HttpClient{client: webhookClient(publicOnly)}
 }
 
 func (hc *HttpClient) Get(url string) (resp *http.Response, err error) {
@@ -24,3 +32,53 @@ func (hc *HttpClient) Get(url string) (resp *http.Response, err error) {
 func (hc *HttpClient) ReadAll(r io.Reader) ([]byte, error) {
 	return io.ReadAll(r)
 }
+
+// Redirects aren't followed, so a signed request only reaches the URL it
+// was made for; the redirect comes back as the response. Connections to
+// loopback, private and link-local addresses are refused, whatever the
+// URL's host resolves to.
+func (hc *HttpClient) Do(req *http.Request) (*http.Response, error) {
+	return hc.client.Do(req)
+}
+
+func webhookClient(control func(string, string, syscall.RawConn) error) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: control,
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Refuse a connection about to be made to an address that isn't public
+func publicOnly(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !PublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("%s is not a public address", addrPort.Addr())
+	}
+	return nil
+}
+
+// Whether the address can be reached from outside the host's networks:
+// not loopback, private, link-local, multicast or unspecified
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified()
+}
@@ -17,6 +17,11 @@ func NewDateGenerator(date string) *DateGenerator {
 	return &dateGen
 }
 
+// Dummy date from its ISO text
+func Date(text string) dto.DateOnly {
+	return NewDateGenerator(text).Current()
+}
+
 func (d *DateGenerator) Current() dto.DateOnly {
 	return dto.DateOnly(*d)
 }
@@ -58,3 +63,35 @@ func (o *OHLCVGenerator) Next() dto.DailyOHLCVRes {
 
 	return res
 }
+
+// HttpClient whose Do may reach test servers on loopback
+func NewLocalHttpClient() *HttpClient {
+	return &HttpClient{client: webhookClient(nil)}
+}
+
+// Dummy daily bars on consecutive days from first, one per close, which
+// also serves as the bar's open, high and low
+func Bars(first string, closes ...float64) []dto.DailyOHLCVRes {
+	dateGen := NewDateGenerator(first)
+	bars := make([]dto.DailyOHLCVRes, len(closes))
+	for i, close := range closes {
+		if i > 0 {
+			dateGen.Next()
+		}
+		price := decimal.NewFromFloat(close)
+		bars[i] = dto.DailyOHLCVRes{
+			Day:    dateGen.Current(),
+			OHLC:   map[string]decimal.Decimal{"open": price, "high": price, "low": price, "close": price},
+			Volume: 100,
+		}
+	}
+	return bars
+}
+
+// The bars with their volumes set to the given ones, in order
+func WithVolumes(bars []dto.DailyOHLCVRes, volumes ...int) []dto.DailyOHLCVRes {
+	for i, volume := range volumes {
+		bars[i].Volume = volume
+	}
+	return bars
+}
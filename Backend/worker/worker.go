@@ -0,0 +1,106 @@
+package worker
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	"Backend/util"
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// What the workers need from the usecase layer
+type JobRunnerItf interface {
+	RequeueJobs(*gin.Context) (int64, error)
+	ClaimJob(*gin.Context) (*dto.JobRes, error)
+	RunJob(*gin.Context, *dto.JobRes) error
+}
+
+type PoolItf interface {
+	Start()
+	Stop()
+}
+
+type Pool struct {
+	jr           JobRunnerItf
+	size         int
+	pollInterval time.Duration
+	stop         chan struct{}
+	wg           sync.WaitGroup
+}
+
+func NewPool(jr JobRunnerItf, size int) *Pool {
+	return &Pool{
+		jr:           jr,
+		size:         size,
+		pollInterval: constant.JobPollInterval,
+		stop:         make(chan struct{}),
+	}
+}
+
+func (p *Pool) Start() {
+	// Pick up jobs interrupted by a restart
+	c, cancel := context.WithTimeout(context.Background(), constant.JobTimeout)
+	defer cancel()
+	requeued, err := p.jr.RequeueJobs(util.NewBackgroundContext(c))
+	if err != nil {
+		log.Printf("worker: requeueing interrupted jobs failed: %s", err)
+	} else if requeued > 0 {
+		log.Printf("worker: requeued %d interrupted jobs", requeued)
+	}
+
+	for range p.size {
+		p.wg.Add(1)
+		go p.work()
+	}
+}
+
+// Stop waits for jobs in progress to finish
+func (p *Pool) Stop() {
+	close(p.stop)
+	p.wg.Wait()
+}
+
+func (p *Pool) work() {
+	defer p.wg.Done()
+	for {
+		select {
+		case <-p.stop:
+			return
+		default:
+		}
+
+		if !p.runNext() {
+			// Queue is empty (or unreachable); wait before polling again
+			select {
+			case <-p.stop:
+				return
+			case <-time.After(p.pollInterval):
+			}
+		}
+	}
+}
+
+// Claim and run one job, reporting whether there was one
+func (p *Pool) runNext() bool {
+	c, cancel := context.WithTimeout(context.Background(), constant.JobTimeout)
+	defer cancel()
+	ctx := util.NewBackgroundContext(c)
+
+	job, err := p.jr.ClaimJob(ctx)
+	if err != nil {
+		log.Printf("worker: claiming job failed: %s", err)
+		return false
+	}
+	if job == nil {
+		return false
+	}
+
+	if err := p.jr.RunJob(ctx, job); err != nil {
+		log.Printf("worker: recording job %s failed: %s", job.Id, err)
+	}
+	return true
+}
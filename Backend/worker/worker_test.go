@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"Backend/constant"
+	"Backend/dto"
+	mocks "Backend/mocks/worker"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUnitWorkerPool(t *testing.T) {
+	var (
+		errorSample = errors.New("error")
+		job         = &dto.JobRes{Id: "1", Type: constant.JobTypeCollect, Symbol: "IBM"}
+	)
+
+	testCases := []struct {
+		name        string
+		runnerSetup func(chan struct{}) JobRunnerItf
+	}{
+		{
+			name: "runs claimed jobs until the queue is empty",
+			runnerSetup: func(done chan struct{}) JobRunnerItf {
+				mocked := new(mocks.JobRunnerItf)
+				mocked.On("RequeueJobs", mock.Anything).Return(int64(1), nil)
+				mocked.On("ClaimJob", mock.Anything).Return(job, nil).Once()
+				mocked.On("ClaimJob", mock.Anything).Return(nil, nil)
+				mocked.On("RunJob", mock.Anything, job).Run(func(mock.Arguments) {
+					close(done)
+				}).Return(nil).Once()
+				return mocked
+			},
+		},
+		{
+			name: "keeps polling after errors",
+			runnerSetup: func(done chan struct{}) JobRunnerItf {
+				mocked := new(mocks.JobRunnerItf)
+				mocked.On("RequeueJobs", mock.Anything).Return(int64(0), errorSample)
+				mocked.On("ClaimJob", mock.Anything).Return(nil, errorSample).Once()
+				mocked.On("ClaimJob", mock.Anything).Return(job, nil).Once()
+				mocked.On("ClaimJob", mock.Anything).Return(nil, nil)
+				mocked.On("RunJob", mock.Anything, job).Run(func(mock.Arguments) {
+					close(done)
+				}).Return(errorSample).Once()
+				return mocked
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			//given
+			done := make(chan struct{})
+			runner := tt.runnerSetup(done)
+			pool := NewPool(runner, 1)
+			pool.pollInterval = time.Millisecond
+
+			//when
+			pool.Start()
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+			}
+			pool.Stop()
+
+			//then
+			assert.Equal(t, runner.(*mocks.JobRunnerItf).AssertExpectations(t), true)
+		})
+	}
+}